@@ -76,6 +76,9 @@ func setupTelegramCommands(telegramClient domain.TelegramClientAPI, appLogger *s
 		{Command: "list", Description: "Список отслеживаемых ссылок"},
 		{Command: "mode", Description: "Изменить режим уведомлений (мгновенный/дайджест)"},
 		{Command: "time", Description: "Установить время доставки дайджеста"},
+		{Command: "quiet", Description: "Настроить часы тишины"},
+		{Command: "mute", Description: "Заглушить уведомления по ссылке"},
+		{Command: "unmute", Description: "Снять заглушение ссылки"},
 	}
 
 	ctx := context.Background()
@@ -165,7 +168,7 @@ func run() error {
 	telegramClient := clients.NewTelegramClient(cfg.TelegramBotToken, appLogger)
 	setupTelegramCommands(telegramClient, appLogger)
 
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	baseBotService := botservice.NewBotService(
 		chatStateRepo,
@@ -17,6 +17,7 @@ import (
 	"github.com/central-university-dev/go-Matthew11K/internal/common/middleware"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
 	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/cache"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/handler"
@@ -38,6 +39,8 @@ func gracefulShutdown(
 	server *http.Server,
 	updateScheduler Scheduler,
 	digestService *service.DigestService,
+	quietHoursService *service.QuietHoursService,
+	muteService *service.MuteService,
 	stopCh <-chan struct{},
 	appLogger *slog.Logger,
 ) {
@@ -50,6 +53,9 @@ func gracefulShutdown(
 		digestService.Stop()
 	}
 
+	quietHoursService.Stop()
+	muteService.Stop()
+
 	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -145,12 +151,71 @@ func run() error {
 		return err
 	}
 
-	updaterFactory := common.NewLinkUpdaterFactory(
-		clients.NewGitHubClient(cfg.GitHubAPIToken, "", cfg, appLogger),
-		clients.NewStackOverflowClient(cfg.StackOverflowAPIToken, "", cfg, appLogger),
-	)
+	feedEntryRepo, err := repoFactory.CreateFeedEntryRepository()
+	if err != nil {
+		appLogger.Error("Ошибка при создании репозитория записей лент",
+			"error", err,
+		)
+
+		return err
+	}
+
+	workflowRunRepo, err := repoFactory.CreateWorkflowRunRepository()
+	if err != nil {
+		appLogger.Error("Ошибка при создании репозитория запусков workflow",
+			"error", err,
+		)
+
+		return err
+	}
+
+	advisoryRepo, err := repoFactory.CreateAdvisoryRepository()
+	if err != nil {
+		appLogger.Error("Ошибка при создании репозитория бюллетеней безопасности",
+			"error", err,
+		)
 
-	linkAnalyzer := common.NewLinkAnalyzer()
+		return err
+	}
+
+	gitLabClients := make(map[string]common.GitLabClient)
+	gitLabInstances := make([]string, 0)
+
+	for _, instance := range cfg.GitLabInstances() {
+		gitLabClients[instance.Address] = clients.NewGitLabClient(instance.Token, instance.BaseURL, cfg, appLogger)
+		gitLabInstances = append(gitLabInstances, instance.Address)
+	}
+
+	sourceRegistry := common.NewDefaultSourceRegistry(gitLabInstances...)
+
+	githubClient := clients.NewGitHubClient(cfg.GitHubAPIToken, "", cfg, appLogger)
+	stackOverflowClient := clients.NewStackOverflowClient(cfg.StackOverflowAPIToken, "", cfg, appLogger)
+
+	var githubBatch common.GitHubBatchClient
+	if cfg.GitHubCheckStrategy == config.GitHubGraphQLStrategy {
+		githubBatch = githubClient
+	}
+
+	updaterFactory := common.NewLinkUpdaterFactory(sourceRegistry, &common.UpdaterDependencies{
+		GitHub:        githubClient,
+		GitHubBatch:   githubBatch,
+		StackOverflow: stackOverflowClient,
+		GitLab:        gitLabClients,
+		Feed:          clients.NewFeedClient(cfg, appLogger),
+		FeedStore:     feedEntryRepo,
+		WorkflowRuns:  workflowRunRepo,
+		Advisories:    advisoryRepo,
+		WebPage:       clients.NewWebPageClient(cfg, appLogger),
+		PageSnapshots: detailsRepo,
+		Packages: map[models.PackageRegistry]common.PackageRegistryClient{
+			models.GoModules: clients.NewGoProxyClient("", cfg, appLogger),
+			models.NPM:       clients.NewNPMClient("", cfg, appLogger),
+			models.PyPI:      clients.NewPyPIClient("", cfg, appLogger),
+			models.Crates:    clients.NewCratesClient("", cfg, appLogger),
+		},
+	})
+
+	linkAnalyzer := common.NewLinkAnalyzer(sourceRegistry)
 
 	notifierFactory := notify.NewNotifierFactory(cfg, appLogger)
 
@@ -178,7 +243,7 @@ func run() error {
 
 			appLogger.Warn("Продолжаем без дайджестов")
 		} else {
-			digestService = service.NewDigestService(cfg, botNotifier, digestCache, chatRepo, appLogger)
+			digestService = service.NewDigestService(cfg, botNotifier, digestCache, chatRepo, linkRepo, appLogger)
 			digestService.Start(ctx)
 			appLogger.Info("Сервис дайджестов успешно запущен")
 		}
@@ -186,11 +251,27 @@ func run() error {
 		appLogger.Info("Дайджесты отключены в конфигурации")
 	}
 
+	heldUpdateRepo, err := repoFactory.CreateHeldUpdateRepository()
+	if err != nil {
+		appLogger.Error("Ошибка при создании репозитория отложенных уведомлений",
+			"error", err,
+		)
+
+		return err
+	}
+
+	quietHoursService := service.NewQuietHoursService(botNotifier, heldUpdateRepo, chatRepo, appLogger)
+	quietHoursService.Start(ctx)
+
+	muteService := service.NewMuteService(botNotifier, linkRepo, appLogger)
+	muteService.Start(ctx)
+
 	scrapperService := service.NewScrapperService(
 		linkRepo,
 		chatRepo,
 		botNotifier,
 		digestService,
+		quietHoursService,
 		detailsRepo,
 		updaterFactory,
 		linkAnalyzer,
@@ -229,6 +310,7 @@ func run() error {
 		appLogger.Info("Использование параллельного шедулера",
 			"batchSize", cfg.DatabaseBatchSize,
 			"workers", cfg.SchedulerWorkers,
+			"githubCheckStrategy", cfg.GitHubCheckStrategy,
 		)
 
 		sch = scheduler.NewParallelScheduler(
@@ -238,6 +320,18 @@ func run() error {
 			cfg.DatabaseBatchSize,
 			cfg.SchedulerWorkers,
 			appLogger,
+			scheduler.RateLimitedSource{
+				LinkTypes: []models.LinkType{
+					models.GitHub, models.GitHubRelease, models.GitHubCommits, models.GitHubIssue, models.GitHubWorkflow,
+					models.GitHubAdvisory,
+				},
+				Quota:   githubClient,
+				Reserve: cfg.GitHubRateLimitReserve,
+			},
+			scheduler.RateLimitedSource{
+				LinkTypes: []models.LinkType{models.StackOverflow},
+				Quota:     stackOverflowClient,
+			},
 		)
 	} else {
 		return fmt.Errorf("обычный шедулер больше не поддерживается в текущей конфигурации")
@@ -255,7 +349,7 @@ func run() error {
 
 	startHTTPServer(ctx, httpServer, cfg.ScrapperServerPort, stopCh, appLogger)
 
-	gracefulShutdown(ctx, httpServer, sch, digestService, stopCh, appLogger)
+	gracefulShutdown(ctx, httpServer, sch, digestService, quietHoursService, muteService, stopCh, appLogger)
 
 	return nil
 }
@@ -190,13 +190,24 @@ func (s *LinkUpdate) encodeFields(e *jx.Encoder) {
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.Keywords != nil {
+			e.FieldStart("keywords")
+			e.ArrStart()
+			for _, elem := range s.Keywords {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfLinkUpdate = [4]string{
+var jsonFieldsNameOfLinkUpdate = [5]string{
 	0: "id",
 	1: "url",
 	2: "description",
 	3: "tgChatIds",
+	4: "keywords",
 }
 
 // Decode decodes LinkUpdate from json.
@@ -256,6 +267,25 @@ func (s *LinkUpdate) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"tgChatIds\"")
 			}
+		case "keywords":
+			if err := func() error {
+				s.Keywords = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Keywords = append(s.Keywords, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"keywords\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -73,6 +73,9 @@ type LinkUpdate struct {
 	URL         OptURI    `json:"url"`
 	Description OptString `json:"description"`
 	TgChatIds   []int64   `json:"tgChatIds"`
+	// Ключевые слова подписок, найденные в тексте
+	// обновления, для выделения в уведомлении.
+	Keywords []string `json:"keywords"`
 }
 
 // GetID returns the value of ID.
@@ -95,6 +98,11 @@ func (s *LinkUpdate) GetTgChatIds() []int64 {
 	return s.TgChatIds
 }
 
+// GetKeywords returns the value of Keywords.
+func (s *LinkUpdate) GetKeywords() []string {
+	return s.Keywords
+}
+
 // SetID sets the value of ID.
 func (s *LinkUpdate) SetID(val OptInt64) {
 	s.ID = val
@@ -115,6 +123,11 @@ func (s *LinkUpdate) SetTgChatIds(val []int64) {
 	s.TgChatIds = val
 }
 
+// SetKeywords sets the value of Keywords.
+func (s *LinkUpdate) SetKeywords(val []string) {
+	s.Keywords = val
+}
+
 // NewOptInt64 returns new OptInt64 with value set to v.
 func NewOptInt64(v int64) OptInt64 {
 	return OptInt64{
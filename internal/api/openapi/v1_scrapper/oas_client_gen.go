@@ -39,6 +39,19 @@ type Invoker interface {
 	//
 	// GET /links
 	LinksGet(ctx context.Context, params LinksGetParams) (LinksGetRes, error)
+	// LinksMuteDelete invokes DELETE /links/mute operation.
+	//
+	// Снять заглушение ссылки.
+	//
+	// DELETE /links/mute
+	LinksMuteDelete(ctx context.Context, request *RemoveLinkRequest, params LinksMuteDeleteParams) (LinksMuteDeleteRes, error)
+	// LinksMutePost invokes POST /links/mute operation.
+	//
+	// Заглушить уведомления по ссылке до указанного
+	// времени.
+	//
+	// POST /links/mute
+	LinksMutePost(ctx context.Context, request *MuteLinkRequest, params LinksMutePostParams) (LinksMutePostRes, error)
 	// LinksPost invokes POST /links operation.
 	//
 	// Добавить отслеживание ссылки.
@@ -51,6 +64,12 @@ type Invoker interface {
 	//
 	// POST /notification-settings
 	NotificationSettingsPost(ctx context.Context, request *UpdateNotificationSettingsRequest, params NotificationSettingsPostParams) (NotificationSettingsPostRes, error)
+	// QuietHoursPost invokes POST /quiet-hours operation.
+	//
+	// Задать часы тишины чата.
+	//
+	// POST /quiet-hours
+	QuietHoursPost(ctx context.Context, request *UpdateQuietHoursRequest, params QuietHoursPostParams) (QuietHoursPostRes, error)
 	// TgChatIDDelete invokes DELETE /tg-chat/{id} operation.
 	//
 	// Удалить чат.
@@ -281,6 +300,183 @@ func (c *Client) sendLinksGet(ctx context.Context, params LinksGetParams) (res L
 	return result, nil
 }
 
+// LinksMuteDelete invokes DELETE /links/mute operation.
+//
+// Снять заглушение ссылки.
+//
+// DELETE /links/mute
+func (c *Client) LinksMuteDelete(ctx context.Context, request *RemoveLinkRequest, params LinksMuteDeleteParams) (LinksMuteDeleteRes, error) {
+	res, err := c.sendLinksMuteDelete(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendLinksMuteDelete(ctx context.Context, request *RemoveLinkRequest, params LinksMuteDeleteParams) (res LinksMuteDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/links/mute"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LinksMuteDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/links/mute"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLinksMuteDeleteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.TgChatID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLinksMuteDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LinksMutePost invokes POST /links/mute operation.
+//
+// Заглушить уведомления по ссылке до указанного
+// времени.
+//
+// POST /links/mute
+func (c *Client) LinksMutePost(ctx context.Context, request *MuteLinkRequest, params LinksMutePostParams) (LinksMutePostRes, error) {
+	res, err := c.sendLinksMutePost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendLinksMutePost(ctx context.Context, request *MuteLinkRequest, params LinksMutePostParams) (res LinksMutePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/links/mute"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LinksMutePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/links/mute"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLinksMutePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.TgChatID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLinksMutePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // LinksPost invokes POST /links operation.
 //
 // Добавить отслеживание ссылки.
@@ -457,6 +653,94 @@ func (c *Client) sendNotificationSettingsPost(ctx context.Context, request *Upda
 	return result, nil
 }
 
+// QuietHoursPost invokes POST /quiet-hours operation.
+//
+// Задать часы тишины чата.
+//
+// POST /quiet-hours
+func (c *Client) QuietHoursPost(ctx context.Context, request *UpdateQuietHoursRequest, params QuietHoursPostParams) (QuietHoursPostRes, error) {
+	res, err := c.sendQuietHoursPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendQuietHoursPost(ctx context.Context, request *UpdateQuietHoursRequest, params QuietHoursPostParams) (res QuietHoursPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/quiet-hours"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, QuietHoursPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/quiet-hours"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeQuietHoursPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.TgChatID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeQuietHoursPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // TgChatIDDelete invokes DELETE /tg-chat/{id} operation.
 //
 // Удалить чат.
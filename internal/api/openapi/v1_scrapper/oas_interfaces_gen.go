@@ -9,6 +9,14 @@ type LinksGetRes interface {
 	linksGetRes()
 }
 
+type LinksMuteDeleteRes interface {
+	linksMuteDeleteRes()
+}
+
+type LinksMutePostRes interface {
+	linksMutePostRes()
+}
+
 type LinksPostRes interface {
 	linksPostRes()
 }
@@ -17,6 +25,10 @@ type NotificationSettingsPostRes interface {
 	notificationSettingsPostRes()
 }
 
+type QuietHoursPostRes interface {
+	quietHoursPostRes()
+}
+
 type TgChatIDDeleteRes interface {
 	tgChatIDDeleteRes()
 }
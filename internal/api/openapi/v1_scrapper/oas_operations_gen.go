@@ -8,8 +8,11 @@ type OperationName = string
 const (
 	LinksDeleteOperation              OperationName = "LinksDelete"
 	LinksGetOperation                 OperationName = "LinksGet"
+	LinksMuteDeleteOperation          OperationName = "LinksMuteDelete"
+	LinksMutePostOperation            OperationName = "LinksMutePost"
 	LinksPostOperation                OperationName = "LinksPost"
 	NotificationSettingsPostOperation OperationName = "NotificationSettingsPost"
+	QuietHoursPostOperation           OperationName = "QuietHoursPost"
 	TgChatIDDeleteOperation           OperationName = "TgChatIDDelete"
 	TgChatIDPostOperation             OperationName = "TgChatIDPost"
 )
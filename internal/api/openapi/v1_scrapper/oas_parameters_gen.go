@@ -125,6 +125,116 @@ func decodeLinksGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
+// LinksMuteDeleteParams is parameters of DELETE /links/mute operation.
+type LinksMuteDeleteParams struct {
+	TgChatID int64
+}
+
+func unpackLinksMuteDeleteParams(packed middleware.Parameters) (params LinksMuteDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+		}
+		params.TgChatID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeLinksMuteDeleteParams(args [0]string, argsEscaped bool, r *http.Request) (params LinksMuteDeleteParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Tg-Chat-Id.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TgChatID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// LinksMutePostParams is parameters of POST /links/mute operation.
+type LinksMutePostParams struct {
+	TgChatID int64
+}
+
+func unpackLinksMutePostParams(packed middleware.Parameters) (params LinksMutePostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+		}
+		params.TgChatID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeLinksMutePostParams(args [0]string, argsEscaped bool, r *http.Request) (params LinksMutePostParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Tg-Chat-Id.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TgChatID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // LinksPostParams is parameters of POST /links operation.
 type LinksPostParams struct {
 	TgChatID int64
@@ -235,6 +345,61 @@ func decodeNotificationSettingsPostParams(args [0]string, argsEscaped bool, r *h
 	return params, nil
 }
 
+// QuietHoursPostParams is parameters of POST /quiet-hours operation.
+type QuietHoursPostParams struct {
+	TgChatID int64
+}
+
+func unpackQuietHoursPostParams(packed middleware.Parameters) (params QuietHoursPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+		}
+		params.TgChatID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeQuietHoursPostParams(args [0]string, argsEscaped bool, r *http.Request) (params QuietHoursPostParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Tg-Chat-Id.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Tg-Chat-Id",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TgChatID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Tg-Chat-Id",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // TgChatIDDeleteParams is parameters of DELETE /tg-chat/{id} operation.
 type TgChatIDDeleteParams struct {
 	ID int64
@@ -70,7 +70,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "DELETE":
 						s.handleLinksDeleteRequest([0]string{}, elemIsEscaped, w, r)
@@ -84,6 +83,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/mute"
+					origElem := elem
+					if l := len("/mute"); len(elem) >= l && elem[0:l] == "/mute" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleLinksMuteDeleteRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleLinksMutePostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'n': // Prefix: "notification-settings"
@@ -106,6 +130,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 
+				elem = origElem
+			case 'q': // Prefix: "quiet-hours"
+				origElem := elem
+				if l := len("quiet-hours"); len(elem) >= l && elem[0:l] == "quiet-hours" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleQuietHoursPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+
 				elem = origElem
 			case 't': // Prefix: "tg-chat/"
 				origElem := elem
@@ -243,7 +288,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "DELETE":
 						r.name = LinksDeleteOperation
@@ -273,6 +317,41 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/mute"
+					origElem := elem
+					if l := len("/mute"); len(elem) >= l && elem[0:l] == "/mute" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = LinksMuteDeleteOperation
+							r.summary = "Снять заглушение ссылки"
+							r.operationID = ""
+							r.pathPattern = "/links/mute"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = LinksMutePostOperation
+							r.summary = "Заглушить уведомления по ссылке до указанного времени"
+							r.operationID = ""
+							r.pathPattern = "/links/mute"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'n': // Prefix: "notification-settings"
@@ -299,6 +378,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
+				elem = origElem
+			case 'q': // Prefix: "quiet-hours"
+				origElem := elem
+				if l := len("quiet-hours"); len(elem) >= l && elem[0:l] == "quiet-hours" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = QuietHoursPostOperation
+						r.summary = "Задать часы тишины чата"
+						r.operationID = ""
+						r.pathPattern = "/quiet-hours"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
 				elem = origElem
 			case 't': // Prefix: "tg-chat/"
 				origElem := elem
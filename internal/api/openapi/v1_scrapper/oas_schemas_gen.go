@@ -4,6 +4,7 @@ package v1_scrapper
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 )
@@ -13,6 +14,12 @@ type AddLinkRequest struct {
 	Link    OptURI   `json:"link"`
 	Tags    []string `json:"tags"`
 	Filters []string `json:"filters"`
+	// Ключевые слова: уведомления приходят, только если
+	// текст обновления содержит одно из них.
+	Keywords []string `json:"keywords"`
+	// Режим уведомлений по ссылке: instant или digest. Если не
+	// задан, действует режим чата.
+	Mode OptAddLinkRequestMode `json:"mode"`
 }
 
 // GetLink returns the value of Link.
@@ -30,6 +37,16 @@ func (s *AddLinkRequest) GetFilters() []string {
 	return s.Filters
 }
 
+// GetKeywords returns the value of Keywords.
+func (s *AddLinkRequest) GetKeywords() []string {
+	return s.Keywords
+}
+
+// GetMode returns the value of Mode.
+func (s *AddLinkRequest) GetMode() OptAddLinkRequestMode {
+	return s.Mode
+}
+
 // SetLink sets the value of Link.
 func (s *AddLinkRequest) SetLink(val OptURI) {
 	s.Link = val
@@ -45,6 +62,59 @@ func (s *AddLinkRequest) SetFilters(val []string) {
 	s.Filters = val
 }
 
+// SetKeywords sets the value of Keywords.
+func (s *AddLinkRequest) SetKeywords(val []string) {
+	s.Keywords = val
+}
+
+// SetMode sets the value of Mode.
+func (s *AddLinkRequest) SetMode(val OptAddLinkRequestMode) {
+	s.Mode = val
+}
+
+// Режим уведомлений по ссылке: instant или digest. Если не
+// задан, действует режим чата.
+type AddLinkRequestMode string
+
+const (
+	AddLinkRequestModeInstant AddLinkRequestMode = "instant"
+	AddLinkRequestModeDigest  AddLinkRequestMode = "digest"
+)
+
+// AllValues returns all AddLinkRequestMode values.
+func (AddLinkRequestMode) AllValues() []AddLinkRequestMode {
+	return []AddLinkRequestMode{
+		AddLinkRequestModeInstant,
+		AddLinkRequestModeDigest,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AddLinkRequestMode) MarshalText() ([]byte, error) {
+	switch s {
+	case AddLinkRequestModeInstant:
+		return []byte(s), nil
+	case AddLinkRequestModeDigest:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AddLinkRequestMode) UnmarshalText(data []byte) error {
+	switch AddLinkRequestMode(data) {
+	case AddLinkRequestModeInstant:
+		*s = AddLinkRequestModeInstant
+		return nil
+	case AddLinkRequestModeDigest:
+		*s = AddLinkRequestModeDigest
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/ApiErrorResponse
 type ApiErrorResponse struct {
 	Description      OptString `json:"description"`
@@ -114,6 +184,11 @@ type LinkResponse struct {
 	URL     OptURI   `json:"url"`
 	Tags    []string `json:"tags"`
 	Filters []string `json:"filters"`
+	// Ключевые слова: уведомления приходят, только если
+	// текст обновления содержит одно из них.
+	Keywords []string `json:"keywords"`
+	// Время, до которого уведомления по ссылке заглушены.
+	MutedUntil OptDateTime `json:"mutedUntil"`
 }
 
 // GetID returns the value of ID.
@@ -136,6 +211,16 @@ func (s *LinkResponse) GetFilters() []string {
 	return s.Filters
 }
 
+// GetKeywords returns the value of Keywords.
+func (s *LinkResponse) GetKeywords() []string {
+	return s.Keywords
+}
+
+// GetMutedUntil returns the value of MutedUntil.
+func (s *LinkResponse) GetMutedUntil() OptDateTime {
+	return s.MutedUntil
+}
+
 // SetID sets the value of ID.
 func (s *LinkResponse) SetID(val OptInt64) {
 	s.ID = val
@@ -156,8 +241,20 @@ func (s *LinkResponse) SetFilters(val []string) {
 	s.Filters = val
 }
 
-func (*LinkResponse) linksDeleteRes() {}
-func (*LinkResponse) linksPostRes()   {}
+// SetKeywords sets the value of Keywords.
+func (s *LinkResponse) SetKeywords(val []string) {
+	s.Keywords = val
+}
+
+// SetMutedUntil sets the value of MutedUntil.
+func (s *LinkResponse) SetMutedUntil(val OptDateTime) {
+	s.MutedUntil = val
+}
+
+func (*LinkResponse) linksDeleteRes()     {}
+func (*LinkResponse) linksMuteDeleteRes() {}
+func (*LinkResponse) linksMutePostRes()   {}
+func (*LinkResponse) linksPostRes()       {}
 
 type LinksDeleteBadRequest ApiErrorResponse
 
@@ -167,6 +264,22 @@ type LinksDeleteNotFound ApiErrorResponse
 
 func (*LinksDeleteNotFound) linksDeleteRes() {}
 
+type LinksMuteDeleteBadRequest ApiErrorResponse
+
+func (*LinksMuteDeleteBadRequest) linksMuteDeleteRes() {}
+
+type LinksMuteDeleteNotFound ApiErrorResponse
+
+func (*LinksMuteDeleteNotFound) linksMuteDeleteRes() {}
+
+type LinksMutePostBadRequest ApiErrorResponse
+
+func (*LinksMutePostBadRequest) linksMutePostRes() {}
+
+type LinksMutePostNotFound ApiErrorResponse
+
+func (*LinksMutePostNotFound) linksMutePostRes() {}
+
 // Ref: #/components/schemas/ListLinksResponse
 type ListLinksResponse struct {
 	Links []LinkResponse `json:"links"`
@@ -195,6 +308,46 @@ func (s *ListLinksResponse) SetSize(val OptInt32) {
 
 func (*ListLinksResponse) linksGetRes() {}
 
+// Ref: #/components/schemas/MuteLinkRequest
+type MuteLinkRequest struct {
+	Link url.URL `json:"link"`
+	// Время, до которого уведомления по ссылке заглушены.
+	MutedUntil time.Time `json:"mutedUntil"`
+	// Прислать сводку пропущенных обновлений, когда
+	// заглушение закончится. По умолчанию true.
+	Summary OptBool `json:"summary"`
+}
+
+// GetLink returns the value of Link.
+func (s *MuteLinkRequest) GetLink() url.URL {
+	return s.Link
+}
+
+// GetMutedUntil returns the value of MutedUntil.
+func (s *MuteLinkRequest) GetMutedUntil() time.Time {
+	return s.MutedUntil
+}
+
+// GetSummary returns the value of Summary.
+func (s *MuteLinkRequest) GetSummary() OptBool {
+	return s.Summary
+}
+
+// SetLink sets the value of Link.
+func (s *MuteLinkRequest) SetLink(val url.URL) {
+	s.Link = val
+}
+
+// SetMutedUntil sets the value of MutedUntil.
+func (s *MuteLinkRequest) SetMutedUntil(val time.Time) {
+	s.MutedUntil = val
+}
+
+// SetSummary sets the value of Summary.
+func (s *MuteLinkRequest) SetSummary(val OptBool) {
+	s.Summary = val
+}
+
 type NotificationSettingsPostBadRequest ApiErrorResponse
 
 func (*NotificationSettingsPostBadRequest) notificationSettingsPostRes() {}
@@ -208,6 +361,144 @@ type NotificationSettingsPostOK struct{}
 
 func (*NotificationSettingsPostOK) notificationSettingsPostRes() {}
 
+// NewOptAddLinkRequestMode returns new OptAddLinkRequestMode with value set to v.
+func NewOptAddLinkRequestMode(v AddLinkRequestMode) OptAddLinkRequestMode {
+	return OptAddLinkRequestMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddLinkRequestMode is optional AddLinkRequestMode.
+type OptAddLinkRequestMode struct {
+	Value AddLinkRequestMode
+	Set   bool
+}
+
+// IsSet returns true if OptAddLinkRequestMode was set.
+func (o OptAddLinkRequestMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddLinkRequestMode) Reset() {
+	var v AddLinkRequestMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddLinkRequestMode) SetTo(v AddLinkRequestMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddLinkRequestMode) Get() (v AddLinkRequestMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddLinkRequestMode) Or(d AddLinkRequestMode) AddLinkRequestMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt32 returns new OptInt32 with value set to v.
 func NewOptInt32(v int32) OptInt32 {
 	return OptInt32{
@@ -392,6 +683,19 @@ func (o OptURI) Or(d url.URL) url.URL {
 	return d
 }
 
+type QuietHoursPostBadRequest ApiErrorResponse
+
+func (*QuietHoursPostBadRequest) quietHoursPostRes() {}
+
+type QuietHoursPostNotFound ApiErrorResponse
+
+func (*QuietHoursPostNotFound) quietHoursPostRes() {}
+
+// QuietHoursPostOK is response for QuietHoursPost operation.
+type QuietHoursPostOK struct{}
+
+func (*QuietHoursPostOK) quietHoursPostRes() {}
+
 // Ref: #/components/schemas/RemoveLinkRequest
 type RemoveLinkRequest struct {
 	Link OptURI `json:"link"`
@@ -506,3 +810,81 @@ func (s *UpdateNotificationSettingsRequestMode) UnmarshalText(data []byte) error
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Ref: #/components/schemas/UpdateQuietHoursRequest
+type UpdateQuietHoursRequest struct {
+	// False отключает часы тишины, остальные поля тогда не
+	// нужны.
+	Enabled bool `json:"enabled"`
+	// Час начала часов тишины (0-23).
+	StartHour OptInt32 `json:"startHour"`
+	// Минута начала часов тишины (0-59).
+	StartMinute OptInt32 `json:"startMinute"`
+	// Час окончания часов тишины (0-23).
+	EndHour OptInt32 `json:"endHour"`
+	// Минута окончания часов тишины (0-59).
+	EndMinute OptInt32 `json:"endMinute"`
+	// Часовой пояс из базы IANA, например Europe/Moscow. По
+	// умолчанию UTC.
+	Timezone OptString `json:"timezone"`
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *UpdateQuietHoursRequest) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetStartHour returns the value of StartHour.
+func (s *UpdateQuietHoursRequest) GetStartHour() OptInt32 {
+	return s.StartHour
+}
+
+// GetStartMinute returns the value of StartMinute.
+func (s *UpdateQuietHoursRequest) GetStartMinute() OptInt32 {
+	return s.StartMinute
+}
+
+// GetEndHour returns the value of EndHour.
+func (s *UpdateQuietHoursRequest) GetEndHour() OptInt32 {
+	return s.EndHour
+}
+
+// GetEndMinute returns the value of EndMinute.
+func (s *UpdateQuietHoursRequest) GetEndMinute() OptInt32 {
+	return s.EndMinute
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *UpdateQuietHoursRequest) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *UpdateQuietHoursRequest) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetStartHour sets the value of StartHour.
+func (s *UpdateQuietHoursRequest) SetStartHour(val OptInt32) {
+	s.StartHour = val
+}
+
+// SetStartMinute sets the value of StartMinute.
+func (s *UpdateQuietHoursRequest) SetStartMinute(val OptInt32) {
+	s.StartMinute = val
+}
+
+// SetEndHour sets the value of EndHour.
+func (s *UpdateQuietHoursRequest) SetEndHour(val OptInt32) {
+	s.EndHour = val
+}
+
+// SetEndMinute sets the value of EndMinute.
+func (s *UpdateQuietHoursRequest) SetEndMinute(val OptInt32) {
+	s.EndMinute = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *UpdateQuietHoursRequest) SetTimezone(val OptString) {
+	s.Timezone = val
+}
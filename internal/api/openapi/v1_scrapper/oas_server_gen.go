@@ -20,6 +20,19 @@ type Handler interface {
 	//
 	// GET /links
 	LinksGet(ctx context.Context, params LinksGetParams) (LinksGetRes, error)
+	// LinksMuteDelete implements DELETE /links/mute operation.
+	//
+	// Снять заглушение ссылки.
+	//
+	// DELETE /links/mute
+	LinksMuteDelete(ctx context.Context, req *RemoveLinkRequest, params LinksMuteDeleteParams) (LinksMuteDeleteRes, error)
+	// LinksMutePost implements POST /links/mute operation.
+	//
+	// Заглушить уведомления по ссылке до указанного
+	// времени.
+	//
+	// POST /links/mute
+	LinksMutePost(ctx context.Context, req *MuteLinkRequest, params LinksMutePostParams) (LinksMutePostRes, error)
 	// LinksPost implements POST /links operation.
 	//
 	// Добавить отслеживание ссылки.
@@ -32,6 +45,12 @@ type Handler interface {
 	//
 	// POST /notification-settings
 	NotificationSettingsPost(ctx context.Context, req *UpdateNotificationSettingsRequest, params NotificationSettingsPostParams) (NotificationSettingsPostRes, error)
+	// QuietHoursPost implements POST /quiet-hours operation.
+	//
+	// Задать часы тишины чата.
+	//
+	// POST /quiet-hours
+	QuietHoursPost(ctx context.Context, req *UpdateQuietHoursRequest, params QuietHoursPostParams) (QuietHoursPostRes, error)
 	// TgChatIDDelete implements DELETE /tg-chat/{id} operation.
 	//
 	// Удалить чат.
@@ -31,6 +31,25 @@ func (UnimplementedHandler) LinksGet(ctx context.Context, params LinksGetParams)
 	return r, ht.ErrNotImplemented
 }
 
+// LinksMuteDelete implements DELETE /links/mute operation.
+//
+// Снять заглушение ссылки.
+//
+// DELETE /links/mute
+func (UnimplementedHandler) LinksMuteDelete(ctx context.Context, req *RemoveLinkRequest, params LinksMuteDeleteParams) (r LinksMuteDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LinksMutePost implements POST /links/mute operation.
+//
+// Заглушить уведомления по ссылке до указанного
+// времени.
+//
+// POST /links/mute
+func (UnimplementedHandler) LinksMutePost(ctx context.Context, req *MuteLinkRequest, params LinksMutePostParams) (r LinksMutePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // LinksPost implements POST /links operation.
 //
 // Добавить отслеживание ссылки.
@@ -49,6 +68,15 @@ func (UnimplementedHandler) NotificationSettingsPost(ctx context.Context, req *U
 	return r, ht.ErrNotImplemented
 }
 
+// QuietHoursPost implements POST /quiet-hours operation.
+//
+// Задать часы тишины чата.
+//
+// POST /quiet-hours
+func (UnimplementedHandler) QuietHoursPost(ctx context.Context, req *UpdateQuietHoursRequest, params QuietHoursPostParams) (r QuietHoursPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // TgChatIDDelete implements DELETE /tg-chat/{id} operation.
 //
 // Удалить чат.
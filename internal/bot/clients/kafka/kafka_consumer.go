@@ -19,6 +19,7 @@ type LinkUpdateMessage struct {
 	Description string             `json:"description"`
 	TgChatIDs   []int64            `json:"tgChatIds"`
 	UpdateInfo  *models.UpdateInfo `json:"updateInfo,omitempty"`
+	Keywords    []string           `json:"keywords,omitempty"`
 }
 
 type MessageHandler interface {
@@ -148,6 +149,7 @@ func (c *Consumer) processMessage(ctx context.Context, msg *kafka.Message) error
 		Description: linkUpdateMessage.Description,
 		TgChatIDs:   linkUpdateMessage.TgChatIDs,
 		UpdateInfo:  linkUpdateMessage.UpdateInfo,
+		Keywords:    linkUpdateMessage.Keywords,
 	}
 
 	if err := c.messageHandler.HandleUpdate(ctx, update); err != nil {
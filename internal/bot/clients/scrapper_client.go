@@ -73,16 +73,28 @@ func (c *ScrapperClient) DeleteChat(ctx context.Context, chatID int64) error {
 	}
 }
 
-func (c *ScrapperClient) AddLink(ctx context.Context, chatID int64, linkURL string, tags, filters []string) (*models.Link, error) {
+func (c *ScrapperClient) AddLink(ctx context.Context, chatID int64, linkURL string,
+	tags, filters, keywords []string, mode models.NotificationMode) (*models.Link, error) {
 	parsedURL, err := url.Parse(linkURL)
 	if err != nil {
 		return nil, &domainerrors.ErrInvalidArgument{Message: "некорректный URL"}
 	}
 
 	req := &v1_scrapper.AddLinkRequest{
-		Link:    v1_scrapper.NewOptURI(*parsedURL),
-		Tags:    tags,
-		Filters: filters,
+		Link:     v1_scrapper.NewOptURI(*parsedURL),
+		Tags:     tags,
+		Filters:  filters,
+		Keywords: keywords,
+	}
+
+	switch mode {
+	case "":
+	case models.NotificationModeInstant:
+		req.Mode = v1_scrapper.NewOptAddLinkRequestMode(v1_scrapper.AddLinkRequestModeInstant)
+	case models.NotificationModeDigest:
+		req.Mode = v1_scrapper.NewOptAddLinkRequestMode(v1_scrapper.AddLinkRequestModeDigest)
+	default:
+		return nil, &domainerrors.ErrUnknownNotificationMode{Mode: string(mode)}
 	}
 
 	params := v1_scrapper.LinksPostParams{
@@ -107,17 +119,7 @@ func (c *ScrapperClient) AddLink(ctx context.Context, chatID int64, linkURL stri
 		return nil, &domainerrors.ErrInternalServer{Message: "неожиданный ответ от сервера"}
 	}
 
-	link := &models.Link{
-		ID:      linkResp.ID.Or(0),
-		Tags:    linkResp.Tags,
-		Filters: linkResp.Filters,
-	}
-
-	if linkResp.URL.IsSet() {
-		link.URL = linkResp.URL.Value.String()
-	}
-
-	return link, nil
+	return linkFromResponse(linkResp), nil
 }
 
 func (c *ScrapperClient) RemoveLink(ctx context.Context, chatID int64, linkURL string) (*models.Link, error) {
@@ -148,17 +150,7 @@ func (c *ScrapperClient) RemoveLink(ctx context.Context, chatID int64, linkURL s
 		return nil, &domainerrors.ErrInternalServer{Message: "неожиданный ответ от сервера"}
 	}
 
-	link := &models.Link{
-		ID:      linkResp.ID.Or(0),
-		Tags:    linkResp.Tags,
-		Filters: linkResp.Filters,
-	}
-
-	if linkResp.URL.IsSet() {
-		link.URL = linkResp.URL.Value.String()
-	}
-
-	return link, nil
+	return linkFromResponse(linkResp), nil
 }
 
 func (c *ScrapperClient) GetLinks(ctx context.Context, chatID int64) ([]*models.Link, error) {
@@ -179,18 +171,7 @@ func (c *ScrapperClient) GetLinks(ctx context.Context, chatID int64) ([]*models.
 	links := make([]*models.Link, 0, len(listResp.Links))
 
 	for i := range listResp.Links {
-		linkResp := &listResp.Links[i]
-		link := &models.Link{
-			ID:      linkResp.ID.Or(0),
-			Tags:    linkResp.Tags,
-			Filters: linkResp.Filters,
-		}
-
-		if linkResp.URL.IsSet() {
-			link.URL = linkResp.URL.Value.String()
-		}
-
-		links = append(links, link)
+		links = append(links, linkFromResponse(&listResp.Links[i]))
 	}
 
 	return links, nil
@@ -236,3 +217,121 @@ func (c *ScrapperClient) UpdateNotificationSettings(ctx context.Context, chatID
 		return &domainerrors.ErrInternalServer{Message: "неожиданный ответ сервера при обновлении настроек уведомлений"}
 	}
 }
+
+// UpdateQuietHours задаёт часы тишины чата. Значение nil отключает их.
+func (c *ScrapperClient) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	req := v1_scrapper.UpdateQuietHoursRequest{Enabled: quietHours != nil}
+
+	if quietHours != nil {
+		req.StartHour = v1_scrapper.NewOptInt32(int32(quietHours.Start.Hour()))     //nolint:gosec // значения часов и минут валидны
+		req.StartMinute = v1_scrapper.NewOptInt32(int32(quietHours.Start.Minute())) //nolint:gosec // значения часов и минут валидны
+		req.EndHour = v1_scrapper.NewOptInt32(int32(quietHours.End.Hour()))         //nolint:gosec // значения часов и минут валидны
+		req.EndMinute = v1_scrapper.NewOptInt32(int32(quietHours.End.Minute()))     //nolint:gosec // значения часов и минут валидны
+		req.Timezone = v1_scrapper.NewOptString(quietHours.Timezone)
+	}
+
+	params := v1_scrapper.QuietHoursPostParams{
+		TgChatID: chatID,
+	}
+
+	resp, err := c.client.QuietHoursPost(ctx, &req, params)
+	if err != nil {
+		return fmt.Errorf("не удалось обновить часы тишины: %w", err)
+	}
+
+	switch r := resp.(type) {
+	case *v1_scrapper.QuietHoursPostOK:
+		return nil
+	case *v1_scrapper.QuietHoursPostNotFound:
+		return &domainerrors.ErrChatNotFound{ChatID: chatID}
+	case *v1_scrapper.QuietHoursPostBadRequest:
+		return &domainerrors.ErrBadRequest{Message: r.Description.Value}
+	default:
+		return &domainerrors.ErrInternalServer{Message: "неожиданный ответ сервера при обновлении часов тишины"}
+	}
+}
+
+func (c *ScrapperClient) MuteLink(ctx context.Context, chatID int64, linkURL string, until time.Time,
+	summary bool) (*models.Link, error) {
+	parsedURL, err := url.Parse(linkURL)
+	if err != nil {
+		return nil, &domainerrors.ErrInvalidArgument{Message: "некорректный URL"}
+	}
+
+	req := &v1_scrapper.MuteLinkRequest{
+		Link:       *parsedURL,
+		MutedUntil: until,
+		Summary:    v1_scrapper.NewOptBool(summary),
+	}
+
+	params := v1_scrapper.LinksMutePostParams{
+		TgChatID: chatID,
+	}
+
+	resp, err := c.client.LinksMutePost(ctx, req, params)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось заглушить ссылку: %w", err)
+	}
+
+	switch r := resp.(type) {
+	case *v1_scrapper.LinkResponse:
+		return linkFromResponse(r), nil
+	case *v1_scrapper.LinksMutePostNotFound:
+		return nil, &domainerrors.ErrLinkNotFound{URL: linkURL}
+	case *v1_scrapper.LinksMutePostBadRequest:
+		return nil, &domainerrors.ErrBadRequest{Message: r.Description.Value}
+	default:
+		return nil, &domainerrors.ErrInternalServer{Message: "неожиданный ответ сервера при заглушении ссылки"}
+	}
+}
+
+func (c *ScrapperClient) UnmuteLink(ctx context.Context, chatID int64, linkURL string) (*models.Link, error) {
+	parsedURL, err := url.Parse(linkURL)
+	if err != nil {
+		return nil, &domainerrors.ErrInvalidArgument{Message: "некорректный URL"}
+	}
+
+	req := &v1_scrapper.RemoveLinkRequest{
+		Link: v1_scrapper.NewOptURI(*parsedURL),
+	}
+
+	params := v1_scrapper.LinksMuteDeleteParams{
+		TgChatID: chatID,
+	}
+
+	resp, err := c.client.LinksMuteDelete(ctx, req, params)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось снять заглушение: %w", err)
+	}
+
+	switch r := resp.(type) {
+	case *v1_scrapper.LinkResponse:
+		return linkFromResponse(r), nil
+	case *v1_scrapper.LinksMuteDeleteNotFound:
+		return nil, &domainerrors.ErrLinkNotFound{URL: linkURL}
+	case *v1_scrapper.LinksMuteDeleteBadRequest:
+		return nil, &domainerrors.ErrBadRequest{Message: r.Description.Value}
+	default:
+		return nil, &domainerrors.ErrInternalServer{Message: "неожиданный ответ сервера при снятии заглушения"}
+	}
+}
+
+func linkFromResponse(linkResp *v1_scrapper.LinkResponse) *models.Link {
+	link := &models.Link{
+		ID:       linkResp.ID.Or(0),
+		Tags:     linkResp.Tags,
+		Filters:  linkResp.Filters,
+		Keywords: linkResp.Keywords,
+	}
+
+	if linkResp.URL.IsSet() {
+		link.URL = linkResp.URL.Value.String()
+	}
+
+	if linkResp.MutedUntil.IsSet() {
+		mutedUntil := linkResp.MutedUntil.Value
+		link.MutedUntil = &mutedUntil
+	}
+
+	return link
+}
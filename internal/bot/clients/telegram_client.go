@@ -8,6 +8,7 @@ import (
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/bot/domain"
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 )
 
@@ -45,8 +46,15 @@ func (c *TelegramClient) SendUpdate(_ context.Context, update any) error {
 		return fmt.Errorf("неправильный тип данных для обновления")
 	}
 
+	description := linkUpdate.Description
+
+	// Описание дайджеста уже размечено, поэтому экранируется только описание события с найденными ключевыми словами.
+	if len(linkUpdate.Keywords) > 0 {
+		description = common.HighlightKeywords(description, linkUpdate.Keywords)
+	}
+
 	for _, chatID := range linkUpdate.TgChatIDs {
-		message := fmt.Sprintf("🔔 *Обновление ссылки*\n\n🔗 [%s](%s)\n\n📝 %s", linkUpdate.URL, linkUpdate.URL, linkUpdate.Description)
+		message := fmt.Sprintf("🔔 *Обновление ссылки*\n\n🔗 [%s](%s)\n\n📝 %s", linkUpdate.URL, linkUpdate.URL, description)
 
 		msg := tgbotapi.NewMessage(chatID, message)
 		msg.ParseMode = tgbotapi.ModeMarkdown
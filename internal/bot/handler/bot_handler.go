@@ -25,6 +25,7 @@ func NewBotHandler(linkUpdater linkUpdater) *BotHandler {
 func (h *BotHandler) UpdatesPost(ctx context.Context, req *v1_bot.LinkUpdate) (v1_bot.UpdatesPostRes, error) {
 	update := &models.LinkUpdate{
 		TgChatIDs: req.TgChatIds,
+		Keywords:  req.Keywords,
 	}
 
 	if !req.URL.IsSet() && !req.Description.IsSet() {
@@ -34,13 +34,20 @@ type ScrapperClient interface {
 
 	DeleteChat(ctx context.Context, chatID int64) error
 
-	AddLink(ctx context.Context, chatID int64, url string, tags []string, filters []string) (*models.Link, error)
+	AddLink(ctx context.Context, chatID int64, url string, tags, filters, keywords []string,
+		mode models.NotificationMode) (*models.Link, error)
 
 	RemoveLink(ctx context.Context, chatID int64, url string) (*models.Link, error)
 
 	GetLinks(ctx context.Context, chatID int64) ([]*models.Link, error)
 
 	UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode, digestTime time.Time) error
+
+	UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error
+
+	MuteLink(ctx context.Context, chatID int64, url string, until time.Time, summary bool) (*models.Link, error)
+
+	UnmuteLink(ctx context.Context, chatID int64, url string) (*models.Link, error)
 }
 
 type Transactor interface {
@@ -92,6 +99,12 @@ func (s *BotService) ProcessCommand(ctx context.Context, command *models.Command
 		return s.handleModeCommand(ctx, command)
 	case models.CommandTime:
 		return s.handleTimeCommand(ctx, command)
+	case models.CommandQuiet:
+		return s.handleQuietCommand(ctx, command)
+	case models.CommandMute:
+		return s.handleMuteCommand(ctx, command)
+	case models.CommandUnmute:
+		return s.handleUnmuteCommand(ctx, command)
 	default:
 		return "Неизвестная команда. Введите /help для просмотра доступных команд.",
 			&domainerrors.ErrUnknownCommand{Command: string(command.Type)}
@@ -113,12 +126,20 @@ func (s *BotService) ProcessMessage(ctx context.Context, chatID, _ int64, text,
 		return s.handleTagsInput(ctx, chatID, text)
 	case models.StateAwaitingFilters:
 		return s.handleFiltersInput(ctx, chatID, text)
+	case models.StateAwaitingKeywords:
+		return s.handleKeywordsInput(ctx, chatID, text)
+	case models.StateAwaitingLinkMode:
+		return s.handleLinkModeInput(ctx, chatID, text)
 	case models.StateAwaitingUntrackLink:
 		return s.handleUntrackLinkInput(ctx, chatID, text)
 	case models.StateAwaitingNotificationMode:
 		return s.handleNotificationModeInput(ctx, chatID, text)
 	case models.StateAwaitingDigestTime:
 		return s.handleDigestTimeInput(ctx, chatID, text)
+	case models.StateAwaitingSelector:
+		return s.handleSelectorInput(ctx, chatID, text)
+	case models.StateAwaitingQuietHours:
+		return s.handleQuietHoursInput(ctx, chatID, text)
 	default:
 		return "", fmt.Errorf("неизвестное состояние чата: %d", state)
 	}
@@ -168,7 +189,10 @@ func (s *BotService) handleHelpCommand(ctx context.Context, command *models.Comm
 /untrack - прекратить отслеживание ссылки
 /list - показать список отслеживаемых ссылок
 /mode - изменить режим уведомлений (мгновенный/дайджест)
-/time - установить время доставки дайджеста`, nil
+/time - установить время доставки дайджеста
+/quiet - настроить часы тишины
+/mute - заглушить уведомления по ссылке, например /mute <ссылка> 3d
+/unmute - снять заглушение ссылки`, nil
 }
 
 func (s *BotService) handleTrackCommand(ctx context.Context, command *models.Command) (string, error) {
@@ -235,6 +259,14 @@ func (s *BotService) handleListCommand(ctx context.Context, command *models.Comm
 		if len(link.Filters) > 0 {
 			sb.WriteString(fmt.Sprintf("   Фильтры: %s\n", strings.Join(link.Filters, ", ")))
 		}
+
+		if len(link.Keywords) > 0 {
+			sb.WriteString(fmt.Sprintf("   Ключевые слова: %s\n", strings.Join(link.Keywords, ", ")))
+		}
+
+		if line := mutedLine(link, time.Now()); line != "" {
+			sb.WriteString(line)
+		}
 	}
 
 	return sb.String(), nil
@@ -243,11 +275,66 @@ func (s *BotService) handleListCommand(ctx context.Context, command *models.Comm
 func (s *BotService) handleLinkInput(ctx context.Context, chatID int64, text string) (string, error) {
 	linkType := s.linkAnalyzer.AnalyzeLink(text)
 	if linkType == models.Unknown {
-		return "Неподдерживаемый тип ссылки. Пожалуйста, введите ссылку на GitHub репозиторий или вопрос StackOverflow:", nil
+		return fmt.Sprintf("Неподдерживаемый тип ссылки. Можно отслеживать: %s. "+
+			"Пожалуйста, введите ссылку, начинающуюся с http:// или https://:",
+			strings.Join(s.linkAnalyzer.SupportedSources(), ", ")), nil
 	}
 
+	if linkType == models.WebPage {
+		_, selector, err := commonservice.ParseWebPageURL(text)
+		if err != nil {
+			return "Некорректная ссылка или CSS-селектор. Попробуйте ещё раз:", nil
+		}
+
+		if selector == "" {
+			return s.askForSelector(ctx, chatID, text)
+		}
+	}
+
+	return s.saveLinkAndAskForTags(ctx, chatID, text)
+}
+
+func (s *BotService) askForSelector(ctx context.Context, chatID int64, link string) (string, error) {
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-		if err := s.setDataWithEnsureChat(ctx, chatID, "link", text); err != nil {
+		if err := s.setDataWithEnsureChat(ctx, chatID, "link", link); err != nil {
+			return err
+		}
+
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateAwaitingSelector)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return "Введите CSS-селектор отслеживаемой части страницы или просто напишите 'нет', чтобы следить за всей страницей:", nil
+}
+
+func (s *BotService) handleSelectorInput(ctx context.Context, chatID int64, text string) (string, error) {
+	linkInterface, err := s.chatStateRepo.GetData(ctx, chatID, "link")
+	if err != nil {
+		return "", err
+	}
+
+	link, ok := linkInterface.(string)
+	if !ok {
+		return "", fmt.Errorf("некорректный тип данных для ссылки")
+	}
+
+	if !strings.EqualFold(text, "нет") {
+		link = commonservice.WebPageURL(link, strings.TrimSpace(text))
+
+		if _, _, err := commonservice.ParseWebPageURL(link); err != nil {
+			return "Некорректный CSS-селектор. Попробуйте ещё раз или напишите 'нет':", nil
+		}
+	}
+
+	return s.saveLinkAndAskForTags(ctx, chatID, link)
+}
+
+func (s *BotService) saveLinkAndAskForTags(ctx context.Context, chatID int64, link string) (string, error) {
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.setDataWithEnsureChat(ctx, chatID, "link", link); err != nil {
 			return err
 		}
 
@@ -288,20 +375,78 @@ func (s *BotService) handleTagsInput(ctx context.Context, chatID int64, text str
 		return "", err
 	}
 
-	return "Введите фильтры для уведомлений (разделите пробелами) или просто напишите 'нет' для пропуска:", nil
+	return "Введите фильтры для уведомлений, каждый с новой строки или через ';', или просто напишите 'нет' для пропуска.\n" +
+		"Например: author=dependabot; include type=release or type=pr; exclude title~\"^WIP\" and not label=bug.\n" +
+		"Поля: author, type, title, text, label. Типы: " + strings.Join(commonservice.UpdateTypes(), ", ") + ".", nil
 }
 
 func (s *BotService) handleFiltersInput(ctx context.Context, chatID int64, text string) (string, error) {
 	var filters []string
 
-	var link string
+	if !strings.EqualFold(text, "нет") {
+		filters = splitFilters(text)
 
-	var tags []string
+		if _, err := commonservice.ParseFilters(filters); err != nil {
+			return err.Error() + ". Исправьте фильтры или напишите 'нет':", nil
+		}
+	}
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.setDataWithEnsureChat(ctx, chatID, "filters", filters); err != nil {
+			return err
+		}
+
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateAwaitingKeywords)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return "Введите ключевые слова через запятую, чтобы получать только обновления, где они упоминаются, " +
+		"например: deadlock, CVE-. Или просто напишите 'нет' для пропуска:", nil
+}
+
+func (s *BotService) handleKeywordsInput(ctx context.Context, chatID int64, text string) (string, error) {
+	var keywords []string
 
 	if !strings.EqualFold(text, "нет") {
-		filters = strings.Fields(text)
+		keywords = commonservice.NormalizeKeywords(strings.Split(text, ","))
 	}
 
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.setDataWithEnsureChat(ctx, chatID, "keywords", keywords); err != nil {
+			return err
+		}
+
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateAwaitingLinkMode)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return "Выберите режим уведомлений для этой ссылки:\n1. Мгновенные уведомления\n2. Дайджест\n" +
+		"Или просто напишите 'нет', чтобы использовать режим чата:", nil
+}
+
+func (s *BotService) handleLinkModeInput(ctx context.Context, chatID int64, text string) (string, error) {
+	var mode models.NotificationMode
+
+	switch input := strings.TrimSpace(text); {
+	case input == "1":
+		mode = models.NotificationModeInstant
+	case input == "2":
+		mode = models.NotificationModeDigest
+	case strings.EqualFold(input, "нет"):
+	default:
+		return "Неверный ввод. Введите 1 для мгновенных уведомлений, 2 для дайджеста или 'нет'.", nil
+	}
+
+	var link string
+
+	var tags, filters, keywords []string
+
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		linkInterface, err := s.chatStateRepo.GetData(ctx, chatID, "link")
 		if err != nil {
@@ -315,30 +460,26 @@ func (s *BotService) handleFiltersInput(ctx context.Context, chatID int64, text
 
 		link = linkStr
 
-		tagsInterface, err := s.chatStateRepo.GetData(ctx, chatID, "tags")
-		if err != nil {
+		if tags, err = s.getStringsData(ctx, chatID, "tags"); err != nil {
 			return err
 		}
 
-		tagsSlice, ok := tagsInterface.([]string)
-		if !ok {
-			tagsSlice = []string{}
+		if filters, err = s.getStringsData(ctx, chatID, "filters"); err != nil {
+			return err
 		}
 
-		tags = tagsSlice
-
-		if err := s.setStateWithEnsureChat(ctx, chatID, models.StateIdle); err != nil {
+		if keywords, err = s.getStringsData(ctx, chatID, "keywords"); err != nil {
 			return err
 		}
 
-		return nil
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateIdle)
 	})
 
 	if err != nil {
 		return "", err
 	}
 
-	_, err = s.scrapperClient.AddLink(ctx, chatID, link, tags, filters)
+	_, err = s.scrapperClient.AddLink(ctx, chatID, link, tags, filters, keywords, mode)
 	if err != nil {
 		var linkExistsErr *domainerrors.ErrLinkAlreadyExists
 		if errors.As(err, &linkExistsErr) {
@@ -351,6 +492,44 @@ func (s *BotService) handleFiltersInput(ctx context.Context, chatID int64, text
 	return "Ссылка успешно добавлена для отслеживания!", nil
 }
 
+// getStringsData читает список строк из данных чата. После хранения в JSON список возвращается как []any.
+func (s *BotService) getStringsData(ctx context.Context, chatID int64, key string) ([]string, error) {
+	value, err := s.chatStateRepo.GetData(ctx, chatID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	switch values := value.(type) {
+	case []string:
+		return values, nil
+	case []any:
+		result := make([]string, 0, len(values))
+
+		for _, item := range values {
+			if str, ok := item.(string); ok {
+				result = append(result, str)
+			}
+		}
+
+		return result, nil
+	default:
+		return []string{}, nil
+	}
+}
+
+// splitFilters разделяет ввод на фильтры: каждый фильтр занимает строку или отделяется точкой с запятой.
+func splitFilters(text string) []string {
+	var filters []string
+
+	for _, filter := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == ';' }) {
+		if filter = strings.TrimSpace(filter); filter != "" {
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters
+}
+
 func (s *BotService) handleUntrackLinkInput(ctx context.Context, chatID int64, text string) (string, error) {
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		return s.setStateWithEnsureChat(ctx, chatID, models.StateIdle)
@@ -362,8 +541,7 @@ func (s *BotService) handleUntrackLinkInput(ctx context.Context, chatID int64, t
 
 	_, err = s.scrapperClient.RemoveLink(ctx, chatID, text)
 	if err != nil {
-		var linkNotFoundErr *domainerrors.ErrLinkNotFound
-		if errors.As(err, &linkNotFoundErr) {
+		if linkNotTracked(err) {
 			return "Указанная ссылка не отслеживается.", nil
 		}
 
@@ -479,6 +657,226 @@ func (s *BotService) handleDigestTimeInput(ctx context.Context, chatID int64, te
 	return fmt.Sprintf("Время доставки дайджеста установлено на %02d:%02d.", hour, minute), nil
 }
 
+func (s *BotService) handleQuietCommand(ctx context.Context, command *models.Command) (string, error) {
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.setStateWithEnsureChat(ctx, command.ChatID, models.StateAwaitingQuietHours); err != nil {
+			return err
+		}
+
+		return s.clearDataWithEnsureChat(ctx, command.ChatID)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return "Введите часы тишины в формате ЧЧ:ММ-ЧЧ:ММ и часовой пояс (например, 23:00-08:00 Europe/Moscow). " +
+		"Без часового пояса используется UTC. Введите 'нет', чтобы отключить часы тишины.", nil
+}
+
+func (s *BotService) handleQuietHoursInput(ctx context.Context, chatID int64, text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	var quietHours *models.QuietHours
+
+	if !strings.EqualFold(text, "нет") {
+		var reply string
+
+		quietHours, reply = parseQuietHours(text)
+		if quietHours == nil {
+			return reply, nil
+		}
+	}
+
+	if err := s.scrapperClient.UpdateQuietHours(ctx, chatID, quietHours); err != nil {
+		return "", fmt.Errorf("ошибка при обновлении часов тишины: %w", err)
+	}
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateIdle)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if quietHours == nil {
+		return "Часы тишины отключены.", nil
+	}
+
+	return fmt.Sprintf("Часы тишины установлены с %s до %s (%s). Уведомления за это время придут одним сообщением.",
+		quietHours.Start.Format("15:04"), quietHours.End.Format("15:04"), quietHours.Timezone), nil
+}
+
+// parseQuietHours разбирает ввод вида "ЧЧ:ММ-ЧЧ:ММ [часовой пояс]". Если ввод некорректен,
+// возвращает nil и подсказку для пользователя.
+func parseQuietHours(text string) (*models.QuietHours, string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, "Неверный формат. Используйте ЧЧ:ММ-ЧЧ:ММ и часовой пояс, например 23:00-08:00 Europe/Moscow."
+	}
+
+	bounds := strings.Split(fields[0], "-")
+	if len(bounds) != 2 {
+		return nil, "Неверный формат. Используйте ЧЧ:ММ-ЧЧ:ММ и часовой пояс, например 23:00-08:00 Europe/Moscow."
+	}
+
+	start, errStart := time.Parse("15:04", bounds[0])
+	end, errEnd := time.Parse("15:04", bounds[1])
+
+	if errStart != nil || errEnd != nil {
+		return nil, "Неверное время. Часы должны быть от 00 до 23, минуты от 00 до 59."
+	}
+
+	timezone := "UTC"
+	if len(fields) == 2 {
+		timezone = fields[1]
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Sprintf("Неизвестный часовой пояс '%s'. Используйте имя из базы IANA, например Europe/Moscow.", timezone)
+	}
+
+	return &models.QuietHours{Start: start, End: end, Timezone: timezone}, ""
+}
+
+const (
+	muteUsage = "Используйте /mute <ссылка> <срок> [тихо], например /mute https://github.com/owner/repo 3d. " +
+		"Срок задаётся в минутах (m), часах (h), днях (d) или неделях (w). " +
+		"Со словом 'тихо' сводка пропущенных обновлений после заглушения не придёт."
+	unmuteUsage     = "Используйте /unmute <ссылка>, например /unmute https://github.com/owner/repo."
+	maxMuteDuration = 365 * 24 * time.Hour
+	muteTimeLayout  = "02.01.2006 15:04"
+)
+
+// handleMuteCommand разбирает команду вида "/mute <ссылка> <срок> [тихо]" и заглушает ссылку.
+func (s *BotService) handleMuteCommand(ctx context.Context, command *models.Command) (string, error) {
+	if err := s.resetState(ctx, command.ChatID); err != nil {
+		return "", err
+	}
+
+	args := commandArgs(command.Text)
+	if len(args) < 2 || len(args) > 3 {
+		return muteUsage, nil
+	}
+
+	duration, ok := parseMuteDuration(args[1])
+	if !ok {
+		return "Неверный срок. " + muteUsage, nil
+	}
+
+	summary := true
+
+	if len(args) == 3 {
+		if !strings.EqualFold(args[2], "тихо") {
+			return muteUsage, nil
+		}
+
+		summary = false
+	}
+
+	until := time.Now().Add(duration).UTC()
+
+	if _, err := s.scrapperClient.MuteLink(ctx, command.ChatID, args[0], until, summary); err != nil {
+		if linkNotTracked(err) {
+			return "Указанная ссылка не отслеживается.", nil
+		}
+
+		return "", fmt.Errorf("ошибка при заглушении ссылки: %w", err)
+	}
+
+	reply := fmt.Sprintf("Уведомления по ссылке заглушены до %s UTC.", until.Format(muteTimeLayout))
+	if summary {
+		reply += " Когда заглушение закончится, придёт сводка пропущенных обновлений."
+	}
+
+	return reply, nil
+}
+
+// handleUnmuteCommand разбирает команду вида "/unmute <ссылка>" и снимает заглушение ссылки досрочно.
+func (s *BotService) handleUnmuteCommand(ctx context.Context, command *models.Command) (string, error) {
+	if err := s.resetState(ctx, command.ChatID); err != nil {
+		return "", err
+	}
+
+	args := commandArgs(command.Text)
+	if len(args) != 1 {
+		return unmuteUsage, nil
+	}
+
+	if _, err := s.scrapperClient.UnmuteLink(ctx, command.ChatID, args[0]); err != nil {
+		if linkNotTracked(err) {
+			return "Указанная ссылка не отслеживается.", nil
+		}
+
+		return "", fmt.Errorf("ошибка при снятии заглушения: %w", err)
+	}
+
+	return "Уведомления по ссылке снова включены.", nil
+}
+
+// linkNotTracked сообщает, что ссылка не найдена или есть, но не отслеживается чатом.
+func linkNotTracked(err error) bool {
+	var (
+		linkNotFoundErr  *domainerrors.ErrLinkNotFound
+		linkNotInChatErr *domainerrors.ErrLinkNotInChat
+	)
+
+	return errors.As(err, &linkNotFoundErr) || errors.As(err, &linkNotInChatErr)
+}
+
+func (s *BotService) resetState(ctx context.Context, chatID int64) error {
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.setStateWithEnsureChat(ctx, chatID, models.StateIdle)
+	})
+}
+
+// commandArgs возвращает аргументы команды: слова текста сообщения после самой команды.
+func commandArgs(text string) []string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return fields[1:]
+}
+
+// parseMuteDuration разбирает срок заглушения вида "30m", "12h", "3d" или "2w".
+// Срок должен быть положительным и не длиннее года.
+func parseMuteDuration(text string) (time.Duration, bool) {
+	units := map[byte]time.Duration{
+		'm': time.Minute,
+		'h': time.Hour,
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+	}
+
+	if len(text) < 2 {
+		return 0, false
+	}
+
+	unit, ok := units[text[len(text)-1]]
+	if !ok {
+		return 0, false
+	}
+
+	value, err := strconv.Atoi(text[:len(text)-1])
+	if err != nil || value <= 0 || time.Duration(value) > maxMuteDuration/unit {
+		return 0, false
+	}
+
+	return time.Duration(value) * unit, true
+}
+
+// mutedLine возвращает строку списка ссылок о заглушении или пустую строку, если ссылка не заглушена.
+func mutedLine(link *models.Link, now time.Time) string {
+	if link.MutedUntil == nil || !now.Before(*link.MutedUntil) {
+		return ""
+	}
+
+	return fmt.Sprintf("   Заглушена до %s UTC\n", link.MutedUntil.UTC().Format(muteTimeLayout))
+}
+
 func (s *BotService) setStateWithEnsureChat(ctx context.Context, chatID int64, state models.ChatState) error {
 	err := s.chatStateRepo.SetState(ctx, chatID, state)
 	if isForeignKeyOrNotFoundErr(err) {
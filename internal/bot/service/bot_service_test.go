@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	domainmocks "github.com/central-university-dev/go-Matthew11K/internal/bot/domain/mocks"
 	repomocks "github.com/central-university-dev/go-Matthew11K/internal/bot/repository/mocks"
@@ -28,7 +29,7 @@ func TestBotService_ProcessCommand_UnknownCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -54,7 +55,7 @@ func TestBotService_ProcessCommand_StartCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -90,7 +91,7 @@ func TestBotService_ProcessCommand_HelpCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -128,7 +129,7 @@ func TestBotService_ProcessCommand_TrackCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -166,7 +167,7 @@ func TestBotService_ProcessCommand_ListCommand_EmptyList(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -194,7 +195,7 @@ func TestBotService_ProcessCommand_ListCommand_WithLinks(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -243,7 +244,7 @@ func TestBotService_ProcessMessage_AddingLink(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -292,7 +293,7 @@ func TestBotService_ProcessMessage_CompleteAddingLink(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -302,7 +303,7 @@ func TestBotService_ProcessMessage_CompleteAddingLink(t *testing.T) {
 	username := testUsername
 	linkURL := testRepoURL
 	tags := []string{"tag1", "tag2"}
-	filters := []string{"filter1", "filter2"}
+	filters := []string{"author=bot", "include type=release or type=pr"}
 
 	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingLink, nil).Once()
 	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
@@ -343,27 +344,72 @@ func TestBotService_ProcessMessage_CompleteAddingLink(t *testing.T) {
 	mockTxManager = new(mocks.TxManager)
 
 	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingFilters, nil).Once()
+	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			txFunc := args.Get(1).(func(context.Context) error)
+			_ = txFunc(ctx)
+		})
+	mockChatStateRepo.On("SetData", ctx, chatID, "filters", filters).Return(nil).Once()
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateAwaitingKeywords).Return(nil).Once()
+
+	botService = service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
+	step3Response, err := botService.ProcessMessage(ctx, chatID, userID, "author=bot\ninclude type=release or type=pr", username)
+	require.NoError(t, err)
+	assert.Contains(t, step3Response, "Введите ключевые слова")
+	mockChatStateRepo.AssertExpectations(t)
+	mockTxManager.AssertExpectations(t)
+
+	mockChatStateRepo = new(repomocks.ChatStateRepository)
+	mockScrapperClient = new(mockservices.ScrapperClient)
+	mockTxManager = new(mocks.TxManager)
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingKeywords, nil).Once()
+	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			txFunc := args.Get(1).(func(context.Context) error)
+			_ = txFunc(ctx)
+		})
+	mockChatStateRepo.On("SetData", ctx, chatID, "keywords", []string{"deadlock", "CVE-"}).Return(nil).Once()
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateAwaitingLinkMode).Return(nil).Once()
+
+	botService = service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
+	step4Response, err := botService.ProcessMessage(ctx, chatID, userID, "deadlock, CVE-, ", username)
+	require.NoError(t, err)
+	assert.Contains(t, step4Response, "Выберите режим уведомлений для этой ссылки")
+	mockChatStateRepo.AssertExpectations(t)
+	mockTxManager.AssertExpectations(t)
+
+	mockChatStateRepo = new(repomocks.ChatStateRepository)
+	mockScrapperClient = new(mockservices.ScrapperClient)
+	mockTxManager = new(mocks.TxManager)
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingLinkMode, nil).Once()
 	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
 		Run(func(args mock.Arguments) {
 			txFunc := args.Get(1).(func(context.Context) error)
 			_ = txFunc(ctx)
 		})
 	mockChatStateRepo.On("GetData", ctx, chatID, "link").Return(linkURL, nil).Once()
-	mockChatStateRepo.On("GetData", ctx, chatID, "tags").Return(tags, nil).Once()
+	// Данные чата хранятся в JSON, поэтому списки читаются как []any.
+	mockChatStateRepo.On("GetData", ctx, chatID, "tags").Return([]any{"tag1", "tag2"}, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "filters").Return([]any{filters[0], filters[1]}, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "keywords").Return([]any{"deadlock", "CVE-"}, nil).Once()
 	mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
-	mockScrapperClient.On("AddLink", ctx, chatID, linkURL, tags, filters).Return(&models.Link{
-		ID:      1,
-		URL:     linkURL,
-		Type:    models.GitHub,
-		Tags:    tags,
-		Filters: filters,
+	mockScrapperClient.On("AddLink", ctx, chatID, linkURL, tags, filters, []string{"deadlock", "CVE-"},
+		models.NotificationModeDigest).Return(&models.Link{
+		ID:       1,
+		URL:      linkURL,
+		Type:     models.GitHub,
+		Tags:     tags,
+		Filters:  filters,
+		Keywords: []string{"deadlock", "CVE-"},
 	}, nil).Once()
 
 	botService = service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
-	step3Response, err := botService.ProcessMessage(ctx, chatID, userID, "filter1 filter2", username)
+	step5Response, err := botService.ProcessMessage(ctx, chatID, userID, "2", username)
 	require.NoError(t, err)
-	assert.Contains(t, step3Response, "Ссылка")
-	assert.Contains(t, step3Response, "добавлена для отслеживания")
+	assert.Contains(t, step5Response, "Ссылка")
+	assert.Contains(t, step5Response, "добавлена для отслеживания")
 	mockChatStateRepo.AssertExpectations(t)
 	mockTxManager.AssertExpectations(t)
 	mockScrapperClient.AssertExpectations(t)
@@ -374,7 +420,7 @@ func TestBotService_SendLinkUpdate(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -399,7 +445,7 @@ func TestBotService_ProcessCommand_UntrackCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -434,7 +480,7 @@ func TestBotService_ProcessMessage_UntrackLink(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -468,12 +514,237 @@ func TestBotService_ProcessMessage_UntrackLink(t *testing.T) {
 	mockTxManager.AssertExpectations(t)
 }
 
+func TestBotService_ProcessMessage_QuietHours(t *testing.T) {
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	tests := []struct {
+		name       string
+		input      string
+		quietHours *models.QuietHours
+		response   string
+	}{
+		{
+			name:  "window with timezone",
+			input: "23:00-08:30 Europe/Moscow",
+			quietHours: &models.QuietHours{
+				Start:    time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC),
+				End:      time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC),
+				Timezone: "Europe/Moscow",
+			},
+			response: "Часы тишины установлены с 23:00 до 08:30 (Europe/Moscow)",
+		},
+		{
+			name:     "disable",
+			input:    "Нет",
+			response: "Часы тишины отключены",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatStateRepo := new(repomocks.ChatStateRepository)
+			mockScrapperClient := new(mockservices.ScrapperClient)
+			mockTxManager := new(mocks.TxManager)
+			linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+			botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, new(domainmocks.TelegramClientAPI),
+				linkAnalyzer, mockTxManager)
+
+			mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingQuietHours, nil).Once()
+			mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+				Run(func(args mock.Arguments) {
+					txFunc := args.Get(1).(func(context.Context) error)
+					_ = txFunc(ctx)
+				})
+			mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
+			mockScrapperClient.On("UpdateQuietHours", ctx, chatID, tt.quietHours).Return(nil).Once()
+
+			response, err := botService.ProcessMessage(ctx, chatID, 654321, tt.input, testUsername)
+
+			require.NoError(t, err)
+			assert.Contains(t, response, tt.response)
+			mockChatStateRepo.AssertExpectations(t)
+			mockScrapperClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBotService_ProcessMessage_QuietHoursInvalidTimezone(t *testing.T) {
+	mockChatStateRepo := new(repomocks.ChatStateRepository)
+	mockScrapperClient := new(mockservices.ScrapperClient)
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, new(domainmocks.TelegramClientAPI),
+		linkAnalyzer, new(mocks.TxManager))
+
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingQuietHours, nil).Once()
+
+	response, err := botService.ProcessMessage(ctx, chatID, 654321, "23:00-08:00 Mars/Olympus", testUsername)
+
+	require.NoError(t, err)
+	assert.Contains(t, response, "Неизвестный часовой пояс 'Mars/Olympus'")
+	mockScrapperClient.AssertNotCalled(t, "UpdateQuietHours", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBotService_ProcessCommand_MuteCommand(t *testing.T) {
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	tests := []struct {
+		name     string
+		text     string
+		duration time.Duration
+		summary  bool
+		response string
+	}{
+		{
+			name:     "days with summary",
+			text:     "/mute " + testRepoURL + " 3d",
+			duration: 72 * time.Hour,
+			summary:  true,
+			response: "придёт сводка пропущенных обновлений",
+		},
+		{
+			name:     "hours without summary",
+			text:     "/mute " + testRepoURL + " 12h тихо",
+			duration: 12 * time.Hour,
+			response: "Уведомления по ссылке заглушены до",
+		},
+		{
+			name:     "missing duration",
+			text:     "/mute " + testRepoURL,
+			response: "Используйте /mute <ссылка> <срок> [тихо]",
+		},
+		{
+			name:     "unknown unit",
+			text:     "/mute " + testRepoURL + " 3y",
+			response: "Неверный срок",
+		},
+		{
+			name:     "longer than a year",
+			text:     "/mute " + testRepoURL + " 60w",
+			response: "Неверный срок",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatStateRepo := new(repomocks.ChatStateRepository)
+			mockScrapperClient := new(mockservices.ScrapperClient)
+			mockTxManager := new(mocks.TxManager)
+			linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+			botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, new(domainmocks.TelegramClientAPI),
+				linkAnalyzer, mockTxManager)
+
+			mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+				Run(func(args mock.Arguments) {
+					txFunc := args.Get(1).(func(context.Context) error)
+					_ = txFunc(ctx)
+				})
+			mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
+
+			if tt.duration > 0 {
+				expected := time.Now().Add(tt.duration)
+				mockScrapperClient.On("MuteLink", ctx, chatID, testRepoURL, mock.MatchedBy(func(until time.Time) bool {
+					return until.Sub(expected).Abs() < time.Minute
+				}), tt.summary).Return(&models.Link{URL: testRepoURL}, nil).Once()
+			}
+
+			response, err := botService.ProcessCommand(ctx, &models.Command{
+				Type:   models.CommandMute,
+				ChatID: chatID,
+				Text:   tt.text,
+			})
+
+			require.NoError(t, err)
+			assert.Contains(t, response, tt.response)
+			mockScrapperClient.AssertExpectations(t)
+
+			if tt.duration == 0 {
+				mockScrapperClient.AssertNotCalled(t, "MuteLink", mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestBotService_ProcessCommand_UnmuteCommand_NotTracked(t *testing.T) {
+	mockChatStateRepo := new(repomocks.ChatStateRepository)
+	mockScrapperClient := new(mockservices.ScrapperClient)
+	mockTxManager := new(mocks.TxManager)
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, new(domainmocks.TelegramClientAPI),
+		linkAnalyzer, mockTxManager)
+
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			txFunc := args.Get(1).(func(context.Context) error)
+			_ = txFunc(ctx)
+		})
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
+	mockScrapperClient.On("UnmuteLink", ctx, chatID, testRepoURL).
+		Return(nil, &errors.ErrLinkNotFound{URL: testRepoURL}).Once()
+
+	response, err := botService.ProcessCommand(ctx, &models.Command{
+		Type:   models.CommandUnmute,
+		ChatID: chatID,
+		Text:   "/unmute " + testRepoURL,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Указанная ссылка не отслеживается.", response)
+	mockScrapperClient.AssertExpectations(t)
+}
+
+func TestBotService_ProcessCommand_MuteCommand_NotInChat(t *testing.T) {
+	mockChatStateRepo := new(repomocks.ChatStateRepository)
+	mockScrapperClient := new(mockservices.ScrapperClient)
+	mockTxManager := new(mocks.TxManager)
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, new(domainmocks.TelegramClientAPI),
+		linkAnalyzer, mockTxManager)
+
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			txFunc := args.Get(1).(func(context.Context) error)
+			_ = txFunc(ctx)
+		})
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
+
+	// Ссылку отслеживают другие чаты, но не этот.
+	mockScrapperClient.On("MuteLink", ctx, chatID, testRepoURL, mock.AnythingOfType("time.Time"), true).
+		Return(nil, &errors.ErrLinkNotInChat{ChatID: chatID, LinkID: 1}).Once()
+
+	response, err := botService.ProcessCommand(ctx, &models.Command{
+		Type:   models.CommandMute,
+		ChatID: chatID,
+		Text:   "/mute " + testRepoURL + " 3d",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Указанная ссылка не отслеживается.", response)
+	mockScrapperClient.AssertExpectations(t)
+}
+
 func TestBotService_ProcessMessage_UntrackLink_NotFound(t *testing.T) {
 	mockChatStateRepo := new(repomocks.ChatStateRepository)
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -506,7 +777,7 @@ func TestBotService_ProcessMessage_AddingDuplicateLink(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -516,9 +787,9 @@ func TestBotService_ProcessMessage_AddingDuplicateLink(t *testing.T) {
 	username := testUsername
 	linkURL := testRepoURL
 	tags := []string{"tag1", "tag2"}
-	filters := []string{"filter1", "filter2"}
+	filters := []string{"author=bot", "include type=release or type=pr"}
 
-	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingFilters, nil).Once()
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingLinkMode, nil).Once()
 	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
 		Run(func(args mock.Arguments) {
 			txFunc := args.Get(1).(func(context.Context) error)
@@ -526,10 +797,13 @@ func TestBotService_ProcessMessage_AddingDuplicateLink(t *testing.T) {
 		})
 	mockChatStateRepo.On("GetData", ctx, chatID, "link").Return(linkURL, nil).Once()
 	mockChatStateRepo.On("GetData", ctx, chatID, "tags").Return(tags, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "filters").Return(filters, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "keywords").Return(nil, nil).Once()
 	mockChatStateRepo.On("SetState", ctx, chatID, models.StateIdle).Return(nil).Once()
-	mockScrapperClient.On("AddLink", ctx, chatID, linkURL, tags, filters).Return(nil, &errors.ErrLinkAlreadyExists{URL: linkURL}).Once()
+	mockScrapperClient.On("AddLink", ctx, chatID, linkURL, tags, filters, []string{}, models.NotificationMode("")).
+		Return(nil, &errors.ErrLinkAlreadyExists{URL: linkURL}).Once()
 
-	response, err := botService.ProcessMessage(ctx, chatID, userID, "filter1 filter2", username)
+	response, err := botService.ProcessMessage(ctx, chatID, userID, "нет", username)
 
 	require.NoError(t, err)
 	assert.Contains(t, response, "Эта ссылка уже отслеживается")
@@ -538,12 +812,84 @@ func TestBotService_ProcessMessage_AddingDuplicateLink(t *testing.T) {
 	mockTxManager.AssertExpectations(t)
 }
 
+func TestBotService_ProcessMessage_InvalidFilter(t *testing.T) {
+	mockChatStateRepo := new(repomocks.ChatStateRepository)
+	mockScrapperClient := new(mockservices.ScrapperClient)
+	mockTelegramClient := new(domainmocks.TelegramClientAPI)
+	mockTxManager := new(mocks.TxManager)
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
+
+	ctx := context.Background()
+	chatID := int64(123456)
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingFilters, nil).Once()
+
+	response, err := botService.ProcessMessage(ctx, chatID, 654321, "author=bot; title=Release", testUsername)
+
+	require.NoError(t, err)
+	assert.Contains(t, response, "поле title сравнивается только регулярным выражением")
+	assert.Contains(t, response, "Исправьте фильтры")
+	mockChatStateRepo.AssertNotCalled(t, "SetState", mock.Anything, mock.Anything, mock.Anything)
+	mockScrapperClient.AssertNotCalled(t, "AddLink",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything, mock.Anything)
+}
+
+func TestBotService_ProcessMessage_WebPageSelector(t *testing.T) {
+	mockChatStateRepo := new(repomocks.ChatStateRepository)
+	mockScrapperClient := new(mockservices.ScrapperClient)
+	mockTelegramClient := new(domainmocks.TelegramClientAPI)
+	mockTxManager := new(mocks.TxManager)
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
+
+	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
+
+	ctx := context.Background()
+	chatID := int64(123456)
+	userID := int64(654321)
+	pageURL := "https://status.example.com/"
+
+	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			txFunc := args.Get(1).(func(context.Context) error)
+			_ = txFunc(ctx)
+		})
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingLink, nil).Once()
+	mockChatStateRepo.On("SetData", ctx, chatID, "link", pageURL).Return(nil).Once()
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateAwaitingSelector).Return(nil).Once()
+
+	response, err := botService.ProcessMessage(ctx, chatID, userID, pageURL, testUsername)
+	require.NoError(t, err)
+	assert.Contains(t, response, "CSS-селектор")
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingSelector, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "link").Return(pageURL, nil).Once()
+
+	response, err = botService.ProcessMessage(ctx, chatID, userID, "div[", testUsername)
+	require.NoError(t, err)
+	assert.Contains(t, response, "Некорректный CSS-селектор")
+
+	mockChatStateRepo.On("GetState", ctx, chatID).Return(models.StateAwaitingSelector, nil).Once()
+	mockChatStateRepo.On("GetData", ctx, chatID, "link").Return(pageURL, nil).Once()
+	mockChatStateRepo.On("SetData", ctx, chatID, "link", commonservice.WebPageURL(pageURL, "div.status")).Return(nil).Once()
+	mockChatStateRepo.On("SetState", ctx, chatID, models.StateAwaitingTags).Return(nil).Once()
+
+	response, err = botService.ProcessMessage(ctx, chatID, userID, "div.status", testUsername)
+	require.NoError(t, err)
+	assert.Contains(t, response, "Введите теги")
+
+	mockChatStateRepo.AssertExpectations(t)
+}
+
 func TestBotService_ProcessMessage_LinkParsing(t *testing.T) {
 	mockChatStateRepo := new(repomocks.ChatStateRepository)
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -567,7 +913,7 @@ func TestBotService_ProcessMessage_LinkParsing(t *testing.T) {
 		{
 			name:          "Valid GitHub link with path",
 			link:          "https://github.com/owner/repo/tree/main",
-			expectedType:  models.GitHub,
+			expectedType:  models.GitHubCommits,
 			shouldProceed: true,
 		},
 		{
@@ -578,7 +924,7 @@ func TestBotService_ProcessMessage_LinkParsing(t *testing.T) {
 		},
 		{
 			name:          "Invalid link",
-			link:          "https://example.com",
+			link:          "ftp://example.com",
 			expectedType:  models.Unknown,
 			shouldProceed: false,
 		},
@@ -625,7 +971,7 @@ func TestBotService_ProcessCommand_ListCommand(t *testing.T) {
 	mockScrapperClient := new(mockservices.ScrapperClient)
 	mockTelegramClient := new(domainmocks.TelegramClientAPI)
 	mockTxManager := new(mocks.TxManager)
-	linkAnalyzer := commonservice.NewLinkAnalyzer()
+	linkAnalyzer := commonservice.NewLinkAnalyzer(commonservice.NewDefaultSourceRegistry())
 
 	botService := service.NewBotService(mockChatStateRepo, mockScrapperClient, mockTelegramClient, linkAnalyzer, mockTxManager)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/bot/cache"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
@@ -25,7 +26,9 @@ func NewCachedBotService(botService *BotService, linkCache cache.LinkCache, logg
 }
 
 func (s *CachedBotService) ProcessCommand(ctx context.Context, command *models.Command) (string, error) {
-	if command.Type == models.CommandTrack || command.Type == models.CommandUntrack {
+	//nolint:exhaustive // кэш сбрасывают только команды, меняющие список ссылок
+	switch command.Type {
+	case models.CommandTrack, models.CommandUntrack, models.CommandMute, models.CommandUnmute:
 		if err := s.linkCache.DeleteLinks(ctx, command.ChatID); err != nil {
 			s.logger.Error("Ошибка при инвалидации кэша",
 				"error", err,
@@ -47,7 +50,7 @@ func (s *CachedBotService) ProcessMessage(ctx context.Context, chatID, userID in
 		return "", err
 	}
 
-	isLinkModification := (state == models.StateAwaitingFilters || state == models.StateAwaitingUntrackLink)
+	isLinkModification := (state == models.StateAwaitingLinkMode || state == models.StateAwaitingUntrackLink)
 
 	response, err := s.botService.ProcessMessage(ctx, chatID, userID, text, username)
 	if err != nil {
@@ -142,6 +145,10 @@ func (s *CachedBotService) formatLinksList(links []*models.Link) string {
 		if len(link.Filters) > 0 {
 			result.WriteString(fmt.Sprintf("   Фильтры: %s\n", strings.Join(link.Filters, ", ")))
 		}
+
+		if line := mutedLine(link, time.Now()); line != "" {
+			result.WriteString(line)
+		}
 	}
 
 	return result.String()
@@ -16,9 +16,9 @@ type ScrapperClient struct {
 	mock.Mock
 }
 
-// AddLink provides a mock function with given fields: ctx, chatID, url, tags, filters
-func (_m *ScrapperClient) AddLink(ctx context.Context, chatID int64, url string, tags []string, filters []string) (*models.Link, error) {
-	ret := _m.Called(ctx, chatID, url, tags, filters)
+// AddLink provides a mock function with given fields: ctx, chatID, url, tags, filters, keywords, mode
+func (_m *ScrapperClient) AddLink(ctx context.Context, chatID int64, url string, tags []string, filters []string, keywords []string, mode models.NotificationMode) (*models.Link, error) {
+	ret := _m.Called(ctx, chatID, url, tags, filters, keywords, mode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddLink")
@@ -26,19 +26,19 @@ func (_m *ScrapperClient) AddLink(ctx context.Context, chatID int64, url string,
 
 	var r0 *models.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, []string, []string) (*models.Link, error)); ok {
-		return rf(ctx, chatID, url, tags, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, []string, []string, []string, models.NotificationMode) (*models.Link, error)); ok {
+		return rf(ctx, chatID, url, tags, filters, keywords, mode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, []string, []string) *models.Link); ok {
-		r0 = rf(ctx, chatID, url, tags, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, []string, []string, []string, models.NotificationMode) *models.Link); ok {
+		r0 = rf(ctx, chatID, url, tags, filters, keywords, mode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string, []string, []string) error); ok {
-		r1 = rf(ctx, chatID, url, tags, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, []string, []string, []string, models.NotificationMode) error); ok {
+		r1 = rf(ctx, chatID, url, tags, filters, keywords, mode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -94,6 +94,36 @@ func (_m *ScrapperClient) GetLinks(ctx context.Context, chatID int64) ([]*models
 	return r0, r1
 }
 
+// MuteLink provides a mock function with given fields: ctx, chatID, url, until, summary
+func (_m *ScrapperClient) MuteLink(ctx context.Context, chatID int64, url string, until time.Time, summary bool) (*models.Link, error) {
+	ret := _m.Called(ctx, chatID, url, until, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteLink")
+	}
+
+	var r0 *models.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time, bool) (*models.Link, error)); ok {
+		return rf(ctx, chatID, url, until, summary)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time, bool) *models.Link); ok {
+		r0 = rf(ctx, chatID, url, until, summary)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, time.Time, bool) error); ok {
+		r1 = rf(ctx, chatID, url, until, summary)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterChat provides a mock function with given fields: ctx, chatID
 func (_m *ScrapperClient) RegisterChat(ctx context.Context, chatID int64) error {
 	ret := _m.Called(ctx, chatID)
@@ -142,6 +172,36 @@ func (_m *ScrapperClient) RemoveLink(ctx context.Context, chatID int64, url stri
 	return r0, r1
 }
 
+// UnmuteLink provides a mock function with given fields: ctx, chatID, url
+func (_m *ScrapperClient) UnmuteLink(ctx context.Context, chatID int64, url string) (*models.Link, error) {
+	ret := _m.Called(ctx, chatID, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmuteLink")
+	}
+
+	var r0 *models.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*models.Link, error)); ok {
+		return rf(ctx, chatID, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *models.Link); ok {
+		r0 = rf(ctx, chatID, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, chatID, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateNotificationSettings provides a mock function with given fields: ctx, chatID, mode, digestTime
 func (_m *ScrapperClient) UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode, digestTime time.Time) error {
 	ret := _m.Called(ctx, chatID, mode, digestTime)
@@ -160,6 +220,24 @@ func (_m *ScrapperClient) UpdateNotificationSettings(ctx context.Context, chatID
 	return r0
 }
 
+// UpdateQuietHours provides a mock function with given fields: ctx, chatID, quietHours
+func (_m *ScrapperClient) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	ret := _m.Called(ctx, chatID, quietHours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuietHours")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *models.QuietHours) error); ok {
+		r0 = rf(ctx, chatID, quietHours)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewScrapperClient creates a new instance of ScrapperClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewScrapperClient(t interface {
@@ -162,6 +162,12 @@ func getCommandType(commandName string) models.CommandType {
 		return models.CommandMode
 	case "/time":
 		return models.CommandTime
+	case "/quiet":
+		return models.CommandQuiet
+	case "/mute":
+		return models.CommandMute
+	case "/unmute":
+		return models.CommandUnmute
 	default:
 		return models.CommandUnknown
 	}
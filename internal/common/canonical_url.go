@@ -0,0 +1,81 @@
+package common
+
+import (
+	neturl "net/url"
+	"strings"
+)
+
+// CanonicalizeURL приводит ссылку к каноническому виду, чтобы разные записи одного ресурса
+// отслеживались как одна ссылка: схема и хост в нижнем регистре, без порта по умолчанию.
+// Для GitHub, GitLab и StackExchange дополнительно используется https, отбрасываются www., завершающий слэш,
+// суффикс .git и заголовок вопроса. У остальных ссылок путь не меняется: для произвольного сайта /docs и /docs/
+// могут быть разными страницами. Фрагмент сохраняется только для CSS-селектора веб-страницы.
+// Ссылки, которые не удаётся разобрать, возвращаются без изменений.
+func CanonicalizeURL(rawURL string) string {
+	trimmed := strings.TrimSpace(rawURL)
+	if trimmed == "" {
+		return rawURL
+	}
+
+	if !strings.Contains(trimmed, "://") {
+		trimmed = "https://" + trimmed
+	}
+
+	u, err := neturl.Parse(trimmed)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+
+	if matches := stackExchangeRegex.FindStringSubmatch(u.String()); len(matches) == 3 {
+		host := strings.TrimPrefix(strings.ToLower(matches[1]), "www.")
+		return "https://" + host + "/questions/" + matches[2]
+	}
+
+	switch strings.TrimPrefix(u.Host, "www.") {
+	case "github.com":
+		u.Scheme = "https"
+		u.Host = "github.com"
+		u.Path = canonicalGitHubPath(u.Path)
+		u.RawPath = ""
+	case gitLabHost:
+		u.Scheme = "https"
+		u.Host = gitLabHost
+		u.Path = strings.TrimSuffix(strings.TrimRight(u.Path, "/"), ".git")
+		u.RawPath = ""
+	default:
+		// Пустой путь и корень сайта — один и тот же адрес.
+		if u.Path == "/" {
+			u.Path = ""
+			u.RawPath = ""
+		}
+	}
+
+	if !strings.HasPrefix(u.Fragment, webPageSelectorPrefix) {
+		u.Fragment = ""
+	}
+
+	u.RawFragment = ""
+
+	return u.String()
+}
+
+// canonicalGitHubPath приводит владельца и репозиторий к нижнему регистру, как их воспринимает GitHub.
+// Остальная часть пути (ветки, файлы) чувствительна к регистру и не меняется.
+func canonicalGitHubPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return strings.TrimRight(path, "/")
+	}
+
+	segments[0] = strings.ToLower(segments[0])
+	segments[1] = strings.ToLower(strings.TrimSuffix(segments[1], ".git"))
+
+	return "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,78 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalizeURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "GitHub repository",
+			url:      "https://github.com/o/r",
+			expected: "https://github.com/o/r",
+		},
+		{
+			name:     "GitHub with http, www and trailing slash",
+			url:      "http://www.github.com/o/r/",
+			expected: "https://github.com/o/r",
+		},
+		{
+			name:     "GitHub clone URL without scheme",
+			url:      "github.com/O/R.git",
+			expected: "https://github.com/o/r",
+		},
+		{
+			name:     "GitHub file path keeps case",
+			url:      "https://github.com/Owner/Repo/blob/main/README.md#L10",
+			expected: "https://github.com/owner/repo/blob/main/README.md",
+		},
+		{
+			name:     "StackOverflow question with slug",
+			url:      "https://stackoverflow.com/questions/12345/how-to-lock?answertab=votes#tab-top",
+			expected: "https://stackoverflow.com/questions/12345",
+		},
+		{
+			name:     "StackExchange short link",
+			url:      "http://www.superuser.com/q/42",
+			expected: "https://superuser.com/questions/42",
+		},
+		{
+			name:     "GitLab project",
+			url:      "http://GitLab.com/group/project.git",
+			expected: "https://gitlab.com/group/project",
+		},
+		{
+			name:     "Web page keeps selector and query",
+			url:      "HTTPS://Example.COM:443/status/?lang=en#css=div.status",
+			expected: "https://example.com/status/?lang=en#css=div.status",
+		},
+		{
+			name:     "Web page keeps trailing slash and www",
+			url:      "https://www.example.com/docs/",
+			expected: "https://www.example.com/docs/",
+		},
+		{
+			name:     "Web page root",
+			url:      "http://example.com/",
+			expected: "http://example.com",
+		},
+		{
+			name:     "Empty URL",
+			url:      "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, common.CanonicalizeURL(tt.url))
+		})
+	}
+}
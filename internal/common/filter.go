@@ -0,0 +1,452 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// Фильтр обновлений — выражение над полями UpdateInfo, например:
+//
+//	author=dependabot
+//	include type=release or type=pr
+//	exclude type=comment and not label=bug
+//	title~"^WIP" or text~"опечатк"
+//
+// Условие состоит из поля, оператора и значения. Поля: author (прежняя запись — user), type, title, text и label.
+// Операторы: = и != сравнивают без учёта регистра, ~ и !~ проверяют регулярное выражение.
+// Для title и text доступны только ~ и !~. Значение с пробелами или скобками берётся в двойные кавычки.
+// Условия объединяются and, or, not и скобками; and связывает сильнее or.
+//
+// Фильтр exclude скрывает подходящие обновления, фильтр include пропускает только их.
+// Фильтр без include и exclude считается exclude.
+
+const (
+	filterInclude = "include"
+	filterExclude = "exclude"
+)
+
+// updateTypes сопоставляет тип обновления в фильтре значениям UpdateInfo.ContentType разных источников.
+var updateTypes = map[string][]string{
+	"issue":    {"issue", "gitlab_issue"},
+	"pr":       {"pull_request", "merge_request"},
+	"question": {"question"},
+	"answer":   {"answer"},
+	"comment":  {"comment", "issue_comment"},
+	"review":   {"review"},
+	"commit":   {"commit"},
+	"release":  {"release", "gitlab_release", "version"},
+	"workflow": {"workflow_run"},
+	"advisory": {"security_advisory"},
+	"page":     {"page", "page_change"},
+	"feed":     {"feed_entry"},
+}
+
+// UpdateFilter — разобранный фильтр обновлений.
+type UpdateFilter struct {
+	// Include означает, что фильтр пропускает только подходящие обновления, а не скрывает их.
+	Include bool
+	expr    filterExpr
+}
+
+// ParseFilter разбирает фильтр обновлений и возвращает ErrInvalidFilter с описанием ошибки.
+func ParseFilter(source string) (*UpdateFilter, error) {
+	tokens, err := tokenizeFilter(source)
+	if err != nil {
+		return nil, &errors.ErrInvalidFilter{Filter: source, Reason: err.Error()}
+	}
+
+	p := &filterParser{tokens: tokens}
+	filter := &UpdateFilter{}
+
+	if first, second := p.tokens[0], p.tokens[1]; first.kind == tokenWord &&
+		second.kind != tokenOperator && second.kind != tokenEOF {
+		switch strings.ToLower(first.text) {
+		case filterInclude:
+			filter.Include = true
+			p.next()
+		case filterExclude:
+			p.next()
+		}
+	}
+
+	filter.expr, err = p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = fmt.Errorf("лишнее '%s' в конце выражения", p.peek().text)
+	}
+
+	if err != nil {
+		return nil, &errors.ErrInvalidFilter{Filter: source, Reason: err.Error()}
+	}
+
+	return filter, nil
+}
+
+// ParseFilters разбирает фильтры подписки и возвращает ошибку первого некорректного из них.
+func ParseFilters(sources []string) ([]*UpdateFilter, error) {
+	filters := make([]*UpdateFilter, 0, len(sources))
+
+	for _, source := range sources {
+		filter, err := ParseFilter(source)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// Match сообщает, подходит ли обновление под выражение фильтра.
+func (f *UpdateFilter) Match(info *models.UpdateInfo) bool {
+	return f.expr.match(info)
+}
+
+// Suppressed сообщает, что обновление нужно скрыть: его отбирает хотя бы один фильтр exclude
+// или, если заданы фильтры include, не отбирает ни один из них.
+func Suppressed(info *models.UpdateInfo, filters []*UpdateFilter) bool {
+	hasInclude, included := false, false
+
+	for _, filter := range filters {
+		if !filter.Include {
+			if filter.Match(info) {
+				return true
+			}
+
+			continue
+		}
+
+		hasInclude = true
+		included = included || filter.Match(info)
+	}
+
+	return hasInclude && !included
+}
+
+// UpdateTypes возвращает типы обновлений, допустимые в условии type.
+func UpdateTypes() []string {
+	types := make([]string, 0, len(updateTypes))
+	for updateType := range updateTypes {
+		types = append(types, updateType)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+type filterExpr interface {
+	match(info *models.UpdateInfo) bool
+}
+
+type filterAnd struct{ left, right filterExpr }
+
+func (e *filterAnd) match(info *models.UpdateInfo) bool {
+	return e.left.match(info) && e.right.match(info)
+}
+
+type filterOr struct{ left, right filterExpr }
+
+func (e *filterOr) match(info *models.UpdateInfo) bool {
+	return e.left.match(info) || e.right.match(info)
+}
+
+type filterNot struct{ expr filterExpr }
+
+func (e *filterNot) match(info *models.UpdateInfo) bool { return !e.expr.match(info) }
+
+// filterCondition — условие вида поле-оператор-значение. Для операторов ~ и !~ задан pattern.
+type filterCondition struct {
+	field   string
+	negate  bool
+	value   string
+	pattern *regexp.Regexp
+}
+
+func (c *filterCondition) match(info *models.UpdateInfo) bool {
+	return c.matchField(info) != c.negate
+}
+
+func (c *filterCondition) matchField(info *models.UpdateInfo) bool {
+	switch c.field {
+	case "author":
+		return c.matchValue(info.Author)
+	case "type":
+		if c.pattern != nil {
+			return c.pattern.MatchString(info.ContentType)
+		}
+
+		return slices.Contains(updateTypes[c.value], info.ContentType)
+	case "title":
+		return c.pattern.MatchString(info.Title)
+	case "text":
+		if info.FullText != "" {
+			return c.pattern.MatchString(info.FullText)
+		}
+
+		return c.pattern.MatchString(info.TextPreview)
+	case "label":
+		return slices.ContainsFunc(info.Labels, c.matchValue)
+	default:
+		return false
+	}
+}
+
+func (c *filterCondition) matchValue(value string) bool {
+	if c.pattern != nil {
+		return c.pattern.MatchString(value)
+	}
+
+	return strings.EqualFold(value, c.value)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type filterToken struct {
+	kind tokenKind
+	text string
+}
+
+// tokenizeFilter разбивает фильтр на слова, строки в кавычках, операторы и скобки.
+func tokenizeFilter(source string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: tokenLParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: tokenRParen, text: ")"})
+			i++
+		case r == '=' || r == '~':
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: string(r)})
+			i++
+		case r == '!':
+			if i+1 >= len(runes) || (runes[i+1] != '=' && runes[i+1] != '~') {
+				return nil, fmt.Errorf("после '!' ожидается '=' или '~'")
+			}
+
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: string(runes[i : i+2])})
+			i += 2
+		case r == '"':
+			text, next, err := readQuoted(runes, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, filterToken{kind: tokenString, text: text})
+			i = next
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()=~!"`, runes[i]) {
+				i++
+			}
+
+			tokens = append(tokens, filterToken{kind: tokenWord, text: string(runes[start:i])})
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("пустой фильтр")
+	}
+
+	return append(tokens, filterToken{kind: tokenEOF}), nil
+}
+
+// readQuoted читает строку в двойных кавычках, начинающуюся с позиции start. Внутри строки \" и \\ экранируют символ.
+func readQuoted(runes []rune, start int) (text string, next int, err error) {
+	var sb strings.Builder
+
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+				i++
+			}
+
+			sb.WriteRune(runes[i])
+		case '"':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("не закрыта кавычка")
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.tokens[p.pos]
+	if token.kind != tokenEOF {
+		p.pos++
+	}
+
+	return token
+}
+
+func (p *filterParser) acceptKeyword(keyword string) bool {
+	if token := p.peek(); token.kind == tokenWord && strings.EqualFold(token.text, keyword) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &filterOr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &filterAnd{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	if p.acceptKeyword("not") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &filterNot{expr: expr}, nil
+	}
+
+	if p.peek().kind != tokenLParen {
+		return p.parseCondition()
+	}
+
+	p.next()
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.next().kind != tokenRParen {
+		return nil, fmt.Errorf("не закрыта скобка")
+	}
+
+	return expr, nil
+}
+
+func (p *filterParser) parseCondition() (filterExpr, error) {
+	fieldToken := p.next()
+	if fieldToken.kind != tokenWord {
+		return nil, fmt.Errorf("ожидалось условие, например author=имя")
+	}
+
+	field := strings.ToLower(fieldToken.text)
+	if field == "user" {
+		field = "author"
+	}
+
+	operator := p.next()
+	if operator.kind != tokenOperator {
+		return nil, fmt.Errorf("после '%s' ожидается оператор =, !=, ~ или !~", fieldToken.text)
+	}
+
+	value := p.next()
+	if (value.kind != tokenWord && value.kind != tokenString) || value.text == "" {
+		return nil, fmt.Errorf("не указано значение условия %s%s", fieldToken.text, operator.text)
+	}
+
+	return newFilterCondition(field, operator.text, value.text)
+}
+
+func newFilterCondition(field, operator, value string) (*filterCondition, error) {
+	condition := &filterCondition{
+		field:  field,
+		negate: strings.HasPrefix(operator, "!"),
+		value:  value,
+	}
+
+	regex := strings.HasSuffix(operator, "~")
+
+	switch field {
+	case "author", "label":
+	case "type":
+		if _, ok := updateTypes[strings.ToLower(value)]; !regex && !ok {
+			return nil, fmt.Errorf("неизвестный тип обновления '%s', допустимые типы: %s",
+				value, strings.Join(UpdateTypes(), ", "))
+		}
+
+		condition.value = strings.ToLower(value)
+	case "title", "text":
+		if !regex {
+			return nil, fmt.Errorf("поле %s сравнивается только регулярным выражением: %s~выражение", field, field)
+		}
+	default:
+		return nil, fmt.Errorf("неизвестное поле '%s', допустимые поля: author, type, title, text, label", field)
+	}
+
+	if regex {
+		pattern, err := regexp.Compile("(?i)" + value)
+		if err != nil {
+			return nil, fmt.Errorf("некорректное регулярное выражение '%s'", value)
+		}
+
+		condition.pattern = pattern
+	}
+
+	return condition, nil
+}
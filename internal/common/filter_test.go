@@ -0,0 +1,98 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	domainErrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateFilter_Match(t *testing.T) {
+	pull := &models.UpdateInfo{
+		Title:       "WIP: Bump deps",
+		Author:      "dependabot",
+		ContentType: "pull_request",
+		FullText:    "Bumps the go-modules group",
+		Labels:      []string{"dependencies"},
+	}
+	answer := &models.UpdateInfo{
+		Author:      "alice",
+		ContentType: "answer",
+		TextPreview: "Используйте sync.Once",
+	}
+
+	tests := []struct {
+		name     string
+		filter   string
+		info     *models.UpdateInfo
+		expected bool
+	}{
+		{name: "author ignores case", filter: "author=Dependabot", info: pull, expected: true},
+		{name: "legacy user condition", filter: "user=dependabot", info: pull, expected: true},
+		{name: "author not equal", filter: "author!=dependabot", info: pull, expected: false},
+		{name: "type alias covers pull requests", filter: "type=pr", info: pull, expected: true},
+		{name: "type alias does not cover answers", filter: "type=pr", info: answer, expected: false},
+		{name: "title regex", filter: `title~"^wip:"`, info: pull, expected: true},
+		{name: "text regex uses full text", filter: "text~go-modules", info: pull, expected: true},
+		{name: "text regex falls back to preview", filter: "text~sync", info: answer, expected: true},
+		{name: "label", filter: "label=Dependencies", info: pull, expected: true},
+		{name: "negated label regex", filter: "label!~^bug", info: pull, expected: true},
+		{name: "and binds tighter than or", filter: "type=answer or type=pr and author=alice", info: pull, expected: false},
+		{name: "parentheses", filter: "(type=answer or type=pr) and author=dependabot", info: pull, expected: true},
+		{name: "not", filter: "not author=alice and type=answer", info: answer, expected: false},
+		{name: "include prefix", filter: "include type=release", info: pull, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := common.ParseFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, filter.Match(tt.info))
+		})
+	}
+}
+
+func TestParseFilter_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		reason string
+	}{
+		{name: "empty", filter: "  ", reason: "пустой фильтр"},
+		{name: "unknown field", filter: "milestone=v1", reason: "неизвестное поле 'milestone'"},
+		{name: "unknown type", filter: "type=bug", reason: "неизвестный тип обновления 'bug'"},
+		{name: "title needs regex", filter: "title=Release", reason: "поле title сравнивается только регулярным выражением"},
+		{name: "bad regex", filter: `text~"(unclosed"`, reason: "некорректное регулярное выражение"},
+		{name: "missing operator", filter: "author bot", reason: "после 'author' ожидается оператор"},
+		{name: "missing value", filter: "author=", reason: "не указано значение условия author="},
+		{name: "unclosed paren", filter: "(author=bot", reason: "не закрыта скобка"},
+		{name: "unclosed quote", filter: `title~"wip`, reason: "не закрыта кавычка"},
+		{name: "trailing tokens", filter: "author=bot type=pr", reason: "лишнее 'type' в конце выражения"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := common.ParseFilter(tt.filter)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, &domainErrors.ErrInvalidFilter{}))
+			assert.Contains(t, err.Error(), tt.reason)
+		})
+	}
+}
+
+func TestSuppressed(t *testing.T) {
+	release := &models.UpdateInfo{Author: "ci-bot", ContentType: "release"}
+	comment := &models.UpdateInfo{Author: "alice", ContentType: "issue_comment"}
+
+	filters, err := common.ParseFilters([]string{"include type=release or type=comment", "exclude author=ci-bot"})
+	require.NoError(t, err)
+
+	assert.True(t, common.Suppressed(release, filters), "exclude wins over include")
+	assert.False(t, common.Suppressed(comment, filters))
+	assert.True(t, common.Suppressed(&models.UpdateInfo{ContentType: "commit"}, filters), "not included")
+	assert.False(t, common.Suppressed(&models.UpdateInfo{ContentType: "commit"}, nil))
+}
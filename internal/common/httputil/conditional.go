@@ -0,0 +1,60 @@
+package httputil
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type validatorsKey struct{}
+
+// Validators хранит ETag и Last-Modified последнего ответа на запрос состояния ссылки.
+// Клиент отправляет их в If-None-Match и If-Modified-Since и сохраняет новые значения из успешного ответа.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// WithValidators привязывает валидаторы ссылки к контексту проверки.
+func WithValidators(ctx context.Context, validators *Validators) context.Context {
+	return context.WithValue(ctx, validatorsKey{}, validators)
+}
+
+// ValidatorsFromContext возвращает валидаторы, привязанные к контексту, или nil.
+func ValidatorsFromContext(ctx context.Context) *Validators {
+	validators, _ := ctx.Value(validatorsKey{}).(*Validators)
+	return validators
+}
+
+// SetConditionalHeaders делает запрос условным, если в контексте есть валидаторы ссылки.
+func SetConditionalHeaders(ctx context.Context, request *resty.Request) {
+	validators := ValidatorsFromContext(ctx)
+	if validators == nil {
+		return
+	}
+
+	if validators.ETag != "" {
+		request.SetHeader("If-None-Match", validators.ETag)
+	}
+
+	if validators.LastModified != "" {
+		request.SetHeader("If-Modified-Since", validators.LastModified)
+	}
+}
+
+// IsNotModified сообщает, что ресурс не изменился с прошлого запроса.
+func IsNotModified(resp *resty.Response) bool {
+	return resp.StatusCode() == http.StatusNotModified
+}
+
+// StoreValidators запоминает валидаторы из успешного ответа в контексте проверки.
+func StoreValidators(ctx context.Context, resp *resty.Response) {
+	validators := ValidatorsFromContext(ctx)
+	if validators == nil {
+		return
+	}
+
+	validators.ETag = resp.Header().Get("ETag")
+	validators.LastModified = resp.Header().Get("Last-Modified")
+}
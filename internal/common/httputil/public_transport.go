@@ -0,0 +1,81 @@
+package httputil
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/go-resty/resty/v2"
+)
+
+// MaxPublicResponseSize ограничивает размер тела ответа, загружаемого по ссылке пользователя.
+const MaxPublicResponseSize = 5 << 20
+
+// sharedAddressSpace — диапазон 100.64.0.0/10 (RFC 6598), который не покрывает netip.Addr.IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// NewPublicTransport возвращает транспорт, который подключается только к публичным адресам.
+// Проверка выполняется в Control уже после разрешения имени, поэтому она действует и на адреса,
+// на которые ведут редиректы, и не обходится DNS-записью, указывающей на внутренний адрес.
+func NewPublicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   denyInternalAddress,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Через прокси подключение к внутреннему адресу выполнил бы сам прокси, минуя проверку.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return transport
+}
+
+func denyInternalAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return &errors.ErrForbiddenAddress{Address: address}
+	}
+
+	if isInternalAddress(addrPort.Addr()) {
+		return &errors.ErrForbiddenAddress{Address: address}
+	}
+
+	return nil
+}
+
+func isInternalAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// ReadLimitedBody читает тело ответа, запрошенного с SetDoNotParseResponse, не более чем limit байт.
+func ReadLimitedBody(resp *resty.Response, limit int64) ([]byte, error) {
+	body := resp.RawBody()
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения ответа: %w", err)
+	}
+
+	if int64(len(data)) > limit {
+		return nil, &errors.ErrResponseTooLarge{URL: resp.Request.URL, Limit: limit}
+	}
+
+	return data, nil
+}
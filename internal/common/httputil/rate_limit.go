@@ -0,0 +1,189 @@
+package httputil
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/go-resty/resty/v2"
+)
+
+// RateLimitStatus описывает остаток квоты внешнего API по последнему ответу.
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	// Known ложно, пока API не прислал ни одного ответа со сведениями о квоте.
+	Known bool
+	// PausedUntil — момент, до которого API попросил не отправлять запросы независимо от остатка квоты.
+	PausedUntil time.Time
+}
+
+// Exhausted сообщает, что квота исчерпана или запросы приостановлены к моменту now.
+func (s RateLimitStatus) Exhausted(now time.Time) bool {
+	return now.Before(s.PausedUntil) || (s.Known && s.Remaining <= 0 && now.Before(s.Reset))
+}
+
+// ResumeAt возвращает момент, когда запросы можно будет возобновить.
+func (s RateLimitStatus) ResumeAt(now time.Time) time.Time {
+	if s.Known && s.Remaining <= 0 && now.Before(s.Reset) && s.Reset.After(s.PausedUntil) {
+		return s.Reset
+	}
+
+	return s.PausedUntil
+}
+
+// ResourceFunc определяет по URL запроса, к какой квоте API он относится.
+type ResourceFunc func(url string) string
+
+// RateLimitTracker запоминает квоту API из заголовков X-RateLimit-* и Retry-After или из тела ответа.
+// Квоты разных ресурсов API (например, REST и GraphQL у GitHub) учитываются раздельно:
+// ресурс берётся из заголовка X-RateLimit-Resource, а до первого ответа — из ResourceFunc.
+type RateLimitTracker struct {
+	mu         sync.RWMutex
+	statuses   map[string]RateLimitStatus
+	resourceOf ResourceFunc
+	primary    string
+}
+
+// NewRateLimitTracker создаёт учёт одной общей квоты API.
+func NewRateLimitTracker() *RateLimitTracker {
+	return NewResourceRateLimitTracker(nil, "")
+}
+
+// NewResourceRateLimitTracker создаёт учёт квот по ресурсам API.
+// RateLimit, Record и Pause относятся к ресурсу primary.
+func NewResourceRateLimitTracker(resourceOf ResourceFunc, primary string) *RateLimitTracker {
+	return &RateLimitTracker{
+		statuses:   make(map[string]RateLimitStatus),
+		resourceOf: resourceOf,
+		primary:    primary,
+	}
+}
+
+// RateLimit возвращает состояние основной квоты.
+func (t *RateLimitTracker) RateLimit() RateLimitStatus {
+	return t.ResourceRateLimit(t.primary)
+}
+
+// ResourceRateLimit возвращает состояние квоты ресурса.
+func (t *RateLimitTracker) ResourceRateLimit(resource string) RateLimitStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.statuses[resource]
+}
+
+// Observe обновляет квоту по ответу API и сообщает, был ли запрос отклонён из-за лимита.
+// Ответ 403 или 429 с Retry-After (вторичный лимит GitHub) блокирует запросы на указанное число секунд.
+func (t *RateLimitTracker) Observe(resp *http.Response, now time.Time) bool {
+	if resp == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	header := resp.Header
+	resource := t.responseResource(resp)
+	status := t.statuses[resource]
+
+	if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		status.Remaining = remaining
+		status.Known = true
+
+		if limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit")); err == nil {
+			status.Limit = limit
+		}
+
+		if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			status.Reset = time.Unix(reset, 0)
+		}
+
+		t.statuses[resource] = status
+	}
+
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	if retryAfter, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		status.PausedUntil = now.Add(time.Duration(retryAfter) * time.Second)
+		t.statuses[resource] = status
+
+		return true
+	}
+
+	return status.Remaining <= 0 && now.Before(status.Reset)
+}
+
+// Record запоминает квоту, о которой API сообщает в теле ответа, а не в заголовках.
+func (t *RateLimitTracker) Record(limit, remaining int, reset time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status := t.statuses[t.primary]
+	status.Limit = limit
+	status.Remaining = remaining
+	status.Reset = reset
+	status.Known = true
+	t.statuses[t.primary] = status
+}
+
+// Pause приостанавливает запросы до until, не меняя известный остаток квоты.
+func (t *RateLimitTracker) Pause(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status := t.statuses[t.primary]
+	if until.After(status.PausedUntil) {
+		status.PausedUntil = until
+		t.statuses[t.primary] = status
+	}
+}
+
+// requestResource определяет ресурс квоты по URL ещё не отправленного запроса.
+func (t *RateLimitTracker) requestResource(url string) string {
+	if t.resourceOf == nil {
+		return t.primary
+	}
+
+	return t.resourceOf(url)
+}
+
+func (t *RateLimitTracker) responseResource(resp *http.Response) string {
+	if resource := resp.Header.Get("X-RateLimit-Resource"); resource != "" && t.resourceOf != nil {
+		return resource
+	}
+
+	if resp.Request == nil || resp.Request.URL == nil {
+		return t.primary
+	}
+
+	return t.requestResource(resp.Request.URL.String())
+}
+
+// TrackRateLimit подключает учёт квоты к клиенту: запросы при исчерпанной квоте не отправляются,
+// а ответы, отклонённые из-за лимита, возвращаются как ErrRateLimited без повторов.
+func TrackRateLimit(client *resty.Client, tracker *RateLimitTracker, serviceName string) {
+	client.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		now := time.Now()
+		if status := tracker.ResourceRateLimit(tracker.requestResource(req.URL)); status.Exhausted(now) {
+			return &errors.ErrRateLimited{Service: serviceName, ResetAt: status.ResumeAt(now)}
+		}
+
+		return nil
+	})
+
+	client.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		now := time.Now()
+		if tracker.Observe(resp.RawResponse, now) {
+			status := tracker.ResourceRateLimit(tracker.responseResource(resp.RawResponse))
+			return &errors.ErrRateLimited{Service: serviceName, ResetAt: status.ResumeAt(now)}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,124 @@
+package httputil_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTracker_Observe(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	tracker := httputil.NewRateLimitTracker()
+	assert.False(t, tracker.RateLimit().Known)
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Limit", "5000")
+	resp.Header.Set("X-RateLimit-Remaining", "42")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	assert.False(t, tracker.Observe(resp, now))
+
+	status := tracker.RateLimit()
+	assert.True(t, status.Known)
+	assert.Equal(t, 5000, status.Limit)
+	assert.Equal(t, 42, status.Remaining)
+	assert.True(t, reset.Equal(status.Reset))
+	assert.False(t, status.Exhausted(now))
+
+	// Вторичный лимит GitHub приходит как 403 с Retry-After без изменения основной квоты.
+	secondary := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}
+	secondary.Header.Set("Retry-After", "60")
+
+	assert.True(t, tracker.Observe(secondary, now))
+	assert.True(t, tracker.RateLimit().Exhausted(now))
+	assert.False(t, tracker.RateLimit().Exhausted(now.Add(time.Minute)))
+
+	// 403 из-за прав доступа не считается исчерпанием квоты.
+	forbidden := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}
+	forbidden.Header.Set("X-RateLimit-Remaining", "41")
+
+	assert.False(t, tracker.Observe(forbidden, now))
+}
+
+func TestTrackRateLimit_StopsRequestsUntilReset(t *testing.T) {
+	var requestCount atomic.Int32
+
+	reset := time.Now().Add(time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 1 * time.Second,
+		RetryCount:             3,
+		RetryBackoff:           10 * time.Millisecond,
+		RetryableStatusCodes:   []int{429, 500},
+	}
+
+	client := httputil.CreateResilientHTTPClient(cfg, nil, "test_service")
+	tracker := httputil.NewRateLimitTracker()
+	httputil.TrackRateLimit(client, tracker, "test_service")
+
+	_, err := client.R().Get(server.URL)
+
+	var rateLimited *customerrors.ErrRateLimited
+
+	require.ErrorAs(t, err, &rateLimited)
+	assert.Equal(t, reset.Unix(), rateLimited.ResetAt.Unix())
+	assert.Equal(t, int32(1), requestCount.Load(), "429 из-за квоты не должен повторяться")
+
+	_, err = client.R().Get(server.URL)
+	require.ErrorIs(t, err, &customerrors.ErrRateLimited{})
+	assert.Equal(t, int32(1), requestCount.Load(), "при исчерпанной квоте запрос не должен отправляться")
+}
+
+func TestRateLimitTracker_SeparatesResources(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	resourceOf := func(url string) string {
+		if strings.HasSuffix(url, "/graphql") {
+			return "graphql"
+		}
+
+		return "core"
+	}
+
+	tracker := httputil.NewResourceRateLimitTracker(resourceOf, "core")
+
+	core := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}
+	core.Header.Set("X-RateLimit-Resource", "core")
+	core.Header.Set("X-RateLimit-Remaining", "0")
+	core.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	assert.True(t, tracker.Observe(core, now))
+
+	graphQL := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	graphQL.Header.Set("X-RateLimit-Resource", "graphql")
+	graphQL.Header.Set("X-RateLimit-Remaining", "4999")
+	graphQL.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	assert.False(t, tracker.Observe(graphQL, now))
+
+	assert.True(t, tracker.RateLimit().Exhausted(now), "ответ GraphQL не должен восстанавливать квоту REST")
+	assert.Equal(t, 4999, tracker.ResourceRateLimit("graphql").Remaining)
+	assert.False(t, tracker.ResourceRateLimit("graphql").Exhausted(now))
+}
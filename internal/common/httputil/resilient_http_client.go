@@ -1,6 +1,7 @@
 package httputil
 
 import (
+	stderrors "errors"
 	"log/slog"
 	"net/http"
 	"time"
@@ -19,6 +20,21 @@ type ResilientHTTPClient struct {
 }
 
 func CreateResilientHTTPClient(cfg *config.Config, logger *slog.Logger, serviceName string) *resty.Client {
+	return createResilientHTTPClient(cfg, logger, serviceName, http.DefaultTransport)
+}
+
+// CreatePublicHTTPClient создаёт клиент для загрузки произвольных ссылок пользователей.
+// Клиент не подключается к внутренним адресам, если это не разрешено ALLOW_PRIVATE_NETWORKS.
+func CreatePublicHTTPClient(cfg *config.Config, logger *slog.Logger, serviceName string) *resty.Client {
+	if cfg.AllowPrivateNetworks {
+		return CreateResilientHTTPClient(cfg, logger, serviceName)
+	}
+
+	return createResilientHTTPClient(cfg, logger, serviceName, NewPublicTransport())
+}
+
+func createResilientHTTPClient(cfg *config.Config, logger *slog.Logger, serviceName string,
+	transport http.RoundTripper) *resty.Client {
 	client := resty.New()
 
 	client.SetTimeout(cfg.ExternalRequestTimeout)
@@ -31,6 +47,16 @@ func CreateResilientHTTPClient(cfg *config.Config, logger *slog.Logger, serviceN
 
 	client.AddRetryCondition(func(r *resty.Response, err error) bool {
 		if err != nil {
+			// Повтор до сброса квоты API только расходует попытки.
+			if stderrors.Is(err, &errors.ErrRateLimited{}) || stderrors.Is(err, &errors.ErrForbiddenAddress{}) {
+				return false
+			}
+
+			var apiErr *errors.ErrStackExchangeAPI
+			if stderrors.As(err, &apiErr) {
+				return apiErr.Temporary()
+			}
+
 			return true
 		}
 
@@ -47,6 +73,10 @@ func CreateResilientHTTPClient(cfg *config.Config, logger *slog.Logger, serviceN
 			return counts.Requests >= uint32(cfg.CBMinimumRequiredCalls) && //nolint:gosec // G115: Значение из конфига
 				failureRatio >= float64(cfg.CBFailureRateThreshold)/100.0
 		},
+		// Отказ подключиться к внутреннему адресу говорит о ссылке пользователя, а не о сбое сервиса.
+		IsSuccessful: func(err error) bool {
+			return err == nil || stderrors.Is(err, &errors.ErrForbiddenAddress{})
+		},
 	}
 
 	circuitBreaker := gobreaker.NewCircuitBreaker(circuitBreakerSettings)
@@ -60,7 +90,7 @@ func CreateResilientHTTPClient(cfg *config.Config, logger *slog.Logger, serviceN
 
 	client.SetTransport(&CircuitBreakerTransport{
 		resilientClient:   resilientClient,
-		originalTransport: http.DefaultTransport,
+		originalTransport: transport,
 	})
 
 	if logger != nil {
@@ -0,0 +1,46 @@
+package httputil
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"net/http"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/go-resty/resty/v2"
+	"github.com/sony/gobreaker"
+)
+
+// IsGone сообщает, что запрошенный ресурс удалён или недоступен.
+func IsGone(resp *resty.Response) bool {
+	return resp.StatusCode() == http.StatusNotFound || resp.StatusCode() == http.StatusGone
+}
+
+// IsTransient сообщает, что запрос не удался из-за временного сбоя — исчерпанной квоты, ошибки сервера,
+// открытого circuit breaker или сети — и его стоит повторить позже.
+func IsTransient(err error) bool {
+	if err == nil || stderrors.Is(err, &errors.ErrForbiddenAddress{}) {
+		return false
+	}
+
+	var (
+		httpErr *errors.HTTPError
+		apiErr  *errors.ErrStackExchangeAPI
+		netErr  net.Error
+	)
+
+	switch {
+	case stderrors.Is(err, &errors.ErrRateLimited{}):
+		return true
+	case stderrors.As(err, &httpErr):
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	case stderrors.As(err, &apiErr):
+		return apiErr.Temporary()
+	case stderrors.Is(err, gobreaker.ErrOpenState), stderrors.Is(err, gobreaker.ErrTooManyRequests):
+		return true
+	case stderrors.Is(err, context.DeadlineExceeded):
+		return true
+	default:
+		return stderrors.As(err, &netErr)
+	}
+}
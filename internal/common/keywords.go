@@ -0,0 +1,116 @@
+package common
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// markdownSpecials — символы, которые Telegram разбирает как разметку Markdown.
+const markdownSpecials = "_*`["
+
+// NormalizeKeywords убирает пробелы по краям и пропускает пустые и повторяющиеся без учёта регистра ключевые слова.
+func NormalizeKeywords(keywords []string) []string {
+	var result []string
+
+	seen := make(map[string]bool, len(keywords))
+
+	for _, keyword := range keywords {
+		keyword = strings.TrimSpace(keyword)
+		key := strings.ToLower(keyword)
+
+		if keyword == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		result = append(result, keyword)
+	}
+
+	return result
+}
+
+// MatchKeywords возвращает ключевые слова, которые без учёта регистра встречаются в заголовке или тексте обновления.
+// Текстом считается FullText, а если он пуст — TextPreview.
+func MatchKeywords(info *models.UpdateInfo, keywords []string) []string {
+	if info == nil {
+		return nil
+	}
+
+	text := info.FullText
+	if text == "" {
+		text = info.TextPreview
+	}
+
+	text = strings.ToLower(info.Title + "\n" + text)
+
+	var matched []string
+
+	for _, keyword := range keywords {
+		if strings.Contains(text, strings.ToLower(keyword)) {
+			matched = append(matched, keyword)
+		}
+	}
+
+	return matched
+}
+
+// HighlightKeywords экранирует текст для разметки Markdown Telegram и выделяет жирным вхождения ключевых слов.
+// Вхождение, содержащее символы разметки, только экранируется: Telegram не разбирает экранирование внутри сущности.
+func HighlightKeywords(text string, keywords []string) string {
+	keywords = NormalizeKeywords(keywords)
+	if len(keywords) == 0 {
+		return EscapeMarkdown(text)
+	}
+
+	// Длинные ключевые слова проверяются первыми, чтобы "CVE-2024" не выделялся как "CVE".
+	sort.SliceStable(keywords, func(i, j int) bool {
+		return len(keywords[i]) > len(keywords[j])
+	})
+
+	quoted := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		quoted = append(quoted, regexp.QuoteMeta(keyword))
+	}
+
+	pattern := regexp.MustCompile("(?i)" + strings.Join(quoted, "|"))
+
+	var sb strings.Builder
+
+	last := 0
+
+	for _, loc := range pattern.FindAllStringIndex(text, -1) {
+		sb.WriteString(EscapeMarkdown(text[last:loc[0]]))
+
+		match := text[loc[0]:loc[1]]
+		if strings.ContainsAny(match, markdownSpecials) {
+			sb.WriteString(EscapeMarkdown(match))
+		} else {
+			sb.WriteString("*" + match + "*")
+		}
+
+		last = loc[1]
+	}
+
+	sb.WriteString(EscapeMarkdown(text[last:]))
+
+	return sb.String()
+}
+
+// EscapeMarkdown экранирует символы разметки Markdown Telegram, чтобы текст выводился как есть.
+func EscapeMarkdown(text string) string {
+	var sb strings.Builder
+
+	for _, r := range text {
+		if strings.ContainsRune(markdownSpecials, r) {
+			sb.WriteRune('\\')
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
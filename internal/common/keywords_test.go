@@ -0,0 +1,77 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeKeywords(t *testing.T) {
+	keywords := common.NormalizeKeywords([]string{" deadlock ", "", "CVE-", "Deadlock", "  "})
+
+	assert.Equal(t, []string{"deadlock", "CVE-"}, keywords)
+	assert.Nil(t, common.NormalizeKeywords(nil))
+}
+
+func TestMatchKeywords(t *testing.T) {
+	issue := &models.UpdateInfo{
+		Title:       "Fix for cve-2024-1234",
+		FullText:    "The worker hits a Deadlock when the pool is closed",
+		TextPreview: "The worker hits",
+	}
+
+	assert.Equal(t, []string{"deadlock", "CVE-"}, common.MatchKeywords(issue, []string{"deadlock", "CVE-", "panic"}))
+	assert.Empty(t, common.MatchKeywords(issue, []string{"race"}))
+	assert.Equal(t, []string{"sync"},
+		common.MatchKeywords(&models.UpdateInfo{TextPreview: "Используйте sync.Once"}, []string{"sync"}),
+		"preview is used when there is no full text")
+	assert.Empty(t, common.MatchKeywords(nil, []string{"deadlock"}))
+}
+
+func TestHighlightKeywords(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		keywords []string
+		expected string
+	}{
+		{
+			name:     "highlight ignores case",
+			text:     "Found a Deadlock and another deadlock",
+			keywords: []string{"deadlock"},
+			expected: "Found a *Deadlock* and another *deadlock*",
+		},
+		{
+			name:     "markdown is escaped",
+			text:     "snake_case [link] *bold* `code`",
+			keywords: nil,
+			expected: "snake\\_case \\[link] \\*bold\\* \\`code\\`",
+		},
+		{
+			name:     "longer keyword wins",
+			text:     "CVE-2024-1234 fixed",
+			keywords: []string{"CVE", "cve-2024"},
+			expected: "*CVE-2024*-1234 fixed",
+		},
+		{
+			name:     "match with markup is only escaped",
+			text:     "call sync_once now",
+			keywords: []string{"sync_once"},
+			expected: "call sync\\_once now",
+		},
+		{
+			name:     "cyrillic",
+			text:     "Взаимная Блокировка потоков",
+			keywords: []string{"блокировка"},
+			expected: "Взаимная *Блокировка* потоков",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, common.HighlightKeywords(tt.text, tt.keywords))
+		})
+	}
+}
@@ -1,41 +1,83 @@
 package common
 
 import (
+	neturl "net/url"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/andybalholm/cascadia"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 )
 
+const (
+	webPageSelectorPrefix = "css="
+	gitLabHost            = "gitlab.com"
+)
+
+// stackExchangeRegex распознаёт вопросы на сайтах сети StackExchange: stackoverflow.com и его локализациях,
+// superuser.com, serverfault.com, askubuntu.com, stackapps.com, mathoverflow.net и *.stackexchange.com.
+var stackExchangeRegex = regexp.MustCompile(`(?i)^https?://(?:www\.)?(` + stackExchangeHosts +
+	`)/(?:questions|q)/(\d+)(?:[/?#].*)?$`)
+
+const stackExchangeHosts = `(?:[a-z0-9-]+\.)*(?:stackoverflow|superuser|serverfault|askubuntu|stackapps)\.com|` +
+	`(?:meta\.)?mathoverflow\.net|` +
+	`(?:[a-z0-9-]+\.)+stackexchange\.com`
+
+// LinkAnalyzer определяет тип ссылки по реестру источников.
 type LinkAnalyzer struct {
-	githubRegex        *regexp.Regexp
-	stackoverflowRegex *regexp.Regexp
+	registry *SourceRegistry
 }
 
-func NewLinkAnalyzer() *LinkAnalyzer {
+func NewLinkAnalyzer(registry *SourceRegistry) *LinkAnalyzer {
 	return &LinkAnalyzer{
-		githubRegex:        regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)(?:/.*)?$`),
-		stackoverflowRegex: regexp.MustCompile(`^https?://(?:www\.)?stackoverflow\.com/questions/(\d+)(?:/.*)?$`),
+		registry: registry,
 	}
 }
 
 func (a *LinkAnalyzer) AnalyzeLink(url string) models.LinkType {
-	if a.githubRegex.MatchString(url) {
-		return models.GitHub
+	source, ok := a.registry.Match(url)
+	if !ok {
+		return models.Unknown
+	}
+
+	return source.Type
+}
+
+// ParseLink определяет тип ссылки и проверяет, что её можно отслеживать.
+func (a *LinkAnalyzer) ParseLink(url string) (models.LinkType, error) {
+	source, ok := a.registry.Match(url)
+	if !ok {
+		return models.Unknown, &errors.ErrUnsupportedLinkType{URL: url}
 	}
 
-	if a.stackoverflowRegex.MatchString(url) {
-		return models.StackOverflow
+	if source.Parse != nil {
+		if err := source.Parse(url); err != nil {
+			return models.Unknown, err
+		}
 	}
 
-	return models.Unknown
+	return source.Type, nil
 }
 
-func ParseGitHubURL(url string) (owner, repo string, err error) {
-	re := regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)(?:/.*)?$`)
+// SupportedSources возвращает названия источников, ссылки на которые можно отслеживать.
+func (a *LinkAnalyzer) SupportedSources() []string {
+	return a.registry.DisplayNames()
+}
 
-	matches := re.FindStringSubmatch(url)
+// FormatLinkUpdate формирует текст уведомления об обновлении ссылки.
+func (a *LinkAnalyzer) FormatLinkUpdate(link *models.Link, info *models.UpdateInfo) string {
+	return a.registry.FormatLinkUpdate(link, info)
+}
+
+// IsUrgent сообщает, что обновления ссылок этого типа доставляются сразу, минуя дайджест.
+func (a *LinkAnalyzer) IsUrgent(linkType models.LinkType) bool {
+	return a.registry.IsUrgent(linkType)
+}
+
+func ParseGitHubURL(url string) (owner, repo string, err error) {
+	matches := githubRegex.FindStringSubmatch(url)
 	if len(matches) < 3 {
 		return "", "", &errors.ErrInvalidURL{URL: url}
 	}
@@ -43,18 +85,183 @@ func ParseGitHubURL(url string) (owner, repo string, err error) {
 	return matches[1], matches[2], nil
 }
 
-func ParseStackOverflowURL(url string) (questionID int64, err error) {
-	re := regexp.MustCompile(`^https?://(?:www\.)?stackoverflow\.com/questions/(\d+)(?:/.*)?$`)
+// ParseGitHubRefURL разбирает ссылки вида github.com/owner/repo/tree/ref/path и
+// github.com/owner/repo/blob/ref/path. Путь может быть пустым, если отслеживается вся ветка.
+func ParseGitHubRefURL(url string) (owner, repo, ref, path string, err error) {
+	matches := githubCommitsRegex.FindStringSubmatch(url)
+	if len(matches) < 5 {
+		return "", "", "", "", &errors.ErrInvalidURL{URL: url}
+	}
+
+	return matches[1], matches[2], matches[3], strings.Trim(matches[4], "/"), nil
+}
+
+// ParseGitHubIssueURL разбирает ссылки на отдельный issue или pull request.
+func ParseGitHubIssueURL(url string) (owner, repo string, number int64, err error) {
+	matches := githubIssueRegex.FindStringSubmatch(url)
+	if len(matches) < 4 {
+		return "", "", 0, &errors.ErrInvalidURL{URL: url}
+	}
+
+	number, err = strconv.ParseInt(matches[3], 10, 64)
+	if err != nil {
+		return "", "", 0, &errors.ErrInvalidURL{URL: url}
+	}
+
+	return matches[1], matches[2], number, nil
+}
+
+// ParseGitHubWorkflowURL разбирает ссылки вида github.com/owner/repo/actions/workflows/ci.yml.
+// Workflow задаётся именем файла или числовым ID, как его принимает GitHub API.
+func ParseGitHubWorkflowURL(url string) (owner, repo, workflow string, err error) {
+	matches := githubWorkflowRegex.FindStringSubmatch(url)
+	if len(matches) < 4 {
+		return "", "", "", &errors.ErrInvalidURL{URL: url}
+	}
+
+	return matches[1], matches[2], matches[3], nil
+}
+
+// ParseGitLabURL выделяет из ссылки на проект GitLab инсталляцию и полный путь проекта с учётом вложенных групп.
+// instances перечисляет адреса self-hosted инсталляций в виде хост[/путь]: GitLab может быть размещён не в корне
+// домена, и тогда путь инсталляции не входит в путь проекта. Если ни один адрес не подошёл, инсталляцией считается хост.
+// Всё, что следует за разделителем /-/ (вкладки merge requests, issues и т.п.), отбрасывается.
+func ParseGitLabURL(rawURL string, instances ...string) (instance, project string, err error) {
+	u, err := neturl.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", "", &errors.ErrInvalidURL{URL: rawURL}
+	}
+
+	host := strings.ToLower(u.Host)
+	path := strings.Trim(u.Path, "/")
+
+	instance, projectPath := host, path
+
+	// Если подходят несколько адресов, выбирается инсталляция с самым длинным путём.
+	for _, candidate := range instances {
+		candidateHost, prefix, ok := strings.Cut(candidate, "/")
+		if !ok || !strings.EqualFold(candidateHost, host) || len(candidate) <= len(instance) {
+			continue
+		}
+
+		if rest, found := strings.CutPrefix(path, prefix); found && (rest == "" || rest[0] == '/') {
+			instance, projectPath = candidate, rest
+		}
+	}
+
+	segments := make([]string, 0, 4)
+
+	for _, segment := range strings.Split(strings.Trim(projectPath, "/"), "/") {
+		if segment == "-" {
+			break
+		}
+
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	if len(segments) < 2 {
+		return "", "", &errors.ErrInvalidURL{URL: rawURL}
+	}
+
+	segments[len(segments)-1] = strings.TrimSuffix(segments[len(segments)-1], ".git")
+
+	return instance, strings.Join(segments, "/"), nil
+}
+
+// ParseWebPageURL отделяет от ссылки на веб-страницу CSS-селектор, переданный во фрагменте вида #css=<селектор>.
+// Пустой селектор (#css=) означает, что отслеживается вся страница.
+func ParseWebPageURL(rawURL string) (pageURL, selector string, err error) {
+	u, err := neturl.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", "", &errors.ErrInvalidURL{URL: rawURL}
+	}
+
+	if strings.HasPrefix(u.Fragment, webPageSelectorPrefix) {
+		selector = strings.TrimSpace(strings.TrimPrefix(u.Fragment, webPageSelectorPrefix))
+
+		if _, err := cascadia.Compile(selector); selector != "" && err != nil {
+			return "", "", &errors.ErrInvalidSelector{Selector: selector}
+		}
+	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	return u.String(), selector, nil
+}
+
+// WebPageURL добавляет CSS-селектор к ссылке на веб-страницу.
+func WebPageURL(pageURL, selector string) string {
+	if selector == "" {
+		return pageURL
+	}
+
+	u, err := neturl.Parse(pageURL)
+	if err != nil {
+		return pageURL
+	}
+
+	u.Fragment = webPageSelectorPrefix + selector
+	u.RawFragment = ""
 
-	matches := re.FindStringSubmatch(url)
-	if len(matches) < 2 {
-		return 0, &errors.ErrInvalidURL{URL: url}
+	return u.String()
+}
+
+// ParseStackOverflowURL разбирает ссылку на вопрос любого сайта сети StackExchange.
+// site — значение параметра site для StackExchange API, полученное из хоста ссылки.
+func ParseStackOverflowURL(url string) (site string, questionID int64, err error) {
+	matches := stackExchangeRegex.FindStringSubmatch(url)
+	if len(matches) < 3 {
+		return "", 0, &errors.ErrInvalidURL{URL: url}
 	}
 
-	questionID, err = strconv.ParseInt(matches[1], 10, 64)
+	questionID, err = strconv.ParseInt(matches[2], 10, 64)
 	if err != nil {
-		return 0, &errors.ErrInvalidURL{URL: url}
+		return "", 0, &errors.ErrInvalidURL{URL: url}
+	}
+
+	return StackExchangeSite(matches[1]), questionID, nil
+}
+
+// StackExchangeSite переводит хост сайта StackExchange в короткое имя, которое принимает API:
+// superuser.com → superuser, math.stackexchange.com → math, meta.math.stackexchange.com → math.meta.
+func StackExchangeSite(host string) string {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+
+	if name, ok := strings.CutSuffix(host, ".stackexchange.com"); ok {
+		if parent, isMeta := strings.CutPrefix(name, "meta."); isMeta {
+			return parent + ".meta"
+		}
+
+		return name
+	}
+
+	if name, ok := strings.CutSuffix(host, ".com"); ok {
+		return name
+	}
+
+	return host
+}
+
+// packageURLRegexes распознают страницы пакетов в реестрах. Суффикс с версией
+// (pkg.go.dev/...@v1.2.3, npmjs.com/package/foo/v/1.2.3, pypi.org/project/bar/1.2.3) отбрасывается.
+var packageURLRegexes = map[models.PackageRegistry]*regexp.Regexp{
+	models.GoModules: regexp.MustCompile(`^https?://pkg\.go\.dev/([^?#@]+?)(?:@[^/?#]*)?/?(?:[?#].*)?$`),
+	models.NPM:       regexp.MustCompile(`^https?://(?:www\.)?npmjs\.com/package/((?:@[^/?#]+/)?[^/?#@]+)(?:/v/[^/?#]+)?/?(?:[?#].*)?$`),
+	models.PyPI:      regexp.MustCompile(`^https?://(?:www\.)?pypi\.org/project/([^/?#]+)(?:/[^/?#]+)?/?(?:[?#].*)?$`),
+	models.Crates:    regexp.MustCompile(`^https?://(?:www\.)?crates\.io/crates/([^/?#]+)(?:/[^/?#]+)?/?(?:[?#].*)?$`),
+}
+
+// ParsePackageURL определяет реестр и имя пакета по ссылке на его страницу:
+// pkg.go.dev/<модуль>, npmjs.com/package/<имя>, pypi.org/project/<имя> или crates.io/crates/<имя>.
+func ParsePackageURL(url string) (registry models.PackageRegistry, name string, err error) {
+	for registry, re := range packageURLRegexes {
+		if matches := re.FindStringSubmatch(url); len(matches) == 2 {
+			return registry, matches[1], nil
+		}
 	}
 
-	return questionID, nil
+	return "", "", &errors.ErrInvalidURL{URL: url}
 }
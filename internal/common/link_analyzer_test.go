@@ -11,7 +11,7 @@ import (
 )
 
 func TestLinkAnalyzer_AnalyzeLink(t *testing.T) {
-	analyzer := common.NewLinkAnalyzer()
+	analyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry("git.example.com", "example.com/gitlab"))
 
 	tests := []struct {
 		name     string
@@ -29,15 +29,65 @@ func TestLinkAnalyzer_AnalyzeLink(t *testing.T) {
 			expected: models.GitHub,
 		},
 		{
-			name:     "GitHub URL with path",
+			name:     "GitHub branch URL",
 			url:      "https://github.com/owner/repo/tree/main",
-			expected: models.GitHub,
+			expected: models.GitHubCommits,
 		},
 		{
 			name:     "GitHub URL with HTTP",
 			url:      "http://github.com/owner/repo",
 			expected: models.GitHub,
 		},
+		{
+			name:     "GitHub file URL",
+			url:      "https://github.com/owner/repo/blob/main/go.mod",
+			expected: models.GitHubCommits,
+		},
+		{
+			name:     "GitHub issue URL",
+			url:      "https://github.com/owner/repo/issues/123",
+			expected: models.GitHubIssue,
+		},
+		{
+			name:     "GitHub pull request URL",
+			url:      "https://github.com/owner/repo/pull/45/files",
+			expected: models.GitHubIssue,
+		},
+		{
+			name:     "GitHub Actions workflow URL",
+			url:      "https://github.com/owner/repo/actions/workflows/ci.yml",
+			expected: models.GitHubWorkflow,
+		},
+		{
+			name:     "GitHub security advisories URL",
+			url:      "https://github.com/owner/repo/security/advisories",
+			expected: models.GitHubAdvisory,
+		},
+		{
+			name:     "RSS feed URL",
+			url:      "https://blog.example.com/feed/",
+			expected: models.Feed,
+		},
+		{
+			name:     "Go module URL",
+			url:      "https://pkg.go.dev/github.com/go-resty/resty/v2",
+			expected: models.Package,
+		},
+		{
+			name:     "npm package URL",
+			url:      "https://www.npmjs.com/package/@types/node",
+			expected: models.Package,
+		},
+		{
+			name:     "Atom feed URL",
+			url:      "https://go.dev/blog/feed.atom",
+			expected: models.Feed,
+		},
+		{
+			name:     "GitHub releases URL",
+			url:      "https://github.com/owner/repo/releases",
+			expected: models.GitHubRelease,
+		},
 		{
 			name:     "StackOverflow URL",
 			url:      "https://stackoverflow.com/questions/12345",
@@ -59,8 +109,68 @@ func TestLinkAnalyzer_AnalyzeLink(t *testing.T) {
 			expected: models.StackOverflow,
 		},
 		{
-			name:     "Invalid URL",
+			name:     "Server Fault URL",
+			url:      "https://serverfault.com/questions/555/nginx-timeout",
+			expected: models.StackOverflow,
+		},
+		{
+			name:     "StackExchange site URL",
+			url:      "https://unix.stackexchange.com/questions/123",
+			expected: models.StackOverflow,
+		},
+		{
+			name:     "GitLab project URL",
+			url:      "https://gitlab.com/group/subgroup/project",
+			expected: models.GitLab,
+		},
+		{
+			name:     "GitLab merge requests URL",
+			url:      "https://gitlab.com/group/project/-/merge_requests",
+			expected: models.GitLab,
+		},
+		{
+			name:     "Self-hosted GitLab URL",
+			url:      "https://git.example.com/team/service",
+			expected: models.GitLab,
+		},
+		{
+			name:     "Self-hosted GitLab under subpath",
+			url:      "https://example.com/gitlab/team/service",
+			expected: models.GitLab,
+		},
+		{
+			name:     "Unknown host with project-like path",
+			url:      "https://example.com/team/service",
+			expected: models.WebPage,
+		},
+		{
+			name:     "Web page URL",
 			url:      "https://example.com",
+			expected: models.WebPage,
+		},
+		{
+			name:     "Web page URL with selector",
+			url:      "https://status.example.com/#css=div.status",
+			expected: models.WebPage,
+		},
+		{
+			name:     "Mistyped GitHub URL marked as web page",
+			url:      "https://github.com/owner#css=",
+			expected: models.Unknown,
+		},
+		{
+			name:     "StackOverflow profile marked as web page",
+			url:      "https://stackoverflow.com/users/1#css=div.rep",
+			expected: models.Unknown,
+		},
+		{
+			name:     "Self-hosted GitLab page marked as web page",
+			url:      "https://example.com/gitlab/team#css=",
+			expected: models.Unknown,
+		},
+		{
+			name:     "Invalid URL",
+			url:      "example.com",
 			expected: models.Unknown,
 		},
 		{
@@ -139,30 +249,263 @@ func TestParseGitHubURL(t *testing.T) {
 	}
 }
 
+func TestParseGitHubRefURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		expectedRef  string
+		expectedPath string
+		expectedErr  error
+	}{
+		{
+			name:         "Branch URL",
+			url:          "https://github.com/owner/repo/tree/main",
+			expectedRef:  "main",
+			expectedPath: "",
+		},
+		{
+			name:         "Directory URL",
+			url:          "https://github.com/owner/repo/tree/main/docs/",
+			expectedRef:  "main",
+			expectedPath: "docs",
+		},
+		{
+			name:         "File URL",
+			url:          "https://github.com/owner/repo/blob/v1.0.0/internal/go.mod",
+			expectedRef:  "v1.0.0",
+			expectedPath: "internal/go.mod",
+		},
+		{
+			name:        "Repository URL",
+			url:         "https://github.com/owner/repo",
+			expectedErr: &errors.ErrInvalidURL{URL: "https://github.com/owner/repo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, repo, ref, path, err := common.ParseGitHubRefURL(tt.url)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "owner", owner)
+				assert.Equal(t, "repo", repo)
+				assert.Equal(t, tt.expectedRef, ref)
+				assert.Equal(t, tt.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestParseWebPageURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		url              string
+		expectedPage     string
+		expectedSelector string
+		expectedErr      error
+	}{
+		{
+			name:         "Whole page",
+			url:          "https://example.com/pricing",
+			expectedPage: "https://example.com/pricing",
+		},
+		{
+			name:             "Page with selector",
+			url:              common.WebPageURL("https://example.com/pricing", "table.plans > tr"),
+			expectedPage:     "https://example.com/pricing",
+			expectedSelector: "table.plans > tr",
+		},
+		{
+			name:         "Empty selector tracks whole page",
+			url:          "https://example.com/pricing#css=",
+			expectedPage: "https://example.com/pricing",
+		},
+		{
+			name:         "Ordinary fragment is dropped",
+			url:          "https://example.com/pricing#plans",
+			expectedPage: "https://example.com/pricing",
+		},
+		{
+			name:        "Invalid selector",
+			url:         "https://example.com/#css=div[",
+			expectedErr: &errors.ErrInvalidSelector{Selector: "div["},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, selector, err := common.ParseWebPageURL(tt.url)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPage, page)
+				assert.Equal(t, tt.expectedSelector, selector)
+			}
+		})
+	}
+}
+
+func TestParseGitLabURL(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		expectedHost    string
+		expectedProject string
+		expectedErr     error
+	}{
+		{
+			name:            "Project URL",
+			url:             "https://gitlab.com/group/project",
+			expectedHost:    "gitlab.com",
+			expectedProject: "group/project",
+		},
+		{
+			name:            "Nested groups with tab",
+			url:             "https://GitLab.example.com/group/sub/project/-/issues/5",
+			expectedHost:    "gitlab.example.com",
+			expectedProject: "group/sub/project",
+		},
+		{
+			name:            "Clone URL",
+			url:             "https://gitlab.com/group/project.git",
+			expectedHost:    "gitlab.com",
+			expectedProject: "group/project",
+		},
+		{
+			name:            "Instance under subpath",
+			url:             "https://example.com/gitlab/group/project/-/merge_requests",
+			expectedHost:    "example.com/gitlab",
+			expectedProject: "group/project",
+		},
+		{
+			name:            "Same host outside instance subpath",
+			url:             "https://example.com/gitlabx/project",
+			expectedHost:    "example.com",
+			expectedProject: "gitlabx/project",
+		},
+		{
+			name:        "Group without project",
+			url:         "https://gitlab.com/group",
+			expectedErr: &errors.ErrInvalidURL{URL: "https://gitlab.com/group"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, project, err := common.ParseGitLabURL(tt.url, "example.com/gitlab")
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedHost, host)
+				assert.Equal(t, tt.expectedProject, project)
+			}
+		})
+	}
+}
+
+func TestParsePackageURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		url              string
+		expectedRegistry models.PackageRegistry
+		expectedName     string
+	}{
+		{
+			name:             "Go module with version",
+			url:              "https://pkg.go.dev/golang.org/x/mod@v0.22.0",
+			expectedRegistry: models.GoModules,
+			expectedName:     "golang.org/x/mod",
+		},
+		{
+			name:             "Scoped npm package",
+			url:              "https://www.npmjs.com/package/@types/node/v/20.0.0",
+			expectedRegistry: models.NPM,
+			expectedName:     "@types/node",
+		},
+		{
+			name:             "PyPI project with version",
+			url:              "https://pypi.org/project/requests/2.31.0/",
+			expectedRegistry: models.PyPI,
+			expectedName:     "requests",
+		},
+		{
+			name:             "Crate",
+			url:              "https://crates.io/crates/serde",
+			expectedRegistry: models.Crates,
+			expectedName:     "serde",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, name, err := common.ParsePackageURL(tt.url)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRegistry, registry)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+
+	_, _, err := common.ParsePackageURL("https://pypi.org/search/?q=requests")
+	assert.Error(t, err)
+}
+
 func TestParseStackOverflowURL(t *testing.T) {
 	tests := []struct {
-		name        string
-		url         string
-		expectedID  int64
-		expectedErr error
+		name         string
+		url          string
+		expectedSite string
+		expectedID   int64
+		expectedErr  error
 	}{
 		{
-			name:        "Valid StackOverflow URL",
-			url:         "https://stackoverflow.com/questions/12345",
-			expectedID:  12345,
-			expectedErr: nil,
+			name:         "Valid StackOverflow URL",
+			url:          "https://stackoverflow.com/questions/12345",
+			expectedSite: "stackoverflow",
+			expectedID:   12345,
+			expectedErr:  nil,
+		},
+		{
+			name:         "StackOverflow URL with title",
+			url:          "https://stackoverflow.com/questions/12345/some-question-title",
+			expectedSite: "stackoverflow",
+			expectedID:   12345,
+			expectedErr:  nil,
+		},
+		{
+			name:         "StackOverflow URL with www",
+			url:          "https://www.stackoverflow.com/questions/12345",
+			expectedSite: "stackoverflow",
+			expectedID:   12345,
+			expectedErr:  nil,
+		},
+		{
+			name:         "Super User URL",
+			url:          "https://superuser.com/q/42",
+			expectedSite: "superuser",
+			expectedID:   42,
 		},
 		{
-			name:        "StackOverflow URL with title",
-			url:         "https://stackoverflow.com/questions/12345/some-question-title",
-			expectedID:  12345,
-			expectedErr: nil,
+			name:         "StackExchange subdomain URL",
+			url:          "https://math.stackexchange.com/questions/777/prove-it",
+			expectedSite: "math",
+			expectedID:   777,
 		},
 		{
-			name:        "StackOverflow URL with www",
-			url:         "https://www.stackoverflow.com/questions/12345",
-			expectedID:  12345,
-			expectedErr: nil,
+			name:         "Localized StackOverflow URL",
+			url:          "https://ru.stackoverflow.com/questions/100",
+			expectedSite: "ru.stackoverflow",
+			expectedID:   100,
 		},
 		{
 			name:        "Invalid StackOverflow URL format",
@@ -180,13 +523,14 @@ func TestParseStackOverflowURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			id, err := common.ParseStackOverflowURL(tt.url)
+			site, id, err := common.ParseStackOverflowURL(tt.url)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedErr.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSite, site)
 				assert.Equal(t, tt.expectedID, id)
 			}
 		})
@@ -2,6 +2,13 @@ package common
 
 import (
 	"context"
+	"crypto/sha256"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
@@ -11,11 +18,27 @@ import (
 type LinkUpdater interface {
 	GetLastUpdate(ctx context.Context, url string) (time.Time, error)
 	GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error)
+	// GetUpdatesSince возвращает отдельные события ресурса, произошедшие после since.
+	// Пустой результат означает, что конкретных событий нет и стоит использовать GetUpdateDetails.
+	GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error)
 }
 
 type GitHubClient interface {
 	GetRepositoryLastUpdate(ctx context.Context, owner, repo string) (time.Time, error)
 	GetRepositoryDetails(ctx context.Context, owner, repo string) (*models.ContentDetails, error)
+	GetIssuesLastUpdate(ctx context.Context, owner, repo string) (time.Time, error)
+	GetIssuesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error)
+	GetReleasesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error)
+	GetLatestRelease(ctx context.Context, owner, repo string) (*models.UpdateInfo, error)
+	GetCommitsSince(ctx context.Context, owner, repo, ref, path string, since time.Time) ([]*models.UpdateInfo, error)
+	GetLatestCommit(ctx context.Context, owner, repo, ref, path string) (*models.UpdateInfo, error)
+	GetIssue(ctx context.Context, owner, repo string, number int64) (*models.UpdateInfo, error)
+	GetIssueCommentsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetIssueEventsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetPullReviewsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetPullCommitsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetWorkflowRuns(ctx context.Context, owner, repo, workflow string) ([]*models.WorkflowRun, error)
+	GetSecurityAdvisories(ctx context.Context, owner, repo string) ([]*models.SecurityAdvisory, error)
 }
 
 type GitHubUpdater struct {
@@ -28,22 +51,943 @@ func NewGitHubUpdater(client GitHubClient) *GitHubUpdater {
 	}
 }
 
+// GetLastUpdate возвращает время последней активности репозитория. Новые issues, pull request'ы и комментарии
+// не меняют pushed_at и updated_at репозитория, поэтому время последнего изменения issues запрашивается отдельно.
+// Если репозиторий ответил 304 Not Modified, issues не запрашиваются, чтобы не тратить квоту на каждой проверке:
+// новый issue или pull request меняет open_issues_count, а вместе с ним и ETag репозитория.
 func (u *GitHubUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
 	owner, repo, err := ParseGitHubURL(url)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	return u.client.GetRepositoryLastUpdate(ctx, owner, repo)
+	repoUpdatedAt, err := u.client.GetRepositoryLastUpdate(ctx, owner, repo)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	issuesUpdatedAt, err := u.client.GetIssuesLastUpdate(ctx, owner, repo)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	snapshot := models.RepositorySnapshot{UpdatedAt: repoUpdatedAt, IssuesUpdatedAt: issuesUpdatedAt}
+
+	return snapshot.LastActivity(), nil
+}
+
+func (u *GitHubUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	owner, repo, err := ParseGitHubURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := u.client.GetRepositoryDetails(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UpdateInfo{
+		Title:       details.Title,
+		Author:      details.Author,
+		UpdatedAt:   details.UpdatedAt,
+		ContentType: "repository",
+		TextPreview: models.TextPreview(details.ContentText, 200),
+		FullText:    details.ContentText,
+	}, nil
+}
+
+func (u *GitHubUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	// При первой проверке отправлять всю историю issues не нужно.
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	owner, repo, err := ParseGitHubURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetIssuesSince(ctx, owner, repo, since)
+}
+
+// BatchLinkUpdater проверяет время последнего обновления сразу нескольких ссылок одним запросом.
+type BatchLinkUpdater interface {
+	LinkUpdater
+	// GetLastUpdates возвращает время последнего обновления по URL.
+	// Ссылки, которые не удалось проверить, отсутствуют в результате и проверяются по одной.
+	GetLastUpdates(ctx context.Context, urls []string) (map[string]time.Time, error)
+}
+
+// AcknowledgingUpdater реализуют обновители, которые сами запоминают, какие события уже учтены.
+// Запоминать их можно только после рассылки, иначе при её сбое события будут потеряны.
+type AcknowledgingUpdater interface {
+	LinkUpdater
+	// AcknowledgeUpdates сообщает, что события, полученные последним вызовом GetUpdatesSince, разосланы.
+	AcknowledgeUpdates(ctx context.Context, url string) error
+}
+
+// GitHubBatchClient получает состояние нескольких репозиториев одним запросом GraphQL API.
+type GitHubBatchClient interface {
+	GetRepositorySnapshots(ctx context.Context, repos []models.RepositoryRef) (map[models.RepositoryRef]*models.RepositorySnapshot, error)
+}
+
+// GitHubBatchUpdater проверяет репозитории пакетами через GraphQL API,
+// а события и детали обновления получает через REST API, как GitHubUpdater.
+type GitHubBatchUpdater struct {
+	*GitHubUpdater
+	batch GitHubBatchClient
+}
+
+func NewGitHubBatchUpdater(client GitHubClient, batch GitHubBatchClient) *GitHubBatchUpdater {
+	return &GitHubBatchUpdater{
+		GitHubUpdater: NewGitHubUpdater(client),
+		batch:         batch,
+	}
+}
+
+func (u *GitHubBatchUpdater) GetLastUpdates(ctx context.Context, urls []string) (map[string]time.Time, error) {
+	refs := make([]models.RepositoryRef, 0, len(urls))
+	urlsByRef := make(map[models.RepositoryRef][]string, len(urls))
+
+	for _, url := range urls {
+		owner, repo, err := ParseGitHubURL(url)
+		if err != nil {
+			continue
+		}
+
+		ref := models.RepositoryRef{Owner: owner, Name: repo}
+		if _, ok := urlsByRef[ref]; !ok {
+			refs = append(refs, ref)
+		}
+
+		urlsByRef[ref] = append(urlsByRef[ref], url)
+	}
+
+	snapshots, err := u.batch.GetRepositorySnapshots(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdates := make(map[string]time.Time, len(urls))
+
+	for ref, snapshot := range snapshots {
+		for _, url := range urlsByRef[ref] {
+			lastUpdates[url] = snapshot.LastActivity()
+		}
+	}
+
+	return lastUpdates, nil
+}
+
+// GitHubReleaseUpdater отслеживает публикацию новых релизов репозитория.
+// Теги, отправленные без релиза, не отслеживаются: у них нет даты публикации.
+type GitHubReleaseUpdater struct {
+	client GitHubClient
+}
+
+func NewGitHubReleaseUpdater(client GitHubClient) *GitHubReleaseUpdater {
+	return &GitHubReleaseUpdater{
+		client: client,
+	}
+}
+
+func (u *GitHubReleaseUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	latest, err := u.latestRelease(ctx, url)
+	if err != nil || latest == nil {
+		return time.Time{}, err
+	}
+
+	return latest.UpdatedAt, nil
+}
+
+func (u *GitHubReleaseUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	latest, err := u.latestRelease(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == nil {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	return latest, nil
+}
+
+func (u *GitHubReleaseUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	owner, repo, err := ParseGitHubURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetReleasesSince(ctx, owner, repo, since)
+}
+
+func (u *GitHubReleaseUpdater) latestRelease(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	owner, repo, err := ParseGitHubURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetLatestRelease(ctx, owner, repo)
+}
+
+// GitHubCommitsUpdater отслеживает коммиты в ветке или по конкретному пути репозитория.
+type GitHubCommitsUpdater struct {
+	client GitHubClient
+}
+
+func NewGitHubCommitsUpdater(client GitHubClient) *GitHubCommitsUpdater {
+	return &GitHubCommitsUpdater{
+		client: client,
+	}
+}
+
+func (u *GitHubCommitsUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	latest, err := u.latestCommit(ctx, url)
+	if err != nil || latest == nil {
+		return time.Time{}, err
+	}
+
+	return latest.UpdatedAt, nil
+}
+
+func (u *GitHubCommitsUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	latest, err := u.latestCommit(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == nil {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	return latest, nil
+}
+
+func (u *GitHubCommitsUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	owner, repo, ref, path, err := ParseGitHubRefURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetCommitsSince(ctx, owner, repo, ref, path, since)
+}
+
+func (u *GitHubCommitsUpdater) latestCommit(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	owner, repo, ref, path, err := ParseGitHubRefURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetLatestCommit(ctx, owner, repo, ref, path)
+}
+
+// GitHubIssueUpdater отслеживает события отдельного issue или pull request:
+// комментарии, ревью, изменения меток и состояния, новые коммиты.
+type GitHubIssueUpdater struct {
+	client GitHubClient
+}
+
+func NewGitHubIssueUpdater(client GitHubClient) *GitHubIssueUpdater {
+	return &GitHubIssueUpdater{
+		client: client,
+	}
+}
+
+func (u *GitHubIssueUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	details, err := u.GetUpdateDetails(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return details.UpdatedAt, nil
+}
+
+func (u *GitHubIssueUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	owner, repo, number, err := ParseGitHubIssueURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetIssue(ctx, owner, repo, number)
+}
+
+func (u *GitHubIssueUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	owner, repo, number, err := ParseGitHubIssueURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := u.client.GetIssue(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := u.client.GetIssueCommentsSince(ctx, owner, repo, number, since)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := u.client.GetIssueEventsSince(ctx, owner, repo, number, since)
+	if err != nil {
+		return nil, err
+	}
+
+	updates = append(updates, events...)
+
+	if issue.ContentType == "pull_request" {
+		reviews, err := u.client.GetPullReviewsSince(ctx, owner, repo, number, since)
+		if err != nil {
+			return nil, err
+		}
+
+		commits, err := u.client.GetPullCommitsSince(ctx, owner, repo, number, since)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, reviews...)
+		updates = append(updates, commits...)
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].UpdatedAt.Before(updates[j].UpdatedAt)
+	})
+
+	for _, update := range updates {
+		if update.ContentType == "commit" {
+			update.TextPreview = update.Title
+		}
+
+		update.Title = issue.Title
+	}
+
+	return updates, nil
+}
+
+// WorkflowRunStore хранит последний учтённый запуск workflow для каждой ссылки.
+type WorkflowRunStore interface {
+	// GetLastRun возвращает nil, если для ссылки ещё не сохранено ни одного запуска.
+	GetLastRun(ctx context.Context, url string) (*models.WorkflowRun, error)
+	SaveLastRun(ctx context.Context, url string, run *models.WorkflowRun) error
+}
+
+// GitHubWorkflowUpdater отслеживает смену итога запусков workflow GitHub Actions в ветке по умолчанию.
+// Уведомление приходит, только когда итог очередного запуска отличается от предыдущего:
+// успех сменился ошибкой, ошибка успехом или запуск отменили.
+// Последний запуск сохраняется только после рассылки, через AcknowledgeUpdates.
+type GitHubWorkflowUpdater struct {
+	client GitHubClient
+	store  WorkflowRunStore
+
+	mu      sync.Mutex
+	pending map[string]*models.WorkflowRun
+}
+
+func NewGitHubWorkflowUpdater(client GitHubClient, store WorkflowRunStore) *GitHubWorkflowUpdater {
+	return &GitHubWorkflowUpdater{
+		client:  client,
+		store:   store,
+		pending: make(map[string]*models.WorkflowRun),
+	}
+}
+
+// GetLastUpdate возвращает время последней смены итога после сохранённого запуска.
+// При первой проверке последний запуск только запоминается.
+func (u *GitHubWorkflowUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	runs, last, err := u.runs(ctx, url)
+	if err != nil || len(runs) == 0 {
+		return time.Time{}, err
+	}
+
+	if last == nil {
+		latest := runs[len(runs)-1]
+		return latest.UpdatedAt, u.store.SaveLastRun(ctx, url, latest)
+	}
+
+	transitions := workflowTransitions(last, runs)
+	if len(transitions) == 0 {
+		return last.UpdatedAt, nil
+	}
+
+	return transitions[len(transitions)-1].UpdatedAt, nil
+}
+
+func (u *GitHubWorkflowUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	runs, _, err := u.runs(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runs) == 0 {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	latest := runs[len(runs)-1]
+
+	return workflowRunToUpdateInfo(latest, ""), nil
+}
+
+// GetUpdatesSince возвращает запуски, сменившие итог после since. Последний запуск запоминается
+// только после рассылки, в AcknowledgeUpdates.
+func (u *GitHubWorkflowUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	runs, last, err := u.runs(ctx, url)
+	if err != nil || len(runs) == 0 {
+		return nil, err
+	}
+
+	u.mu.Lock()
+	u.pending[url] = runs[len(runs)-1]
+	u.mu.Unlock()
+
+	if last == nil || since.IsZero() {
+		return nil, nil
+	}
+
+	previous := workflowOutcome(last.Conclusion)
+
+	var updates []*models.UpdateInfo
+
+	for _, run := range workflowTransitions(last, runs) {
+		if run.UpdatedAt.After(since) {
+			updates = append(updates, workflowRunToUpdateInfo(run, previous))
+		}
+
+		previous = workflowOutcome(run.Conclusion)
+	}
+
+	return updates, nil
+}
+
+// AcknowledgeUpdates сохраняет последний запуск, полученный GetUpdatesSince.
+func (u *GitHubWorkflowUpdater) AcknowledgeUpdates(ctx context.Context, url string) error {
+	u.mu.Lock()
+	latest, ok := u.pending[url]
+	delete(u.pending, url)
+	u.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return u.store.SaveLastRun(ctx, url, latest)
+}
+
+// runs возвращает завершённые запуски workflow в хронологическом порядке и последний сохранённый запуск.
+func (u *GitHubWorkflowUpdater) runs(ctx context.Context, url string) ([]*models.WorkflowRun, *models.WorkflowRun, error) {
+	owner, repo, workflow, err := ParseGitHubWorkflowURL(url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runs, err := u.client.GetWorkflowRuns(ctx, owner, repo, workflow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	last, err := u.store.GetLastRun(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return runs, last, nil
+}
+
+// workflowTransitions возвращает запуски, завершившиеся после last, итог которых отличается от итога предыдущего.
+// Запуски с итогом, не относящимся к успеху, ошибке или отмене (например, skipped), цепочку не прерывают.
+func workflowTransitions(last *models.WorkflowRun, runs []*models.WorkflowRun) []*models.WorkflowRun {
+	previous := workflowOutcome(last.Conclusion)
+
+	var transitions []*models.WorkflowRun
+
+	for _, run := range runs {
+		if !run.UpdatedAt.After(last.UpdatedAt) {
+			continue
+		}
+
+		outcome := workflowOutcome(run.Conclusion)
+		if outcome == "" || outcome == previous {
+			continue
+		}
+
+		transitions = append(transitions, run)
+		previous = outcome
+	}
+
+	return transitions
+}
+
+// workflowOutcome сводит итог запуска к success, failure или cancelled. Для прочих итогов возвращается пустая строка.
+func workflowOutcome(conclusion string) string {
+	switch conclusion {
+	case "success", "cancelled":
+		return conclusion
+	case "failure", "timed_out", "startup_failure":
+		return "failure"
+	default:
+		return ""
+	}
+}
+
+func workflowOutcomeName(outcome string) string {
+	switch outcome {
+	case "success":
+		return "успешно"
+	case "failure":
+		return "с ошибкой"
+	case "cancelled":
+		return "отменён"
+	default:
+		return "неизвестно"
+	}
+}
+
+// workflowRunToUpdateInfo описывает запуск run. Пустой previous означает, что предыдущий итог неизвестен.
+func workflowRunToUpdateInfo(run *models.WorkflowRun, previous string) *models.UpdateInfo {
+	outcome := workflowOutcome(run.Conclusion)
+	if outcome == "" {
+		outcome = run.Conclusion
+	}
+
+	status := workflowOutcomeName(outcome)
+	if previous != "" {
+		status = workflowOutcomeName(previous) + " → " + status
+	}
+
+	shortSHA := run.HeadSHA
+	if len(shortSHA) > 7 {
+		shortSHA = shortSHA[:7]
+	}
+
+	subject, _, _ := strings.Cut(run.CommitMessage, "\n")
+
+	text := fmt.Sprintf("Статус: %s\nКоммит: %s %s\nЗапуск: %s", status, shortSHA, subject, run.URL)
+
+	return &models.UpdateInfo{
+		Title:       fmt.Sprintf("%s #%d", run.Name, run.Number),
+		Author:      run.Actor,
+		UpdatedAt:   run.UpdatedAt,
+		ContentType: "workflow_run",
+		TextPreview: text,
+		FullText:    text,
+	}
+}
+
+// AdvisoryStore хранит ключи учтённых бюллетеней безопасности для каждой ссылки.
+type AdvisoryStore interface {
+	// GetSeenAdvisories возвращает checked = false, если бюллетени ссылки ещё ни разу не сохранялись.
+	GetSeenAdvisories(ctx context.Context, url string) (keys []string, checked bool, err error)
+	ReplaceSeenAdvisories(ctx context.Context, url string, keys []string) error
+}
+
+// GitHubAdvisoryUpdater отслеживает бюллетени безопасности репозитория GitHub: публикацию нового бюллетеня
+// и назначение CVE уже опубликованному. Ключом учтённого бюллетеня служит GHSA-идентификатор вместе с CVE,
+// поэтому назначенный CVE даёт новый ключ. Ключи новых бюллетеней сохраняются только после рассылки,
+// через AcknowledgeUpdates.
+type GitHubAdvisoryUpdater struct {
+	client GitHubClient
+	store  AdvisoryStore
+
+	mu      sync.Mutex
+	pending map[string][]string
+}
+
+func NewGitHubAdvisoryUpdater(client GitHubClient, store AdvisoryStore) *GitHubAdvisoryUpdater {
+	return &GitHubAdvisoryUpdater{
+		client:  client,
+		store:   store,
+		pending: make(map[string][]string),
+	}
+}
+
+func (u *GitHubAdvisoryUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	advisories, err := u.advisories(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var lastUpdate time.Time
+
+	for _, advisory := range advisories {
+		if advisory.PublishedAt.After(lastUpdate) {
+			lastUpdate = advisory.PublishedAt
+		}
+	}
+
+	seen, checked, err := u.store.GetSeenAdvisories(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Бюллетени запоминаются при первой же проверке: если подписчиков ещё нет, GetUpdatesSince не вызывается,
+	// и без этого CVE, назначенный существовавшему бюллетеню, выглядел бы как первая проверка.
+	if !checked {
+		return lastUpdate, u.store.ReplaceSeenAdvisories(ctx, url, advisoryKeys(advisories))
+	}
+
+	// Назначение CVE не меняет дату публикации, поэтому о новых ключах сообщает текущее время.
+	if len(unseenAdvisories(advisories, seen)) > 0 {
+		if now := time.Now(); now.After(lastUpdate) {
+			lastUpdate = now
+		}
+	}
+
+	return lastUpdate, nil
+}
+
+func (u *GitHubAdvisoryUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	advisories, err := u.advisories(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(advisories) == 0 {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	latest := advisories[0]
+
+	for _, advisory := range advisories[1:] {
+		if advisory.PublishedAt.After(latest.PublishedAt) {
+			latest = advisory
+		}
+	}
+
+	return advisoryToUpdateInfo(latest, false), nil
+}
+
+// GetUpdatesSince возвращает бюллетени, которых не было при предыдущей проверке, и бюллетени с новым CVE.
+// При первой проверке бюллетени только запоминаются. Текущие ключи запоминаются только после рассылки,
+// в AcknowledgeUpdates.
+func (u *GitHubAdvisoryUpdater) GetUpdatesSince(ctx context.Context, url string, _ time.Time) ([]*models.UpdateInfo, error) {
+	advisories, err := u.advisories(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	seen, checked, err := u.store.GetSeenAdvisories(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	u.mu.Lock()
+	u.pending[url] = advisoryKeys(advisories)
+	u.mu.Unlock()
+
+	if !checked {
+		return nil, nil
+	}
+
+	unseen := unseenAdvisories(advisories, seen)
+
+	sort.SliceStable(unseen, func(i, j int) bool {
+		return unseen[i].UpdatedAt.Before(unseen[j].UpdatedAt)
+	})
+
+	updates := make([]*models.UpdateInfo, 0, len(unseen))
+
+	for _, advisory := range unseen {
+		updates = append(updates, advisoryToUpdateInfo(advisory, slices.Contains(seen, advisory.GHSAID)))
+	}
+
+	return updates, nil
+}
+
+func (u *GitHubAdvisoryUpdater) advisories(ctx context.Context, url string) ([]*models.SecurityAdvisory, error) {
+	owner, repo, err := ParseGitHubURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.GetSecurityAdvisories(ctx, owner, repo)
+}
+
+// AcknowledgeUpdates сохраняет ключи бюллетеней, полученных GetUpdatesSince.
+func (u *GitHubAdvisoryUpdater) AcknowledgeUpdates(ctx context.Context, url string) error {
+	u.mu.Lock()
+	keys, ok := u.pending[url]
+	delete(u.pending, url)
+	u.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return u.store.ReplaceSeenAdvisories(ctx, url, keys)
+}
+
+// advisoryKeys возвращает ключи текущего состояния бюллетеней.
+func advisoryKeys(advisories []*models.SecurityAdvisory) []string {
+	keys := make([]string, 0, len(advisories))
+
+	for _, advisory := range advisories {
+		keys = append(keys, advisoryKey(advisory))
+	}
+
+	return keys
+}
+
+// advisoryKey возвращает ключ учтённого состояния бюллетеня: GHSA-идентификатор и CVE, если он назначен.
+func advisoryKey(advisory *models.SecurityAdvisory) string {
+	if advisory.CVEID == "" {
+		return advisory.GHSAID
+	}
+
+	return advisory.GHSAID + "/" + advisory.CVEID
+}
+
+func unseenAdvisories(advisories []*models.SecurityAdvisory, seen []string) []*models.SecurityAdvisory {
+	seenSet := make(map[string]struct{}, len(seen))
+	for _, key := range seen {
+		seenSet[key] = struct{}{}
+	}
+
+	var unseen []*models.SecurityAdvisory
+
+	for _, advisory := range advisories {
+		if _, ok := seenSet[advisoryKey(advisory)]; !ok {
+			unseen = append(unseen, advisory)
+		}
+	}
+
+	return unseen
+}
+
+// advisoryToUpdateInfo описывает бюллетень. cveAssigned означает, что бюллетень уже был известен без CVE.
+func advisoryToUpdateInfo(advisory *models.SecurityAdvisory, cveAssigned bool) *models.UpdateInfo {
+	var text strings.Builder
+
+	if cveAssigned {
+		fmt.Fprintf(&text, "Бюллетеню назначен %s\n", advisory.CVEID)
+	} else if advisory.CVEID != "" {
+		fmt.Fprintf(&text, "CVE: %s\n", advisory.CVEID)
+	}
+
+	fmt.Fprintf(&text, "Критичность: %s\n", advisory.Severity)
+
+	for _, vulnerability := range advisory.Vulnerabilities {
+		fmt.Fprintf(&text, "Затронуты: %s %s", vulnerability.Package, vulnerability.VulnerableRange)
+
+		if vulnerability.PatchedVersions != "" {
+			fmt.Fprintf(&text, ", исправлено в %s", vulnerability.PatchedVersions)
+		}
+
+		text.WriteString("\n")
+	}
+
+	text.WriteString(advisory.Summary)
+
+	if advisory.URL != "" {
+		text.WriteString("\n" + advisory.URL)
+	}
+
+	updatedAt := advisory.PublishedAt
+	if cveAssigned {
+		updatedAt = advisory.UpdatedAt
+	}
+
+	return &models.UpdateInfo{
+		Title:       fmt.Sprintf("%s: %s", advisory.GHSAID, advisory.Summary),
+		Author:      advisory.Publisher,
+		UpdatedAt:   updatedAt,
+		ContentType: "security_advisory",
+		TextPreview: text.String(),
+		FullText:    text.String() + "\n\n" + advisory.Description,
+		Labels:      []string{advisory.Severity},
+	}
+}
+
+type StackOverflowClient interface {
+	GetQuestionLastUpdate(ctx context.Context, site string, questionID int64) (time.Time, error)
+	GetQuestionsLastUpdate(ctx context.Context, site string, questionIDs []int64) (map[int64]time.Time, error)
+	GetQuestionDetails(ctx context.Context, site string, questionID int64) (*models.ContentDetails, error)
+	GetAnswersSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetCommentsSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error)
+}
+
+type StackOverflowUpdater struct {
+	client StackOverflowClient
+}
+
+func NewStackOverflowUpdater(client StackOverflowClient) *StackOverflowUpdater {
+	return &StackOverflowUpdater{
+		client: client,
+	}
+}
+
+func (u *StackOverflowUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	site, questionID, err := ParseStackOverflowURL(url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return u.client.GetQuestionLastUpdate(ctx, site, questionID)
+}
+
+// GetLastUpdates проверяет вопросы пакетно: по одному запросу на каждые 100 вопросов одного сайта StackExchange.
+func (u *StackOverflowUpdater) GetLastUpdates(ctx context.Context, urls []string) (map[string]time.Time, error) {
+	sites := make([]string, 0)
+	idsBySite := make(map[string][]int64)
+	urlsByQuestion := make(map[string]map[int64][]string)
+
+	for _, url := range urls {
+		site, questionID, err := ParseStackOverflowURL(url)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := urlsByQuestion[site]; !ok {
+			sites = append(sites, site)
+			urlsByQuestion[site] = make(map[int64][]string)
+		}
+
+		if _, ok := urlsByQuestion[site][questionID]; !ok {
+			idsBySite[site] = append(idsBySite[site], questionID)
+		}
+
+		urlsByQuestion[site][questionID] = append(urlsByQuestion[site][questionID], url)
+	}
+
+	lastUpdates := make(map[string]time.Time, len(urls))
+
+	for _, site := range sites {
+		questions, err := u.client.GetQuestionsLastUpdate(ctx, site, idsBySite[site])
+		if err != nil {
+			return nil, err
+		}
+
+		for questionID, lastUpdate := range questions {
+			for _, url := range urlsByQuestion[site][questionID] {
+				lastUpdates[url] = lastUpdate
+			}
+		}
+	}
+
+	return lastUpdates, nil
+}
+
+func (u *StackOverflowUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	site, questionID, err := ParseStackOverflowURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := u.client.GetQuestionDetails(ctx, site, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UpdateInfo{
+		Title:       details.Title,
+		Author:      details.Author,
+		UpdatedAt:   details.UpdatedAt,
+		ContentType: "question",
+		TextPreview: models.TextPreview(details.ContentText, 200),
+		FullText:    details.ContentText,
+	}, nil
+}
+
+func (u *StackOverflowUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	site, questionID, err := ParseStackOverflowURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := u.client.GetAnswersSince(ctx, site, questionID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := u.client.GetCommentsSince(ctx, site, questionID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(answers)+len(comments))
+	updates = append(updates, answers...)
+	updates = append(updates, comments...)
+
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].UpdatedAt.Before(updates[j].UpdatedAt)
+	})
+
+	// Ответы и комментарии не содержат заголовка, поэтому берём его из самого вопроса.
+	details, err := u.client.GetQuestionDetails(ctx, site, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, update := range updates {
+		update.Title = details.Title
+	}
+
+	return updates, nil
+}
+
+type GitLabClient interface {
+	GetProjectLastActivity(ctx context.Context, project string) (time.Time, error)
+	GetProjectDetails(ctx context.Context, project string) (*models.ContentDetails, error)
+	GetMergeRequestsSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+	GetIssuesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+	GetReleasesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+}
+
+// GitLabUpdater отслеживает проекты на gitlab.com и self-hosted инсталляциях GitLab.
+// Клиенты передаются по адресам инсталляций в виде хост[/путь], клиент выбирается по инсталляции ссылки.
+type GitLabUpdater struct {
+	clients   map[string]GitLabClient
+	instances []string
+}
+
+func NewGitLabUpdater(clients map[string]GitLabClient) *GitLabUpdater {
+	instances := make([]string, 0, len(clients))
+	for instance := range clients {
+		instances = append(instances, instance)
+	}
+
+	return &GitLabUpdater{
+		clients:   clients,
+		instances: instances,
+	}
 }
 
-func (u *GitHubUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
-	owner, repo, err := ParseGitHubURL(url)
+func (u *GitLabUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	client, project, err := u.resolve(url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return client.GetProjectLastActivity(ctx, project)
+}
+
+func (u *GitLabUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	client, project, err := u.resolve(url)
 	if err != nil {
 		return nil, err
 	}
 
-	details, err := u.client.GetRepositoryDetails(ctx, owner, repo)
+	details, err := client.GetProjectDetails(ctx, project)
 	if err != nil {
 		return nil, err
 	}
@@ -52,77 +996,573 @@ func (u *GitHubUpdater) GetUpdateDetails(ctx context.Context, url string) (*mode
 		Title:       details.Title,
 		Author:      details.Author,
 		UpdatedAt:   details.UpdatedAt,
-		ContentType: "repository",
+		ContentType: "project",
 		TextPreview: models.TextPreview(details.ContentText, 200),
 		FullText:    details.ContentText,
 	}, nil
 }
 
-type StackOverflowClient interface {
-	GetQuestionLastUpdate(ctx context.Context, questionID int64) (time.Time, error)
-	GetQuestionDetails(ctx context.Context, questionID int64) (*models.ContentDetails, error)
+func (u *GitLabUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	client, project, err := u.resolve(url)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := client.GetMergeRequestsSince(ctx, project, since)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := client.GetIssuesSince(ctx, project, since)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := client.GetReleasesSince(ctx, project, since)
+	if err != nil {
+		return nil, err
+	}
+
+	updates = append(updates, issues...)
+	updates = append(updates, releases...)
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].UpdatedAt.Before(updates[j].UpdatedAt)
+	})
+
+	return updates, nil
 }
 
-type StackOverflowUpdater struct {
-	client StackOverflowClient
+func (u *GitLabUpdater) resolve(url string) (GitLabClient, string, error) {
+	instance, project, err := ParseGitLabURL(url, u.instances...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, ok := u.clients[instance]
+	if !ok {
+		return nil, "", &errors.ErrUnsupportedLinkType{URL: url}
+	}
+
+	return client, project, nil
 }
 
-func NewStackOverflowUpdater(client StackOverflowClient) *StackOverflowUpdater {
-	return &StackOverflowUpdater{
-		client: client,
+// PackageRegistryClient получает историю версий пакета из реестра.
+type PackageRegistryClient interface {
+	// GetVersions возвращает опубликованные версии пакета в порядке публикации: последней идёт текущая версия.
+	GetVersions(ctx context.Context, name string) ([]models.PackageVersion, error)
+}
+
+// PackageUpdater отслеживает публикацию новых версий пакетов в реестрах Go, npm, PyPI и crates.io.
+// Клиент выбирается по реестру, которому принадлежит ссылка.
+type PackageUpdater struct {
+	clients map[models.PackageRegistry]PackageRegistryClient
+}
+
+func NewPackageUpdater(clients map[models.PackageRegistry]PackageRegistryClient) *PackageUpdater {
+	return &PackageUpdater{
+		clients: clients,
 	}
 }
 
-func (u *StackOverflowUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
-	questionID, err := ParseStackOverflowURL(url)
+func (u *PackageUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	_, versions, _, err := u.versions(ctx, url)
+	if err != nil || len(versions) == 0 {
+		return time.Time{}, err
+	}
+
+	return versions[len(versions)-1].PublishedAt, nil
+}
+
+func (u *PackageUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	registry, versions, name, err := u.versions(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	return packageVersionToUpdateInfo(registry, name, versions, len(versions)-1), nil
+}
+
+// GetUpdatesSince возвращает версии, опубликованные после since, вместе с предшествующей каждой из них версией.
+func (u *PackageUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	registry, versions, name, err := u.versions(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0)
+
+	for i, version := range versions {
+		if version.PublishedAt.After(since) {
+			updates = append(updates, packageVersionToUpdateInfo(registry, name, versions, i))
+		}
+	}
+
+	return updates, nil
+}
+
+func (u *PackageUpdater) versions(ctx context.Context,
+	url string) (models.PackageRegistry, []models.PackageVersion, string, error) {
+	registry, name, err := ParsePackageURL(url)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	client, ok := u.clients[registry]
+	if !ok {
+		return "", nil, "", &errors.ErrUnsupportedLinkType{URL: url}
+	}
+
+	versions, err := client.GetVersions(ctx, name)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	return registry, versions, name, nil
+}
+
+func packageVersionToUpdateInfo(registry models.PackageRegistry, name string, versions []models.PackageVersion,
+	index int) *models.UpdateInfo {
+	version := versions[index]
+
+	previous := "нет"
+	if index > 0 {
+		previous = versions[index-1].Version
+	}
+
+	text := fmt.Sprintf("Новая версия: %s\nПредыдущая версия: %s", version.Version, previous)
+
+	return &models.UpdateInfo{
+		Title:       name + " " + version.Version,
+		Author:      registry.DisplayName(),
+		UpdatedAt:   version.PublishedAt,
+		ContentType: "version",
+		TextPreview: text,
+		FullText:    text,
+	}
+}
+
+type FeedClient interface {
+	GetFeed(ctx context.Context, url string) ([]*models.FeedEntry, error)
+}
+
+// FeedEntryStore хранит идентификаторы уже обработанных записей ленты для каждой ссылки.
+type FeedEntryStore interface {
+	GetSeenGUIDs(ctx context.Context, url string) ([]string, error)
+	ReplaceSeenGUIDs(ctx context.Context, url string, guids []string) error
+}
+
+// feedReuseWindow — сколько записи, загруженные GetLastUpdate, переиспользуются остальными методами проверки.
+const feedReuseWindow = 30 * time.Second
+
+// FeedUpdater отслеживает появление новых записей в RSS и Atom лентах.
+// Новизна записи определяется по её GUID, а не по дате: многие ленты не указывают даты или меняют их задним числом.
+// Лента загружается и разбирается один раз за проверку: остальные методы используют записи, полученные GetLastUpdate.
+// GUID новых записей сохраняются только после рассылки, через AcknowledgeUpdates.
+type FeedUpdater struct {
+	client FeedClient
+	store  FeedEntryStore
+
+	mu      sync.Mutex
+	fetched map[string]*fetchedFeed
+	pending map[string][]string
+}
+
+// fetchedFeed — записи ленты и время их загрузки.
+type fetchedFeed struct {
+	entries   []*models.FeedEntry
+	fetchedAt time.Time
+}
+
+func NewFeedUpdater(client FeedClient, store FeedEntryStore) *FeedUpdater {
+	return &FeedUpdater{
+		client:  client,
+		store:   store,
+		fetched: make(map[string]*fetchedFeed),
+		pending: make(map[string][]string),
+	}
+}
+
+// entries возвращает записи ленты, загруженные не раньше feedReuseWindow назад, или загружает её заново.
+func (u *FeedUpdater) entries(ctx context.Context, url string) ([]*models.FeedEntry, error) {
+	u.mu.Lock()
+	feed, ok := u.fetched[url]
+	u.mu.Unlock()
+
+	if ok && time.Since(feed.fetchedAt) < feedReuseWindow {
+		return feed.entries, nil
+	}
+
+	return u.fetch(ctx, url)
+}
+
+// fetch загружает ленту и запоминает её записи для остальных вызовов той же проверки.
+func (u *FeedUpdater) fetch(ctx context.Context, url string) ([]*models.FeedEntry, error) {
+	entries, err := u.client.GetFeed(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for feedURL, feed := range u.fetched {
+		if now.Sub(feed.fetchedAt) >= feedReuseWindow {
+			delete(u.fetched, feedURL)
+		}
+	}
+
+	u.fetched[url] = &fetchedFeed{entries: entries, fetchedAt: now}
+
+	return entries, nil
+}
+
+func (u *FeedUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	entries, err := u.fetch(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var lastUpdate time.Time
+
+	for _, entry := range entries {
+		if entry.PublishedAt.After(lastUpdate) {
+			lastUpdate = entry.PublishedAt
+		}
+	}
+
+	seen, err := u.store.GetSeenGUIDs(ctx, url)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	return u.client.GetQuestionLastUpdate(ctx, questionID)
+	// Записи запоминаются при первой же проверке: время добавления ссылки сохраняется как время её обновления,
+	// и без этого записи без даты никогда не выглядели бы новыми.
+	if len(seen) == 0 {
+		guids := feedGUIDs(entries)
+		if len(guids) == 0 {
+			return lastUpdate, nil
+		}
+
+		return lastUpdate, u.store.ReplaceSeenGUIDs(ctx, url, guids)
+	}
+
+	if len(unseenEntries(entries, seen)) > 0 {
+		if now := time.Now(); now.After(lastUpdate) {
+			lastUpdate = now
+		}
+	}
+
+	return lastUpdate, nil
 }
 
-func (u *StackOverflowUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
-	questionID, err := ParseStackOverflowURL(url)
+func (u *FeedUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	entries, err := u.entries(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, &errors.ErrDetailsNotFound{}
+	}
+
+	latest := entries[0]
+
+	for _, entry := range entries[1:] {
+		if entry.PublishedAt.After(latest.PublishedAt) {
+			latest = entry
+		}
+	}
+
+	return feedEntryToUpdateInfo(latest), nil
+}
+
+// GetUpdatesSince возвращает записи, которых не было при предыдущей проверке.
+// При первой проверке ленты записи только запоминаются, чтобы не присылать весь архив.
+// Текущие GUID запоминаются только после рассылки, в AcknowledgeUpdates.
+func (u *FeedUpdater) GetUpdatesSince(ctx context.Context, url string, _ time.Time) ([]*models.UpdateInfo, error) {
+	entries, err := u.entries(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
-	details, err := u.client.GetQuestionDetails(ctx, questionID)
+	seen, err := u.store.GetSeenGUIDs(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
+	// Пустой ответ скорее говорит о временной проблеме ленты, сохранённые GUID не сбрасываются.
+	if guids := feedGUIDs(entries); len(guids) > 0 {
+		u.mu.Lock()
+		u.pending[url] = guids
+		u.mu.Unlock()
+	}
+
+	if len(seen) == 0 {
+		return nil, nil
+	}
+
+	unseen := unseenEntries(entries, seen)
+
+	// Ленты перечисляют записи от новых к старым.
+	updates := make([]*models.UpdateInfo, 0, len(unseen))
+	for i := len(unseen) - 1; i >= 0; i-- {
+		updates = append(updates, feedEntryToUpdateInfo(unseen[i]))
+	}
+
+	return updates, nil
+}
+
+// AcknowledgeUpdates сохраняет GUID записей, полученных GetUpdatesSince.
+func (u *FeedUpdater) AcknowledgeUpdates(ctx context.Context, url string) error {
+	u.mu.Lock()
+	guids, ok := u.pending[url]
+	delete(u.pending, url)
+	u.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return u.store.ReplaceSeenGUIDs(ctx, url, guids)
+}
+
+func feedGUIDs(entries []*models.FeedEntry) []string {
+	guids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		guids = append(guids, entry.GUID)
+	}
+
+	return guids
+}
+
+func unseenEntries(entries []*models.FeedEntry, seen []string) []*models.FeedEntry {
+	seenSet := make(map[string]struct{}, len(seen))
+	for _, guid := range seen {
+		seenSet[guid] = struct{}{}
+	}
+
+	var unseen []*models.FeedEntry
+
+	for _, entry := range entries {
+		if _, ok := seenSet[entry.GUID]; !ok {
+			unseen = append(unseen, entry)
+		}
+	}
+
+	return unseen
+}
+
+func feedEntryToUpdateInfo(entry *models.FeedEntry) *models.UpdateInfo {
 	return &models.UpdateInfo{
-		Title:       details.Title,
-		Author:      details.Author,
-		UpdatedAt:   details.UpdatedAt,
-		ContentType: "question",
-		TextPreview: models.TextPreview(details.ContentText, 200),
-		FullText:    details.ContentText,
+		Title:       entry.Title,
+		Author:      entry.Author,
+		UpdatedAt:   entry.PublishedAt,
+		ContentType: "feed_entry",
+		TextPreview: models.TextPreview(entry.Summary, 200),
+		FullText:    entry.Summary,
+	}
+}
+
+type WebPageClient interface {
+	GetPageContent(ctx context.Context, url, selector string) (*models.ContentDetails, error)
+}
+
+// PageSnapshotStore возвращает последний сохранённый снимок страницы.
+type PageSnapshotStore interface {
+	FindByURL(ctx context.Context, url string) (*models.ContentDetails, error)
+}
+
+// pageReuseWindow — сколько страница, загруженная GetLastUpdate, переиспользуется остальными методами проверки.
+const pageReuseWindow = 30 * time.Second
+
+// WebPageUpdater отслеживает изменения текста веб-страницы или её части, заданной CSS-селектором.
+// Снимок страницы хранится в деталях контента ссылки и сравнивается по хешу нормализованного текста.
+// Страница загружается один раз за проверку, чтобы все методы сравнивали один и тот же её вариант.
+type WebPageUpdater struct {
+	client WebPageClient
+	store  PageSnapshotStore
+
+	mu      sync.Mutex
+	fetched map[string]*fetchedPage
+}
+
+// fetchedPage — содержимое страницы и время его загрузки.
+type fetchedPage struct {
+	content   *models.ContentDetails
+	fetchedAt time.Time
+}
+
+func NewWebPageUpdater(client WebPageClient, store PageSnapshotStore) *WebPageUpdater {
+	return &WebPageUpdater{
+		client:  client,
+		store:   store,
+		fetched: make(map[string]*fetchedPage),
+	}
+}
+
+func (u *WebPageUpdater) GetLastUpdate(ctx context.Context, url string) (time.Time, error) {
+	current, err := u.fetch(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	previous, err := u.snapshot(ctx, url)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if previous == nil || pageHash(previous.ContentText) != pageHash(current.ContentText) {
+		return time.Now(), nil
+	}
+
+	return previous.UpdatedAt, nil
+}
+
+func (u *WebPageUpdater) GetUpdateDetails(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	current, err := u.page(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UpdateInfo{
+		Title:       current.Title,
+		UpdatedAt:   time.Now(),
+		ContentType: "page",
+		TextPreview: models.TextPreview(current.ContentText, 200),
+		FullText:    current.ContentText,
 	}, nil
 }
 
+func (u *WebPageUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	if since.IsZero() {
+		return nil, nil
+	}
+
+	current, err := u.page(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := u.snapshot(ctx, url)
+	if err != nil || previous == nil {
+		return nil, err
+	}
+
+	if pageHash(previous.ContentText) == pageHash(current.ContentText) {
+		return nil, nil
+	}
+
+	return []*models.UpdateInfo{{
+		Title:       current.Title,
+		UpdatedAt:   time.Now(),
+		ContentType: "page_change",
+		TextPreview: PageDiffPreview(previous.ContentText, current.ContentText),
+		FullText:    current.ContentText,
+	}}, nil
+}
+
+// page возвращает содержимое страницы, загруженное не раньше pageReuseWindow назад, или загружает её заново.
+func (u *WebPageUpdater) page(ctx context.Context, url string) (*models.ContentDetails, error) {
+	u.mu.Lock()
+	page, ok := u.fetched[url]
+	u.mu.Unlock()
+
+	if ok && time.Since(page.fetchedAt) < pageReuseWindow {
+		return page.content, nil
+	}
+
+	return u.fetch(ctx, url)
+}
+
+// fetch загружает страницу и запоминает её содержимое для остальных вызовов той же проверки.
+func (u *WebPageUpdater) fetch(ctx context.Context, url string) (*models.ContentDetails, error) {
+	pageURL, selector, err := ParseWebPageURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := u.client.GetPageContent(ctx, pageURL, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for pageURL, page := range u.fetched {
+		if now.Sub(page.fetchedAt) >= pageReuseWindow {
+			delete(u.fetched, pageURL)
+		}
+	}
+
+	u.fetched[url] = &fetchedPage{content: content, fetchedAt: now}
+
+	return content, nil
+}
+
+func (u *WebPageUpdater) snapshot(ctx context.Context, url string) (*models.ContentDetails, error) {
+	details, err := u.store.FindByURL(ctx, url)
+	if err != nil {
+		var notFoundErr *errors.ErrLinkNotFound
+		if stderrors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return details, nil
+}
+
+func pageHash(text string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(text))
+}
+
+// LinkUpdaterFactory выдаёт LinkUpdater для типа ссылки, собирая их из зарегистрированных источников.
 type LinkUpdaterFactory struct {
-	githubUpdater        *GitHubUpdater
-	stackOverflowUpdater *StackOverflowUpdater
+	updaters map[models.LinkType]LinkUpdater
 }
 
-func NewLinkUpdaterFactory(githubClient GitHubClient, stackOverflowClient StackOverflowClient) *LinkUpdaterFactory {
+func NewLinkUpdaterFactory(registry *SourceRegistry, deps *UpdaterDependencies) *LinkUpdaterFactory {
+	updaters := make(map[models.LinkType]LinkUpdater, len(registry.Sources()))
+
+	for _, source := range registry.Sources() {
+		updaters[source.Type] = source.NewUpdater(deps)
+	}
+
 	return &LinkUpdaterFactory{
-		githubUpdater:        NewGitHubUpdater(githubClient),
-		stackOverflowUpdater: NewStackOverflowUpdater(stackOverflowClient),
+		updaters: updaters,
 	}
 }
 
+// CreateBatchUpdater возвращает средство пакетной проверки, если источник ссылок его поддерживает.
+func (f *LinkUpdaterFactory) CreateBatchUpdater(linkType models.LinkType) (BatchLinkUpdater, bool) {
+	updater, ok := f.updaters[linkType].(BatchLinkUpdater)
+	return updater, ok
+}
+
 func (f *LinkUpdaterFactory) CreateUpdater(linkType models.LinkType) (LinkUpdater, error) {
-	//nolint:exhaustive // Unknown обрабатывается в default
-	switch linkType {
-	case models.GitHub:
-		return f.githubUpdater, nil
-	case models.StackOverflow:
-		return f.stackOverflowUpdater, nil
-	default:
+	updater, ok := f.updaters[linkType]
+	if !ok {
 		return nil, &errors.ErrUnsupportedLinkType{URL: string(linkType)}
 	}
+
+	return updater, nil
 }
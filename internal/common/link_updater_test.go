@@ -0,0 +1,679 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/common/mocks"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackOverflowUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	client := mocks.NewStackOverflowClient(t)
+	updater := common.NewStackOverflowUpdater(client)
+
+	answer := &models.UpdateInfo{Author: "alice", ContentType: "answer", UpdatedAt: since.Add(2 * time.Minute)}
+	comment := &models.UpdateInfo{Author: "bob", ContentType: "comment", UpdatedAt: since.Add(time.Minute)}
+
+	client.On("GetAnswersSince", ctx, "stackoverflow", int64(12345), since).Return([]*models.UpdateInfo{answer}, nil).Once()
+	client.On("GetCommentsSince", ctx, "stackoverflow", int64(12345), since).Return([]*models.UpdateInfo{comment}, nil).Once()
+	client.On("GetQuestionDetails", ctx, "stackoverflow", int64(12345)).Return(&models.ContentDetails{Title: "How to lock?"}, nil).Once()
+
+	updates, err := updater.GetUpdatesSince(ctx, "https://stackoverflow.com/questions/12345/how-to-lock", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "comment", updates[0].ContentType)
+	assert.Equal(t, "answer", updates[1].ContentType)
+
+	for _, update := range updates {
+		assert.Equal(t, "How to lock?", update.Title)
+	}
+}
+
+func TestStackOverflowUpdater_GetUpdatesSince_FirstCheck(t *testing.T) {
+	client := mocks.NewStackOverflowClient(t)
+	updater := common.NewStackOverflowUpdater(client)
+
+	updates, err := updater.GetUpdatesSince(context.Background(), "https://stackoverflow.com/questions/12345", time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, updates)
+}
+
+func TestStackOverflowUpdater_GetLastUpdates(t *testing.T) {
+	ctx := context.Background()
+
+	client := mocks.NewStackOverflowClient(t)
+	updater := common.NewStackOverflowUpdater(client)
+
+	client.On("GetQuestionsLastUpdate", ctx, "stackoverflow", []int64{1, 2}).
+		Return(map[int64]time.Time{1: time.Unix(100, 0), 2: time.Unix(200, 0)}, nil).Once()
+	client.On("GetQuestionsLastUpdate", ctx, "math", []int64{1}).
+		Return(map[int64]time.Time{1: time.Unix(300, 0)}, nil).Once()
+
+	lastUpdates, err := updater.GetLastUpdates(ctx, []string{
+		"https://stackoverflow.com/questions/1",
+		"https://math.stackexchange.com/questions/1",
+		"https://stackoverflow.com/questions/2/title",
+		"https://stackoverflow.com/q/1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]time.Time{
+		"https://stackoverflow.com/questions/1":       time.Unix(100, 0),
+		"https://stackoverflow.com/q/1":               time.Unix(100, 0),
+		"https://stackoverflow.com/questions/2/title": time.Unix(200, 0),
+		"https://math.stackexchange.com/questions/1":  time.Unix(300, 0),
+	}, lastUpdates)
+}
+
+func TestGitHubIssueUpdater_GetUpdatesSince_PullRequest(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	client := mocks.NewGitHubClient(t)
+	updater := common.NewGitHubIssueUpdater(client)
+
+	pull := &models.UpdateInfo{Title: "#7 Add cache", ContentType: "pull_request"}
+	comment := &models.UpdateInfo{Author: "alice", ContentType: "issue_comment", UpdatedAt: since.Add(3 * time.Minute)}
+	labeled := &models.UpdateInfo{Author: "bob", ContentType: "labeled", UpdatedAt: since.Add(time.Minute)}
+	review := &models.UpdateInfo{Author: "carol", ContentType: "review", UpdatedAt: since.Add(4 * time.Minute)}
+	commit := &models.UpdateInfo{Title: "abc1234 Fix tests", ContentType: "commit", UpdatedAt: since.Add(2 * time.Minute)}
+
+	client.On("GetIssue", ctx, "owner", "repo", int64(7)).Return(pull, nil).Once()
+	client.On("GetIssueCommentsSince", ctx, "owner", "repo", int64(7), since).
+		Return([]*models.UpdateInfo{comment}, nil).Once()
+	client.On("GetIssueEventsSince", ctx, "owner", "repo", int64(7), since).
+		Return([]*models.UpdateInfo{labeled}, nil).Once()
+	client.On("GetPullReviewsSince", ctx, "owner", "repo", int64(7), since).
+		Return([]*models.UpdateInfo{review}, nil).Once()
+	client.On("GetPullCommitsSince", ctx, "owner", "repo", int64(7), since).
+		Return([]*models.UpdateInfo{commit}, nil).Once()
+
+	updates, err := updater.GetUpdatesSince(ctx, "https://github.com/owner/repo/pull/7", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 4)
+
+	assert.Equal(t, "labeled", updates[0].ContentType)
+	assert.Equal(t, "commit", updates[1].ContentType)
+	assert.Equal(t, "abc1234 Fix tests", updates[1].TextPreview)
+	assert.Equal(t, "issue_comment", updates[2].ContentType)
+	assert.Equal(t, "review", updates[3].ContentType)
+
+	for _, update := range updates {
+		assert.Equal(t, "#7 Add cache", update.Title)
+	}
+}
+
+func TestGitHubUpdater_NewIssueWithoutRepositoryChange(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var (
+		issueOpened   atomic.Bool
+		issueRequests atomic.Int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/repos/owner/repo":
+			// Новый issue не меняет ни pushed_at, ни updated_at репозитория, меняется только open_issues_count.
+			openIssues := 0
+			if issueOpened.Load() {
+				openIssues = 1
+			}
+
+			etag := fmt.Sprintf(`"repo-v%d"`, openIssues)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", etag)
+
+			if _, err := fmt.Fprintf(w, `{"full_name": "owner/repo", "pushed_at": "2024-01-01T10:00:00Z",
+				"updated_at": "2024-01-01T09:00:00Z", "open_issues_count": %d}`, openIssues); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+		case "/repos/owner/repo/issues":
+			issueRequests.Add(1)
+
+			response := `[]`
+			if issueOpened.Load() {
+				response = `[{"number": 5, "title": "Crash on start", "updated_at": "2024-01-01T12:00:00Z",
+					"user": {"login": "alice"}}]`
+			}
+
+			if _, err := w.Write([]byte(response)); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	updater := common.NewGitHubUpdater(clients.NewGitHubClient("", server.URL, cfg, logger))
+
+	url := "https://github.com/owner/repo"
+	validators := &httputil.Validators{}
+	ctx := httputil.WithValidators(context.Background(), validators)
+
+	checkedAt, err := updater.GetLastUpdate(ctx, url)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), checkedAt)
+	assert.Equal(t, `"repo-v0"`, validators.ETag)
+
+	// Пока репозиторий отвечает 304, список issues не запрашивается.
+	_, err = updater.GetLastUpdate(ctx, url)
+	require.ErrorIs(t, err, &errors.ErrNotModified{})
+	assert.Equal(t, int32(1), issueRequests.Load())
+
+	issueOpened.Store(true)
+
+	lastUpdate, err := updater.GetLastUpdate(ctx, url)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), lastUpdate)
+
+	updates, err := updater.GetUpdatesSince(ctx, url, checkedAt)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	assert.Equal(t, "#5 Crash on start", updates[0].Title)
+	assert.Equal(t, "issue", updates[0].ContentType)
+}
+
+func TestGitHubBatchUpdater_GetLastUpdates(t *testing.T) {
+	ctx := context.Background()
+	pushedAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	batch := mocks.NewGitHubBatchClient(t)
+	updater := common.NewGitHubBatchUpdater(mocks.NewGitHubClient(t), batch)
+
+	repo := models.RepositoryRef{Owner: "owner", Name: "repo"}
+	deleted := models.RepositoryRef{Owner: "owner", Name: "deleted"}
+
+	// Две ссылки на один репозиторий запрашиваются один раз.
+	batch.On("GetRepositorySnapshots", ctx, []models.RepositoryRef{repo, deleted}).
+		Return(map[models.RepositoryRef]*models.RepositorySnapshot{
+			repo: {PushedAt: pushedAt, UpdatedAt: pushedAt.Add(-time.Hour)},
+		}, nil).Once()
+
+	lastUpdates, err := updater.GetLastUpdates(ctx, []string{
+		"https://github.com/owner/repo",
+		"https://github.com/owner/repo/",
+		"https://github.com/owner/deleted",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]time.Time{
+		"https://github.com/owner/repo":  pushedAt,
+		"https://github.com/owner/repo/": pushedAt,
+	}, lastUpdates)
+}
+
+func TestGitLabUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	selfHosted := mocks.NewGitLabClient(t)
+	updater := common.NewGitLabUpdater(map[string]common.GitLabClient{
+		"gitlab.com":      mocks.NewGitLabClient(t),
+		"git.example.com": selfHosted,
+	})
+
+	subpath := mocks.NewGitLabClient(t)
+	subpathUpdater := common.NewGitLabUpdater(map[string]common.GitLabClient{
+		"gitlab.com":         mocks.NewGitLabClient(t),
+		"example.com/gitlab": subpath,
+	})
+
+	mergeRequest := &models.UpdateInfo{Title: "!4 Fix build", ContentType: "merge_request", UpdatedAt: since.Add(2 * time.Minute)}
+	issue := &models.UpdateInfo{Title: "#7 Crash", ContentType: "gitlab_issue", UpdatedAt: since.Add(time.Minute)}
+
+	selfHosted.On("GetMergeRequestsSince", ctx, "team/service", since).Return([]*models.UpdateInfo{mergeRequest}, nil).Once()
+	selfHosted.On("GetIssuesSince", ctx, "team/service", since).Return([]*models.UpdateInfo{issue}, nil).Once()
+	selfHosted.On("GetReleasesSince", ctx, "team/service", since).Return(nil, nil).Once()
+
+	updates, err := updater.GetUpdatesSince(ctx, "https://git.example.com/team/service/-/merge_requests", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "gitlab_issue", updates[0].ContentType)
+	assert.Equal(t, "merge_request", updates[1].ContentType)
+
+	_, err = updater.GetUpdatesSince(ctx, "https://other.example.com/team/service", since)
+	assert.Error(t, err)
+
+	subpath.On("GetMergeRequestsSince", ctx, "team/service", since).Return(nil, nil).Once()
+	subpath.On("GetIssuesSince", ctx, "team/service", since).Return([]*models.UpdateInfo{issue}, nil).Once()
+	subpath.On("GetReleasesSince", ctx, "team/service", since).Return(nil, nil).Once()
+
+	updates, err = subpathUpdater.GetUpdatesSince(ctx, "https://example.com/gitlab/team/service", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+}
+
+func TestPackageUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	npm := mocks.NewPackageRegistryClient(t)
+	updater := common.NewPackageUpdater(map[models.PackageRegistry]common.PackageRegistryClient{
+		models.NPM: npm,
+	})
+
+	npm.On("GetVersions", ctx, "@scope/lib").Return([]models.PackageVersion{
+		{Version: "1.0.0", PublishedAt: since.Add(-time.Hour)},
+		{Version: "1.1.0", PublishedAt: since.Add(time.Minute)},
+		{Version: "2.0.0", PublishedAt: since.Add(2 * time.Minute)},
+	}, nil).Twice()
+
+	updates, err := updater.GetUpdatesSince(ctx, "https://www.npmjs.com/package/@scope/lib", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "@scope/lib 1.1.0", updates[0].Title)
+	assert.Contains(t, updates[0].TextPreview, "Предыдущая версия: 1.0.0")
+	assert.Equal(t, "@scope/lib 2.0.0", updates[1].Title)
+	assert.Contains(t, updates[1].TextPreview, "Предыдущая версия: 1.1.0")
+	assert.Equal(t, "npm", updates[1].Author)
+
+	lastUpdate, err := updater.GetLastUpdate(ctx, "https://www.npmjs.com/package/@scope/lib")
+	require.NoError(t, err)
+	assert.Equal(t, since.Add(2*time.Minute), lastUpdate)
+
+	_, err = updater.GetLastUpdate(ctx, "https://crates.io/crates/serde")
+	assert.Error(t, err)
+}
+
+func TestGitHubWorkflowUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	url := "https://github.com/owner/repo/actions/workflows/ci.yml"
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	run := func(id int64, conclusion string) *models.WorkflowRun {
+		return &models.WorkflowRun{
+			ID:            id,
+			Number:        id,
+			Name:          "CI",
+			Conclusion:    conclusion,
+			URL:           fmt.Sprintf("https://github.com/owner/repo/actions/runs/%d", id),
+			HeadSHA:       "abcdef1234567890",
+			CommitMessage: "Fix tests\n\nDetails",
+			Actor:         "alice",
+			UpdatedAt:     base.Add(time.Duration(id) * time.Minute),
+		}
+	}
+
+	runs := []*models.WorkflowRun{
+		run(1, "success"),
+		run(2, "success"),
+		run(3, "failure"),
+		run(4, "skipped"),
+		run(5, "timed_out"),
+		run(6, "success"),
+	}
+
+	t.Run("FirstCheck", func(t *testing.T) {
+		client := mocks.NewGitHubClient(t)
+		store := mocks.NewWorkflowRunStore(t)
+		updater := common.NewGitHubWorkflowUpdater(client, store)
+
+		client.On("GetWorkflowRuns", ctx, "owner", "repo", "ci.yml").Return(runs, nil).Once()
+		store.On("GetLastRun", ctx, url).Return(nil, nil).Once()
+		store.On("SaveLastRun", ctx, url, runs[5]).Return(nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, runs[5].UpdatedAt, lastUpdate)
+	})
+
+	t.Run("StatusChanges", func(t *testing.T) {
+		client := mocks.NewGitHubClient(t)
+		store := mocks.NewWorkflowRunStore(t)
+		updater := common.NewGitHubWorkflowUpdater(client, store)
+
+		client.On("GetWorkflowRuns", ctx, "owner", "repo", "ci.yml").Return(runs, nil).Times(3)
+		store.On("GetLastRun", ctx, url).Return(runs[0], nil).Times(3)
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, runs[5].UpdatedAt, lastUpdate)
+
+		updates, err := updater.GetUpdatesSince(ctx, url, base)
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+
+		// Последний запуск сохраняется только после рассылки.
+		store.AssertNotCalled(t, "SaveLastRun", mock.Anything, mock.Anything, mock.Anything)
+		store.On("SaveLastRun", ctx, url, runs[5]).Return(nil).Once()
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+
+		assert.Equal(t, "CI #3", updates[0].Title)
+		assert.Equal(t, "alice", updates[0].Author)
+		assert.Equal(t, "workflow_run", updates[0].ContentType)
+		assert.Equal(t, "Статус: успешно → с ошибкой\nКоммит: abcdef1 Fix tests\n"+
+			"Запуск: https://github.com/owner/repo/actions/runs/3", updates[0].TextPreview)
+
+		assert.Equal(t, "CI #6", updates[1].Title)
+		assert.Contains(t, updates[1].TextPreview, "Статус: с ошибкой → успешно")
+
+		// Смены итога не позже since уже отправлены.
+		updates, err = updater.GetUpdatesSince(ctx, url, runs[3].UpdatedAt)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.Equal(t, "CI #6", updates[0].Title)
+		assert.Contains(t, updates[0].TextPreview, "Статус: с ошибкой → успешно")
+	})
+
+	t.Run("SameStatus", func(t *testing.T) {
+		client := mocks.NewGitHubClient(t)
+		store := mocks.NewWorkflowRunStore(t)
+		updater := common.NewGitHubWorkflowUpdater(client, store)
+
+		client.On("GetWorkflowRuns", ctx, "owner", "repo", "ci.yml").Return(runs[:3], nil).Once()
+		store.On("GetLastRun", ctx, url).Return(runs[2], nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, runs[2].UpdatedAt, lastUpdate)
+	})
+}
+
+func TestGitHubAdvisoryUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	url := "https://github.com/owner/repo/security/advisories"
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	advisory := func(id, cve string, published time.Time) *models.SecurityAdvisory {
+		return &models.SecurityAdvisory{
+			GHSAID:      id,
+			CVEID:       cve,
+			Summary:     "Path traversal in " + id,
+			Severity:    "high",
+			PublishedAt: published,
+			UpdatedAt:   published,
+		}
+	}
+
+	t.Run("CVEAssignedToAdvisoryPublishedBeforeTracking", func(t *testing.T) {
+		client := mocks.NewGitHubClient(t)
+		store := mocks.NewAdvisoryStore(t)
+		updater := common.NewGitHubAdvisoryUpdater(client, store)
+
+		published := advisory("GHSA-1111", "", base)
+
+		withCVE := advisory("GHSA-1111", "CVE-2024-0001", base)
+		withCVE.UpdatedAt = base.Add(time.Hour)
+
+		// Первая проверка запоминает бюллетень, даже если GetUpdatesSince не будет вызван.
+		client.On("GetSecurityAdvisories", ctx, "owner", "repo").
+			Return([]*models.SecurityAdvisory{published}, nil).Once()
+		store.On("GetSeenAdvisories", ctx, url).Return(nil, false, nil).Once()
+		store.On("ReplaceSeenAdvisories", ctx, url, []string{"GHSA-1111"}).Return(nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, base, lastUpdate)
+
+		client.On("GetSecurityAdvisories", ctx, "owner", "repo").
+			Return([]*models.SecurityAdvisory{withCVE}, nil).Twice()
+		store.On("GetSeenAdvisories", ctx, url).Return([]string{"GHSA-1111"}, true, nil).Twice()
+		store.On("ReplaceSeenAdvisories", ctx, url, []string{"GHSA-1111/CVE-2024-0001"}).Return(nil).Once()
+
+		lastUpdate, err = updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.True(t, lastUpdate.After(base), "назначение CVE должно считаться обновлением")
+
+		updates, err := updater.GetUpdatesSince(ctx, url, base)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.Contains(t, updates[0].TextPreview, "Бюллетеню назначен CVE-2024-0001")
+		assert.Equal(t, withCVE.UpdatedAt, updates[0].UpdatedAt)
+
+		// Пока обновление не разослано, назначенный CVE не считается учтённым.
+		store.AssertNumberOfCalls(t, "ReplaceSeenAdvisories", 1)
+
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+	})
+
+	t.Run("TwoAdvisoriesInOneCheck", func(t *testing.T) {
+		client := mocks.NewGitHubClient(t)
+		store := mocks.NewAdvisoryStore(t)
+		updater := common.NewGitHubAdvisoryUpdater(client, store)
+
+		first := advisory("GHSA-2222", "CVE-2024-0002", base.Add(time.Minute))
+		second := advisory("GHSA-3333", "", base.Add(2*time.Minute))
+
+		// Репозиторий без бюллетеней уже проверялся: оба новых бюллетеня должны прийти, а не быть приняты
+		// за первую проверку.
+		client.On("GetSecurityAdvisories", ctx, "owner", "repo").
+			Return([]*models.SecurityAdvisory{second, first}, nil).Twice()
+		store.On("GetSeenAdvisories", ctx, url).Return(nil, true, nil).Twice()
+		store.On("ReplaceSeenAdvisories", ctx, url, []string{"GHSA-3333", "GHSA-2222/CVE-2024-0002"}).
+			Return(nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.False(t, lastUpdate.Before(second.PublishedAt))
+
+		updates, err := updater.GetUpdatesSince(ctx, url, base)
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+
+		assert.Equal(t, "GHSA-2222: Path traversal in GHSA-2222", updates[0].Title)
+		assert.Contains(t, updates[0].TextPreview, "CVE: CVE-2024-0002")
+		assert.Equal(t, "GHSA-3333: Path traversal in GHSA-3333", updates[1].Title)
+	})
+}
+
+func TestFeedUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	feed := `<?xml version="1.0"?>
+<rss version="2.0"><channel>
+  <item><guid>post-3</guid><title>Third</title><author>carol</author><description>New one</description></item>
+  <item><guid>post-2</guid><title>Second</title><author>bob</author><description>Also new</description></item>
+  <item><guid>post-1</guid><title>First</title><author>alice</author><description>Old</description></item>
+</channel></rss>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := w.Write([]byte(feed)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+		AllowPrivateNetworks:       true,
+	}
+
+	url := server.URL + "/feed.xml"
+	allGUIDs := []string{"post-3", "post-2", "post-1"}
+
+	t.Run("FirstCheck", func(t *testing.T) {
+		store := mocks.NewFeedEntryStore(t)
+		updater := common.NewFeedUpdater(clients.NewFeedClient(cfg, logger), store)
+
+		// Записи без дат запоминаются уже при проверке времени обновления, иначе новые записи никогда не нашлись бы.
+		store.On("GetSeenGUIDs", ctx, url).Return(nil, nil).Once()
+		store.On("ReplaceSeenGUIDs", ctx, url, allGUIDs).Return(nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.True(t, lastUpdate.IsZero())
+	})
+
+	t.Run("UndatedEntryAfterFirstCheck", func(t *testing.T) {
+		store := mocks.NewFeedEntryStore(t)
+		updater := common.NewFeedUpdater(clients.NewFeedClient(cfg, logger), store)
+
+		store.On("GetSeenGUIDs", ctx, url).Return([]string{"post-2", "post-1"}, nil).Once()
+
+		checkedAt := time.Now()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.False(t, lastUpdate.Before(checkedAt))
+	})
+
+	t.Run("NewEntries", func(t *testing.T) {
+		store := mocks.NewFeedEntryStore(t)
+		updater := common.NewFeedUpdater(clients.NewFeedClient(cfg, logger), store)
+
+		store.On("GetSeenGUIDs", ctx, url).Return([]string{"post-1"}, nil).Once()
+
+		updates, err := updater.GetUpdatesSince(ctx, url, time.Now())
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+
+		assert.Equal(t, "Second", updates[0].Title)
+		assert.Equal(t, "bob", updates[0].Author)
+		assert.Equal(t, "Also new", updates[0].TextPreview)
+		assert.Equal(t, "Third", updates[1].Title)
+		assert.Equal(t, "feed_entry", updates[1].ContentType)
+
+		// Пока записи не разосланы, они не считаются учтёнными.
+		store.AssertNotCalled(t, "ReplaceSeenGUIDs", mock.Anything, mock.Anything, mock.Anything)
+
+		store.On("ReplaceSeenGUIDs", ctx, url, allGUIDs).Return(nil).Once()
+
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+		require.NoError(t, updater.AcknowledgeUpdates(ctx, url))
+	})
+
+	t.Run("FetchedOncePerCheck", func(t *testing.T) {
+		var requests atomic.Int32
+
+		counting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+
+			if _, err := w.Write([]byte(feed)); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+		}))
+		defer counting.Close()
+
+		countingURL := counting.URL + "/feed.xml"
+
+		store := mocks.NewFeedEntryStore(t)
+		updater := common.NewFeedUpdater(clients.NewFeedClient(cfg, logger), store)
+
+		store.On("GetSeenGUIDs", ctx, countingURL).Return([]string{"post-1"}, nil).Twice()
+
+		_, err := updater.GetLastUpdate(ctx, countingURL)
+		require.NoError(t, err)
+
+		updates, err := updater.GetUpdatesSince(ctx, countingURL, time.Now())
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestWebPageUpdater_GetUpdatesSince(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+
+		page := `<html><head><title>Pricing</title></head><body>
+<h1>Plans</h1><div id="plans"><p>Basic: $10</p><p>Pro: $25</p></div></body></html>`
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+		AllowPrivateNetworks:       true,
+	}
+
+	url := common.WebPageURL(server.URL+"/pricing", "#plans")
+	checkedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Changed", func(t *testing.T) {
+		store := mocks.NewPageSnapshotStore(t)
+		updater := common.NewWebPageUpdater(clients.NewWebPageClient(cfg, logger), store)
+
+		store.On("FindByURL", ctx, url).
+			Return(&models.ContentDetails{ContentText: "Basic: $10\nPro: $20", UpdatedAt: checkedAt}, nil).Twice()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.True(t, lastUpdate.After(checkedAt))
+
+		updates, err := updater.GetUpdatesSince(ctx, url, checkedAt)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+
+		assert.Equal(t, "Pricing", updates[0].Title)
+		assert.Equal(t, "page_change", updates[0].ContentType)
+		assert.Equal(t, "- Pro: $20\n+ Pro: $25", updates[0].TextPreview)
+		assert.Equal(t, "Basic: $10\nPro: $25", updates[0].FullText)
+
+		details, err := updater.GetUpdateDetails(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, "Pricing", details.Title)
+
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		store := mocks.NewPageSnapshotStore(t)
+		updater := common.NewWebPageUpdater(clients.NewWebPageClient(cfg, logger), store)
+
+		store.On("FindByURL", ctx, url).
+			Return(&models.ContentDetails{ContentText: "Basic: $10\nPro: $25", UpdatedAt: checkedAt}, nil).Once()
+
+		lastUpdate, err := updater.GetLastUpdate(ctx, url)
+		require.NoError(t, err)
+		assert.Equal(t, checkedAt, lastUpdate)
+	})
+}
@@ -226,7 +226,7 @@ func initializeTestApplication(ctx context.Context, t *testing.T, cfg *config.Co
 	telegramClient.On("SetMyCommands", mock.Anything, mock.Anything).Return(nil).Maybe()
 	telegramClient.On("GetBot").Return(nil).Maybe()
 
-	linkAnalyzer := common.NewLinkAnalyzer()
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
 
 	baseBotService := botservice.NewBotService(
 		chatStateRepo,
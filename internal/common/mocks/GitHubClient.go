@@ -15,6 +15,334 @@ type GitHubClient struct {
 	mock.Mock
 }
 
+// GetCommitsSince provides a mock function with given fields: ctx, owner, repo, ref, path, since
+func (_m *GitHubClient) GetCommitsSince(ctx context.Context, owner string, repo string, ref string, path string, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, ref, path, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommitsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, ref, path, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, ref, path, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, ref, path, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIssue provides a mock function with given fields: ctx, owner, repo, number
+func (_m *GitHubClient) GetIssue(ctx context.Context, owner string, repo string, number int64) (*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssue")
+	}
+
+	var r0 *models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) (*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) *models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, owner, repo, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIssueCommentsSince provides a mock function with given fields: ctx, owner, repo, number, since
+func (_m *GitHubClient) GetIssueCommentsSince(ctx context.Context, owner string, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, number, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssueCommentsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, number, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, number, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, number, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIssueEventsSince provides a mock function with given fields: ctx, owner, repo, number, since
+func (_m *GitHubClient) GetIssueEventsSince(ctx context.Context, owner string, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, number, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssueEventsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, number, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, number, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, number, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIssuesLastUpdate provides a mock function with given fields: ctx, owner, repo
+func (_m *GitHubClient) GetIssuesLastUpdate(ctx context.Context, owner string, repo string) (time.Time, error) {
+	ret := _m.Called(ctx, owner, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssuesLastUpdate")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (time.Time, error)); ok {
+		return rf(ctx, owner, repo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) time.Time); ok {
+		r0 = rf(ctx, owner, repo)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, owner, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIssuesSince provides a mock function with given fields: ctx, owner, repo, since
+func (_m *GitHubClient) GetIssuesSince(ctx context.Context, owner string, repo string, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssuesSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestCommit provides a mock function with given fields: ctx, owner, repo, ref, path
+func (_m *GitHubClient) GetLatestCommit(ctx context.Context, owner string, repo string, ref string, path string) (*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, ref, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestCommit")
+	}
+
+	var r0 *models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, ref, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, ref, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, owner, repo, ref, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRelease provides a mock function with given fields: ctx, owner, repo
+func (_m *GitHubClient) GetLatestRelease(ctx context.Context, owner string, repo string) (*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestRelease")
+	}
+
+	var r0 *models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, owner, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPullCommitsSince provides a mock function with given fields: ctx, owner, repo, number, since
+func (_m *GitHubClient) GetPullCommitsSince(ctx context.Context, owner string, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, number, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullCommitsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, number, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, number, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, number, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPullReviewsSince provides a mock function with given fields: ctx, owner, repo, number, since
+func (_m *GitHubClient) GetPullReviewsSince(ctx context.Context, owner string, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, number, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullReviewsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, number, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, number, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, number, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReleasesSince provides a mock function with given fields: ctx, owner, repo, since
+func (_m *GitHubClient) GetReleasesSince(ctx context.Context, owner string, repo string, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, owner, repo, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReleasesSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, owner, repo, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, owner, repo, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, owner, repo, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRepositoryDetails provides a mock function with given fields: ctx, owner, repo
 func (_m *GitHubClient) GetRepositoryDetails(ctx context.Context, owner string, repo string) (*models.ContentDetails, error) {
 	ret := _m.Called(ctx, owner, repo)
@@ -73,6 +401,66 @@ func (_m *GitHubClient) GetRepositoryLastUpdate(ctx context.Context, owner strin
 	return r0, r1
 }
 
+// GetSecurityAdvisories provides a mock function with given fields: ctx, owner, repo
+func (_m *GitHubClient) GetSecurityAdvisories(ctx context.Context, owner string, repo string) ([]*models.SecurityAdvisory, error) {
+	ret := _m.Called(ctx, owner, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityAdvisories")
+	}
+
+	var r0 []*models.SecurityAdvisory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*models.SecurityAdvisory, error)); ok {
+		return rf(ctx, owner, repo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*models.SecurityAdvisory); ok {
+		r0 = rf(ctx, owner, repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SecurityAdvisory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, owner, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowRuns provides a mock function with given fields: ctx, owner, repo, workflow
+func (_m *GitHubClient) GetWorkflowRuns(ctx context.Context, owner string, repo string, workflow string) ([]*models.WorkflowRun, error) {
+	ret := _m.Called(ctx, owner, repo, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRuns")
+	}
+
+	var r0 []*models.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*models.WorkflowRun, error)); ok {
+		return rf(ctx, owner, repo, workflow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []*models.WorkflowRun); ok {
+		r0 = rf(ctx, owner, repo, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, owner, repo, workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewGitHubClient creates a new instance of GitHubClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGitHubClient(t interface {
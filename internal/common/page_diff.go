@@ -0,0 +1,62 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	pageDiffMaxLines   = 10
+	pageDiffMaxLineLen = 120
+)
+
+// PageDiffPreview строит краткое текстовое описание изменений между двумя снимками страницы.
+// Строки сравниваются как множества: удалённые помечаются "-", добавленные "+".
+func PageDiffPreview(previous, current string) string {
+	oldLines := strings.Split(previous, "\n")
+	newLines := strings.Split(current, "\n")
+
+	oldSet := make(map[string]struct{}, len(oldLines))
+	for _, line := range oldLines {
+		oldSet[line] = struct{}{}
+	}
+
+	newSet := make(map[string]struct{}, len(newLines))
+	for _, line := range newLines {
+		newSet[line] = struct{}{}
+	}
+
+	var diff []string
+
+	for _, line := range oldLines {
+		if _, ok := newSet[line]; !ok && line != "" {
+			diff = append(diff, "- "+truncateLine(line))
+		}
+	}
+
+	for _, line := range newLines {
+		if _, ok := oldSet[line]; !ok && line != "" {
+			diff = append(diff, "+ "+truncateLine(line))
+		}
+	}
+
+	if len(diff) == 0 {
+		return "Изменился порядок или форматирование текста"
+	}
+
+	if len(diff) > pageDiffMaxLines {
+		hidden := len(diff) - pageDiffMaxLines
+		diff = append(diff[:pageDiffMaxLines], fmt.Sprintf("... и ещё %d изменённых строк", hidden))
+	}
+
+	return strings.Join(diff, "\n")
+}
+
+func truncateLine(line string) string {
+	runes := []rune(line)
+	if len(runes) <= pageDiffMaxLineLen {
+		return line
+	}
+
+	return string(runes[:pageDiffMaxLineLen]) + "..."
+}
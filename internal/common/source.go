@@ -0,0 +1,130 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// Source описывает источник отслеживаемых ссылок: как распознать и разобрать его ссылки,
+// чем проверять обновления и как показать их пользователю.
+type Source struct {
+	Type        models.LinkType
+	DisplayName string
+	// Match сообщает, относится ли ссылка к источнику. Источники проверяются в порядке регистрации.
+	Match func(url string) bool
+	// Parse проверяет, что из ссылки извлекается всё необходимое для отслеживания. Может быть nil.
+	Parse func(url string) error
+	// NewUpdater создаёт средство проверки обновлений из клиентов внешних API.
+	NewUpdater func(deps *UpdaterDependencies) LinkUpdater
+	// Describe возвращает заголовок уведомления об обновлении ссылки.
+	Describe func(link *models.Link) string
+	// Format дополняет заголовок уведомления подробностями события.
+	Format func(description string, info *models.UpdateInfo) string
+	// Urgent означает, что обновления источника доставляются сразу, минуя дайджест,
+	// независимо от режима уведомлений чата.
+	Urgent bool
+}
+
+// SourceRegistry хранит источники ссылок, известные боту и скрапперу.
+type SourceRegistry struct {
+	sources []*Source
+}
+
+func NewSourceRegistry() *SourceRegistry {
+	return &SourceRegistry{}
+}
+
+// Register добавляет источник в конец очереди проверки.
+// Источник с уже зарегистрированным типом заменяет прежний, сохраняя его место в очереди.
+func (r *SourceRegistry) Register(source *Source) {
+	for i, registered := range r.sources {
+		if registered.Type == source.Type {
+			r.sources[i] = source
+			return
+		}
+	}
+
+	r.sources = append(r.sources, source)
+}
+
+// Match возвращает первый источник, которому принадлежит ссылка.
+func (r *SourceRegistry) Match(url string) (*Source, bool) {
+	for _, source := range r.sources {
+		if source.Match(url) {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *SourceRegistry) Get(linkType models.LinkType) (*Source, bool) {
+	for _, source := range r.sources {
+		if source.Type == linkType {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *SourceRegistry) Sources() []*Source {
+	return r.sources
+}
+
+// IsUrgent сообщает, что обновления ссылок этого типа нужно доставлять сразу, минуя дайджест.
+func (r *SourceRegistry) IsUrgent(linkType models.LinkType) bool {
+	source, ok := r.Get(linkType)
+	return ok && source.Urgent
+}
+
+// DisplayNames возвращает названия источников в порядке регистрации.
+func (r *SourceRegistry) DisplayNames() []string {
+	names := make([]string, 0, len(r.sources))
+	for _, source := range r.sources {
+		names = append(names, source.DisplayName)
+	}
+
+	return names
+}
+
+// FormatLinkUpdate формирует текст уведомления об обновлении ссылки с учётом её источника.
+// Для ссылок неизвестного типа используется общий шаблон.
+func (r *SourceRegistry) FormatLinkUpdate(link *models.Link, info *models.UpdateInfo) string {
+	describe := describeAs("Обнаружено обновление ссылки")
+	format := formatUpdate("🔹 Обновление ресурса 🔹", "Заголовок", "Автор")
+
+	if source, ok := r.Get(link.Type); ok {
+		describe, format = source.Describe, source.Format
+	}
+
+	description := describe(link)
+	if info == nil {
+		return description
+	}
+
+	return format(description, info)
+}
+
+func describeAs(description string) func(*models.Link) string {
+	return func(*models.Link) string {
+		return description
+	}
+}
+
+func formatUpdate(header, titleLabel, authorLabel string) func(string, *models.UpdateInfo) string {
+	return func(description string, info *models.UpdateInfo) string {
+		return fmt.Sprintf("%s\n\n%s\n"+
+			"📎 %s: %s\n"+
+			"👤 %s: %s\n"+
+			"⏱️ Время: %s\n"+
+			"📄 Тип: %s\n"+
+			"📝 Превью:\n%s",
+			description, header,
+			titleLabel, info.Title,
+			authorLabel, info.Author,
+			info.UpdatedAt.Format("2006-01-02 15:04:05"),
+			info.ContentType, info.TextPreview)
+	}
+}
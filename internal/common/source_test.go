@@ -0,0 +1,70 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceRegistry_Register(t *testing.T) {
+	registry := common.NewDefaultSourceRegistry()
+
+	registry.Register(&common.Source{
+		Type:        models.GitHub,
+		DisplayName: "GitHub Enterprise",
+		Match: func(url string) bool {
+			return strings.HasPrefix(url, "https://github.example.com/")
+		},
+	})
+
+	source, ok := registry.Match("https://github.example.com/team/repo")
+	require.True(t, ok)
+	assert.Equal(t, models.GitHub, source.Type)
+
+	// Заменённый источник сохраняет своё место в очереди, поэтому веб-страница по-прежнему проверяется последней.
+	names := registry.DisplayNames()
+	assert.Contains(t, names, "GitHub Enterprise")
+	assert.Equal(t, "веб-страница", names[len(names)-1])
+}
+
+func TestSourceRegistry_FormatLinkUpdate(t *testing.T) {
+	registry := common.NewDefaultSourceRegistry()
+
+	info := &models.UpdateInfo{
+		Title:       "How to lock?",
+		Author:      "alice",
+		UpdatedAt:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		ContentType: "answer",
+		TextPreview: "Use a mutex",
+	}
+
+	link := &models.Link{Type: models.StackOverflow, Site: "superuser"}
+
+	text := registry.FormatLinkUpdate(link, info)
+	assert.True(t, strings.HasPrefix(text, "Обнаружено обновление вопроса StackExchange (superuser)"))
+	assert.Contains(t, text, "📎 Тема вопроса: How to lock?")
+	assert.Contains(t, text, "👤 Пользователь: alice")
+
+	assert.Equal(t, "Обнаружено обновление ссылки",
+		registry.FormatLinkUpdate(&models.Link{Type: models.Unknown}, nil))
+}
+
+func TestLinkAnalyzer_ParseLink(t *testing.T) {
+	analyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+
+	linkType, err := analyzer.ParseLink("https://gitlab.com/group/project")
+	require.NoError(t, err)
+	assert.Equal(t, models.GitLab, linkType)
+
+	_, err = analyzer.ParseLink("https://example.com/#css=div[")
+	assert.ErrorAs(t, err, new(*errors.ErrInvalidSelector))
+
+	_, err = analyzer.ParseLink("ftp://example.com")
+	assert.ErrorAs(t, err, new(*errors.ErrUnsupportedLinkType))
+}
@@ -0,0 +1,250 @@
+package common
+
+import (
+	"fmt"
+	neturl "net/url"
+	"regexp"
+	"strings"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// Шаблоны ссылок, общие для распознавания источников и функций Parse*URL.
+var (
+	githubRegex         = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)(?:/.*)?$`)
+	githubReleaseRegex  = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)/releases/?$`)
+	githubCommitsRegex  = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)/(?:tree|blob)/([^/]+)(?:/(.*))?$`)
+	githubIssueRegex    = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)/(?:issues|pull)/(\d+)(?:[/#?].*)?$`)
+	githubAdvisoryRegex = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)/security(?:/advisories)?/?$`)
+	githubWorkflowRegex = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([^/]+)/([^/]+)/actions/workflows/([^/?#]+)/?(?:[?#].*)?$`)
+	feedRegex           = regexp.MustCompile(`(?i)^https?://[^/\s]+(?:/\S*)?(?:\.(?:rss|atom|xml)|/(?:feed|rss|atom)(?:\.xml)?)/?(?:\?\S*)?$`)
+	webPageRegex        = regexp.MustCompile(`^https?://[^/\s#?]+(?:[/#?]\S*)?$`)
+
+	// sourceHostRegex перечисляет хосты, ссылки на которые обслуживают другие источники. Опечатка в такой
+	// ссылке (например, github.com/owner) не должна превращаться в отслеживание веб-страницы.
+	sourceHostRegex = regexp.MustCompile(`(?i)^(?:www\.)?(?:github\.com|gitlab\.com|pkg\.go\.dev|npmjs\.com|` +
+		`pypi\.org|crates\.io|` + stackExchangeHosts + `)$`)
+)
+
+// UpdaterDependencies содержит клиенты внешних API и хранилища, из которых источники собирают свои LinkUpdater.
+// Боту, которому нужны только распознавание и форматирование ссылок, зависимости не требуются.
+// GitHubBatch задают только при пакетной проверке репозиториев GitHub через GraphQL API.
+type UpdaterDependencies struct {
+	GitHub        GitHubClient
+	GitHubBatch   GitHubBatchClient
+	StackOverflow StackOverflowClient
+	GitLab        map[string]GitLabClient
+	Feed          FeedClient
+	FeedStore     FeedEntryStore
+	WorkflowRuns  WorkflowRunStore
+	Advisories    AdvisoryStore
+	WebPage       WebPageClient
+	PageSnapshots PageSnapshotStore
+	Packages      map[models.PackageRegistry]PackageRegistryClient
+}
+
+// NewDefaultSourceRegistry создаёт реестр со всеми встроенными источниками.
+func NewDefaultSourceRegistry(gitLabInstances ...string) *SourceRegistry {
+	registry := NewSourceRegistry()
+
+	for _, source := range DefaultSources(gitLabInstances...) {
+		registry.Register(source)
+	}
+
+	return registry
+}
+
+// DefaultSources возвращает встроенные источники в порядке проверки: от более специфичных ссылок к общим.
+// Ссылки на gitlab.com распознаются всегда, gitLabInstances дополнительно перечисляет адреса self-hosted
+// инсталляций в виде хост[/путь].
+//
+//nolint:funlen // Перечень источников удобнее читать одним списком
+func DefaultSources(gitLabInstances ...string) []*Source {
+	githubFormat := formatUpdate("🔷 GitHub обновление 🔷", "Название", "Автор")
+	resourceFormat := formatUpdate("🔹 Обновление ресурса 🔹", "Заголовок", "Автор")
+
+	return []*Source{
+		{
+			Type:        models.GitHubRelease,
+			DisplayName: "релизы GitHub",
+			Match:       githubReleaseRegex.MatchString,
+			Parse:       parseWith(ParseGitHubURL),
+			NewUpdater:  func(deps *UpdaterDependencies) LinkUpdater { return NewGitHubReleaseUpdater(deps.GitHub) },
+			Describe:    describeAs("Опубликован новый релиз GitHub"),
+			Format:      githubFormat,
+		},
+		{
+			Type:        models.GitHubCommits,
+			DisplayName: "ветка или файл GitHub",
+			Match:       githubCommitsRegex.MatchString,
+			Parse: func(url string) error {
+				_, _, _, _, err := ParseGitHubRefURL(url)
+				return err
+			},
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater { return NewGitHubCommitsUpdater(deps.GitHub) },
+			Describe:   describeAs("Обнаружены новые коммиты GitHub"),
+			Format:     githubFormat,
+		},
+		{
+			Type:        models.GitHubIssue,
+			DisplayName: "issue или pull request GitHub",
+			Match:       githubIssueRegex.MatchString,
+			Parse: func(url string) error {
+				_, _, _, err := ParseGitHubIssueURL(url)
+				return err
+			},
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater { return NewGitHubIssueUpdater(deps.GitHub) },
+			Describe:   describeAs("Обнаружено обновление GitHub issue или pull request"),
+			Format:     githubFormat,
+		},
+		{
+			Type:        models.GitHubWorkflow,
+			DisplayName: "workflow GitHub Actions",
+			Match:       githubWorkflowRegex.MatchString,
+			Parse: func(url string) error {
+				_, _, _, err := ParseGitHubWorkflowURL(url)
+				return err
+			},
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater {
+				return NewGitHubWorkflowUpdater(deps.GitHub, deps.WorkflowRuns)
+			},
+			Describe: describeAs("Изменился статус запусков GitHub Actions"),
+			Format:   formatUpdate("⚙️ GitHub Actions ⚙️", "Запуск", "Инициатор"),
+		},
+		{
+			Type:        models.GitHubAdvisory,
+			DisplayName: "бюллетени безопасности GitHub",
+			Match:       githubAdvisoryRegex.MatchString,
+			Parse:       parseWith(ParseGitHubURL),
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater {
+				return NewGitHubAdvisoryUpdater(deps.GitHub, deps.Advisories)
+			},
+			Describe: describeAs("Опубликован бюллетень безопасности GitHub"),
+			Format:   formatUpdate("🛡️ Бюллетень безопасности 🛡️", "Бюллетень", "Опубликовал"),
+			Urgent:   true,
+		},
+		{
+			Type:        models.GitHub,
+			DisplayName: "репозиторий GitHub",
+			Match:       githubRegex.MatchString,
+			Parse:       parseWith(ParseGitHubURL),
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater {
+				if deps.GitHubBatch != nil {
+					return NewGitHubBatchUpdater(deps.GitHub, deps.GitHubBatch)
+				}
+
+				return NewGitHubUpdater(deps.GitHub)
+			},
+			Describe: describeAs("Обнаружено обновление GitHub репозитория"),
+			Format:   githubFormat,
+		},
+		{
+			Type:        models.StackOverflow,
+			DisplayName: "вопрос StackExchange",
+			Match:       stackExchangeRegex.MatchString,
+			Parse: func(url string) error {
+				_, _, err := ParseStackOverflowURL(url)
+				return err
+			},
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater { return NewStackOverflowUpdater(deps.StackOverflow) },
+			Describe: func(link *models.Link) string {
+				if link.Site != "" && link.Site != "stackoverflow" {
+					return fmt.Sprintf("Обнаружено обновление вопроса StackExchange (%s)", link.Site)
+				}
+
+				return "Обнаружено обновление вопроса StackOverflow"
+			},
+			Format: formatUpdate("🔶 StackOverflow обновление 🔶", "Тема вопроса", "Пользователь"),
+		},
+		{
+			Type:        models.Feed,
+			DisplayName: "RSS/Atom-лента",
+			Match:       feedRegex.MatchString,
+			NewUpdater:  func(deps *UpdaterDependencies) LinkUpdater { return NewFeedUpdater(deps.Feed, deps.FeedStore) },
+			Describe:    describeAs("В ленте появилась новая запись"),
+			Format:      resourceFormat,
+		},
+		{
+			Type:        models.GitLab,
+			DisplayName: "проект GitLab",
+			Match:       gitLabMatcher(gitLabInstances),
+			Parse:       gitLabParser(gitLabInstances),
+			NewUpdater:  func(deps *UpdaterDependencies) LinkUpdater { return NewGitLabUpdater(deps.GitLab) },
+			Describe:    describeAs("Обнаружено обновление проекта GitLab"),
+			Format:      formatUpdate("🦊 GitLab обновление 🦊", "Название", "Автор"),
+		},
+		{
+			Type:        models.Package,
+			DisplayName: "пакет Go, npm, PyPI или crates.io",
+			Match: func(url string) bool {
+				_, _, err := ParsePackageURL(url)
+				return err == nil
+			},
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater { return NewPackageUpdater(deps.Packages) },
+			Describe:   describeAs("Опубликована новая версия пакета"),
+			Format:     formatUpdate("📦 Новая версия пакета 📦", "Пакет", "Реестр"),
+		},
+		{
+			// Любая другая http(s) ссылка отслеживается как веб-страница, часть страницы задаётся фрагментом #css=<селектор>.
+			Type:        models.WebPage,
+			DisplayName: "веб-страница",
+			Match:       webPageMatcher(gitLabInstances),
+			Parse:       parseWith(ParseWebPageURL),
+			NewUpdater: func(deps *UpdaterDependencies) LinkUpdater {
+				return NewWebPageUpdater(deps.WebPage, deps.PageSnapshots)
+			},
+			Describe: describeAs("Содержимое страницы изменилось"),
+			Format:   resourceFormat,
+		},
+	}
+}
+
+func gitLabMatcher(gitLabInstances []string) func(url string) bool {
+	instances := map[string]bool{gitLabHost: true}
+	for _, instance := range gitLabInstances {
+		instances[instance] = true
+	}
+
+	return func(url string) bool {
+		instance, _, err := ParseGitLabURL(url, gitLabInstances...)
+		return err == nil && instances[instance]
+	}
+}
+
+func webPageMatcher(gitLabInstances []string) func(url string) bool {
+	return func(url string) bool {
+		if !webPageRegex.MatchString(url) {
+			return false
+		}
+
+		u, err := neturl.Parse(url)
+		if err != nil || sourceHostRegex.MatchString(u.Hostname()) {
+			return false
+		}
+
+		path := strings.Trim(u.Path, "/")
+
+		for _, instance := range gitLabInstances {
+			host, prefix, _ := strings.Cut(instance, "/")
+			if strings.EqualFold(host, u.Host) && (prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func gitLabParser(gitLabInstances []string) func(url string) error {
+	return func(url string) error {
+		_, _, err := ParseGitLabURL(url, gitLabInstances...)
+		return err
+	}
+}
+
+func parseWith(parse func(url string) (string, string, error)) func(url string) error {
+	return func(url string) error {
+		_, _, err := parse(url)
+		return err
+	}
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -13,6 +16,14 @@ const (
 	SquirrelAccess AccessType = "SQUIRREL" // Вместо ORM
 )
 
+// GitHubCheckStrategy определяет, как скраппер проверяет репозитории GitHub.
+type GitHubCheckStrategy string
+
+const (
+	GitHubRESTStrategy    GitHubCheckStrategy = "REST"    // Отдельный запрос на каждую ссылку
+	GitHubGraphQLStrategy GitHubCheckStrategy = "GRAPHQL" // Один запрос GraphQL на батч ссылок
+)
+
 type Config struct {
 	TelegramBotToken       string        `mapstructure:"TELEGRAM_BOT_TOKEN"`
 	BotServerPort          int           `mapstructure:"BOT_SERVER_PORT"`
@@ -22,14 +33,18 @@ type Config struct {
 	SchedulerCheckInterval time.Duration `mapstructure:"SCHEDULER_CHECK_INTERVAL"`
 	GitHubAPIToken         string        `mapstructure:"GITHUB_API_TOKEN"`
 	StackOverflowAPIToken  string        `mapstructure:"STACKOVERFLOW_API_TOKEN"`
+	GitLabAPIToken         string        `mapstructure:"GITLAB_API_TOKEN"`
+	GitLabHosts            string        `mapstructure:"GITLAB_HOSTS"`
 
 	DatabaseURL        string     `mapstructure:"DATABASE_URL"`
 	DatabaseAccessType AccessType `mapstructure:"DATABASE_ACCESS_TYPE"`
 	DatabaseBatchSize  int        `mapstructure:"DATABASE_BATCH_SIZE"`
 	DatabaseMaxConn    int        `mapstructure:"DATABASE_MAX_CONNECTIONS"`
 
-	UseParallelScheduler bool `mapstructure:"USE_PARALLEL_SCHEDULER"`
-	SchedulerWorkers     int  `mapstructure:"SCHEDULER_WORKERS"`
+	UseParallelScheduler   bool                `mapstructure:"USE_PARALLEL_SCHEDULER"`
+	SchedulerWorkers       int                 `mapstructure:"SCHEDULER_WORKERS"`
+	GitHubRateLimitReserve int                 `mapstructure:"GITHUB_RATE_LIMIT_RESERVE"`
+	GitHubCheckStrategy    GitHubCheckStrategy `mapstructure:"GITHUB_CHECK_STRATEGY"`
 
 	KafkaBrokers         string `mapstructure:"KAFKA_BROKERS"`
 	MessageTransport     string `mapstructure:"MESSAGE_TRANSPORT"`
@@ -47,6 +62,8 @@ type Config struct {
 
 	HTTPRequestTimeout     time.Duration `mapstructure:"HTTP_REQUEST_TIMEOUT"`
 	ExternalRequestTimeout time.Duration `mapstructure:"EXTERNAL_REQUEST_TIMEOUT"`
+	// AllowPrivateNetworks разрешает загружать страницы и ленты с внутренних адресов.
+	AllowPrivateNetworks bool `mapstructure:"ALLOW_PRIVATE_NETWORKS"`
 
 	RateLimitRequests int           `mapstructure:"RATE_LIMIT_REQUESTS"`
 	RateLimitWindow   time.Duration `mapstructure:"RATE_LIMIT_WINDOW"`
@@ -65,6 +82,60 @@ type Config struct {
 	FallbackTransport string `mapstructure:"FALLBACK_TRANSPORT"`
 }
 
+// GitLabInstance описывает инсталляцию GitLab, ссылки на которую умеет отслеживать скраппер.
+// Address — хост инсталляции и путь, по которому она размещена, без схемы: gitlab.example.com или example.com/gitlab.
+type GitLabInstance struct {
+	Address string
+	BaseURL string
+	Token   string
+}
+
+const defaultGitLabHost = "gitlab.com"
+
+// GitLabInstances возвращает gitlab.com и self-hosted инсталляции из GITLAB_HOSTS,
+// перечисленные через запятую в формате <base URL>[=<токен>].
+// Некорректные записи пропускаются.
+func (c *Config) GitLabInstances() []GitLabInstance {
+	instances := []GitLabInstance{{
+		Address: defaultGitLabHost,
+		BaseURL: "https://" + defaultGitLabHost + "/api/v4",
+		Token:   c.GitLabAPIToken,
+	}}
+
+	for _, entry := range strings.Split(c.GitLabHosts, ",") {
+		rawURL, token, _ := strings.Cut(strings.TrimSpace(entry), "=")
+		if rawURL == "" {
+			continue
+		}
+
+		if !strings.Contains(rawURL, "://") {
+			rawURL = "https://" + rawURL
+		}
+
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Host == "" {
+			continue
+		}
+
+		address := strings.ToLower(u.Host)
+		if address == defaultGitLabHost {
+			continue
+		}
+
+		if path := strings.Trim(u.Path, "/"); path != "" {
+			address += "/" + path
+		}
+
+		instances = append(instances, GitLabInstance{
+			Address: address,
+			BaseURL: fmt.Sprintf("%s://%s%s/api/v4", u.Scheme, u.Host, strings.TrimRight(u.Path, "/")),
+			Token:   strings.TrimSpace(token),
+		})
+	}
+
+	return instances
+}
+
 func LoadConfig() *Config {
 	setDefaults()
 
@@ -99,6 +170,8 @@ func setDefaults() {
 
 	viper.SetDefault("USE_PARALLEL_SCHEDULER", true)
 	viper.SetDefault("SCHEDULER_WORKERS", 4)
+	viper.SetDefault("GITHUB_RATE_LIMIT_RESERVE", 50)
+	viper.SetDefault("GITHUB_CHECK_STRATEGY", string(GitHubRESTStrategy))
 
 	viper.SetDefault("KAFKA_BROKERS", "kafka:9092")
 	viper.SetDefault("MESSAGE_TRANSPORT", "HTTP")
@@ -147,6 +220,8 @@ func getDefaultConfig() *Config {
 		DatabaseMaxConn:        10,
 		UseParallelScheduler:   true,
 		SchedulerWorkers:       4,
+		GitHubRateLimitReserve: 50,
+		GitHubCheckStrategy:    GitHubRESTStrategy,
 
 		KafkaBrokers:         "kafka:9092",
 		MessageTransport:     "HTTP",
@@ -164,6 +239,7 @@ func getDefaultConfig() *Config {
 
 		HTTPRequestTimeout:     5 * time.Second,
 		ExternalRequestTimeout: 10 * time.Second,
+		AllowPrivateNetworks:   false,
 
 		RateLimitRequests: 100,
 		RateLimitWindow:   1 * time.Minute,
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 )
 
 type ErrLinkAlreadyExists struct {
@@ -54,6 +55,29 @@ func (e *ErrInvalidURL) Error() string {
 	return "неверный формат URL: " + e.URL
 }
 
+type ErrInvalidSelector struct {
+	Selector string
+}
+
+func (e *ErrInvalidSelector) Error() string {
+	return "некорректный CSS-селектор: " + e.Selector
+}
+
+// ErrInvalidFilter возникает, когда фильтр обновлений не удаётся разобрать. Reason объясняет, что именно не так.
+type ErrInvalidFilter struct {
+	Filter string
+	Reason string
+}
+
+func (e *ErrInvalidFilter) Error() string {
+	return fmt.Sprintf("некорректный фильтр '%s': %s", e.Filter, e.Reason)
+}
+
+func (e *ErrInvalidFilter) Is(target error) bool {
+	_, ok := target.(*ErrInvalidFilter)
+	return ok
+}
+
 type ErrUnknownCommand struct {
 	Command string
 }
@@ -268,6 +292,118 @@ func (e *ErrMissingURLInUpdate) Is(target error) bool {
 	return ok
 }
 
+// ErrNotModified возникает, когда API ответил 304 Not Modified на условный запрос:
+// ресурс не изменился с прошлой проверки.
+type ErrNotModified struct {
+	URL string
+}
+
+func (e *ErrNotModified) Error() string {
+	return "ресурс не изменился: " + e.URL
+}
+
+func (e *ErrNotModified) Is(target error) bool {
+	_, ok := target.(*ErrNotModified)
+	return ok
+}
+
+// ErrRateLimited возникает, когда квота внешнего API исчерпана. Запросы можно возобновить в ResetAt.
+type ErrRateLimited struct {
+	Service string
+	ResetAt time.Time
+}
+
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("квота API %s исчерпана до %s", e.Service, e.ResetAt.Format(time.RFC3339))
+}
+
+func (e *ErrRateLimited) Is(target error) bool {
+	_, ok := target.(*ErrRateLimited)
+	return ok
+}
+
+// ErrResourceMoved возникает, когда ресурс ссылки переехал: репозиторий переименован или передан другому владельцу.
+// URL — прежний адрес ресурса, NewURL — новый.
+type ErrResourceMoved struct {
+	URL    string
+	NewURL string
+}
+
+func (e *ErrResourceMoved) Error() string {
+	return fmt.Sprintf("ресурс переехал: %s -> %s", e.URL, e.NewURL)
+}
+
+func (e *ErrResourceMoved) Is(target error) bool {
+	_, ok := target.(*ErrResourceMoved)
+	return ok
+}
+
+// ErrResourceGone возникает, когда ресурс ссылки удалён или стал недоступен: API ответил 404 или 410.
+type ErrResourceGone struct {
+	URL string
+}
+
+func (e *ErrResourceGone) Error() string {
+	return "ресурс удалён: " + e.URL
+}
+
+func (e *ErrResourceGone) Is(target error) bool {
+	_, ok := target.(*ErrResourceGone)
+	return ok
+}
+
+// ErrForbiddenAddress возникает при попытке подключиться к внутреннему адресу: loopback, частной сети,
+// link-local, в том числе сервису метаданных облака.
+type ErrForbiddenAddress struct {
+	Address string
+}
+
+func (e *ErrForbiddenAddress) Error() string {
+	return "подключение к внутреннему адресу запрещено: " + e.Address
+}
+
+func (e *ErrForbiddenAddress) Is(target error) bool {
+	_, ok := target.(*ErrForbiddenAddress)
+	return ok
+}
+
+// ErrResponseTooLarge возникает, когда тело ответа превышает допустимый размер.
+type ErrResponseTooLarge struct {
+	URL   string
+	Limit int64
+}
+
+func (e *ErrResponseTooLarge) Error() string {
+	return fmt.Sprintf("ответ %s превышает %d байт", e.URL, e.Limit)
+}
+
+func (e *ErrResponseTooLarge) Is(target error) bool {
+	_, ok := target.(*ErrResponseTooLarge)
+	return ok
+}
+
+// ErrStackExchangeAPI описывает ошибку из полей error_id, error_name и error_message ответа StackExchange API.
+type ErrStackExchangeAPI struct {
+	ID      int
+	Name    string
+	Message string
+}
+
+func (e *ErrStackExchangeAPI) Error() string {
+	return fmt.Sprintf("StackExchange API вернул ошибку %d (%s): %s", e.ID, e.Name, e.Message)
+}
+
+// Temporary сообщает, что запрос можно повторить: internal_error (500) и temporarily_unavailable (503).
+// Остальные ошибки (неверные параметры, ключ, доступ) при повторе не исчезнут.
+func (e *ErrStackExchangeAPI) Temporary() bool {
+	return e.ID == 500 || e.ID == 503
+}
+
+func (e *ErrStackExchangeAPI) Is(target error) bool {
+	_, ok := target.(*ErrStackExchangeAPI)
+	return ok
+}
+
 type HTTPError struct {
 	StatusCode int
 }
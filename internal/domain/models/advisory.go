@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// SecurityAdvisory описывает опубликованный бюллетень безопасности репозитория GitHub.
+type SecurityAdvisory struct {
+	GHSAID          string
+	CVEID           string
+	URL             string
+	Summary         string
+	Description     string
+	Severity        string
+	Publisher       string
+	Vulnerabilities []AdvisoryVulnerability
+	PublishedAt     time.Time
+	UpdatedAt       time.Time
+}
+
+// AdvisoryVulnerability описывает затронутый бюллетенем пакет: уязвимые версии и версии с исправлением.
+type AdvisoryVulnerability struct {
+	Ecosystem       string
+	Package         string
+	VulnerableRange string
+	PatchedVersions string
+}
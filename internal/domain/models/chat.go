@@ -17,8 +17,38 @@ type Chat struct {
 	Links            []int64
 	NotificationMode NotificationMode
 	DigestTime       time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	// QuietHours равно nil, если часы тишины не заданы.
+	QuietHours *QuietHours
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// QuietHours — ежедневный интервал, в который чат не получает мгновенных уведомлений. Start и End задают
+// время суток в часовом поясе Timezone (имя из базы IANA, например Europe/Moscow). Интервал может переходить
+// через полночь, например 23:00–08:00; при совпадающих Start и End он пуст.
+type QuietHours struct {
+	Start    time.Time
+	End      time.Time
+	Timezone string
+}
+
+// Active сообщает, приходится ли момент now на часы тишины. Неизвестный часовой пояс считается UTC.
+func (q *QuietHours) Active(now time.Time) bool {
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	start := q.Start.Hour()*60 + q.Start.Minute()
+	end := q.End.Hour()*60 + q.End.Minute()
+
+	if start <= end {
+		return start <= minute && minute < end
+	}
+
+	return minute >= start || minute < end
 }
 
 type ChatState int
@@ -31,4 +61,31 @@ const (
 	StateAwaitingUntrackLink
 	StateAwaitingNotificationMode
 	StateAwaitingDigestTime
+	StateAwaitingSelector
+	StateAwaitingKeywords
+	StateAwaitingQuietHours
+	StateAwaitingLinkMode
 )
+
+// Subscription описывает подписку чата на ссылку. Теги, фильтры, ключевые слова и режим уведомлений у каждого чата
+// свои, даже если ссылку отслеживают несколько чатов. Пустой Mode означает режим уведомлений, выбранный для чата.
+// Если заданы Keywords, чат получает только обновления, в тексте которых встречается хотя бы одно из них.
+// Пока подписка заглушена до MutedUntil, уведомления не приходят, а MutedUpdates считает пропущенные обновления;
+// MuteSummary означает, что по окончании заглушки чат получит их сводку.
+type Subscription struct {
+	ChatID       int64
+	LinkID       int64
+	Tags         []string
+	Filters      []string
+	Keywords     []string
+	Mode         NotificationMode
+	MutedUntil   *time.Time
+	MuteSummary  bool
+	MutedUpdates int
+	CreatedAt    time.Time
+}
+
+// Muted сообщает, заглушена ли подписка в момент now.
+func (s *Subscription) Muted(now time.Time) bool {
+	return s.MutedUntil != nil && now.Before(*s.MutedUntil)
+}
@@ -10,6 +10,9 @@ const (
 	CommandList    CommandType = "/list"
 	CommandMode    CommandType = "/mode"
 	CommandTime    CommandType = "/time"
+	CommandQuiet   CommandType = "/quiet"
+	CommandMute    CommandType = "/mute"
+	CommandUnmute  CommandType = "/unmute"
 	CommandUnknown CommandType = "unknown"
 )
 
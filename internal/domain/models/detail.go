@@ -1,6 +1,15 @@
 package models
 
-import "time"
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// Длина колонок title и author таблицы content_details.
+const (
+	MaxDetailsTitleLength  = 500
+	MaxDetailsAuthorLength = 100
+)
 
 type ContentDetails struct {
 	LinkID      int64
@@ -11,6 +20,22 @@ type ContentDetails struct {
 	LinkType    LinkType
 }
 
+// TruncateFields обрезает заголовок и автора до длины колонок content_details:
+// заголовки записей лент и веб-страниц бывают длиннее, и без этого сохранение деталей не удалось бы.
+func (d *ContentDetails) TruncateFields() {
+	d.Title = truncateRunes(d.Title, MaxDetailsTitleLength)
+	d.Author = truncateRunes(d.Author, MaxDetailsAuthorLength)
+}
+
+// truncateRunes оставляет первые limit символов text. Колонки VARCHAR ограничивают длину в символах, а не в байтах.
+func truncateRunes(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	return string([]rune(text)[:limit])
+}
+
 func TextPreview(text string, length int) string {
 	if len(text) <= length {
 		return text
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// FeedEntry описывает запись RSS или Atom ленты.
+type FeedEntry struct {
+	GUID        string
+	Title       string
+	Author      string
+	Summary     string
+	Link        string
+	PublishedAt time.Time
+}
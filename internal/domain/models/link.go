@@ -7,17 +7,37 @@ import (
 type LinkType string
 
 const (
-	GitHub        LinkType = "github"
-	StackOverflow LinkType = "stackoverflow"
-	Unknown       LinkType = "unknown"
+	GitHub         LinkType = "github"
+	GitHubRelease  LinkType = "github_release"
+	GitHubCommits  LinkType = "github_commits"
+	GitHubIssue    LinkType = "github_issue"
+	GitHubWorkflow LinkType = "github_workflow"
+	GitHubAdvisory LinkType = "github_advisory"
+	StackOverflow  LinkType = "stackoverflow"
+	GitLab         LinkType = "gitlab"
+	Feed           LinkType = "feed"
+	Package        LinkType = "package"
+	WebPage        LinkType = "webpage"
+	Unknown        LinkType = "unknown"
 )
 
+// Link описывает отслеживаемый ресурс. Active ложно, если ресурс удалён: такая ссылка больше не проверяется.
+// Tags и Filters берутся из подписки чата и заполняются только в списке ссылок конкретного чата.
 type Link struct {
-	ID          int64
-	URL         string
-	Type        LinkType
-	Tags        []string
-	Filters     []string
+	ID       int64
+	URL      string
+	Type     LinkType
+	Site     string
+	Tags     []string
+	Filters  []string
+	Keywords []string
+	// MutedUntil задан, если чат заглушил ссылку: до этого момента уведомления о ней не приходят.
+	MutedUntil   *time.Time
+	ETag         string
+	LastModified string
+	Active       bool
+	// GoneChecks — сколько проверок подряд API отвечал, что ресурс удалён.
+	GoneChecks  int
 	LastChecked time.Time
 	LastUpdated time.Time
 	CreatedAt   time.Time
@@ -30,6 +50,7 @@ type UpdateInfo struct {
 	ContentType string
 	TextPreview string
 	FullText    string
+	Labels      []string
 }
 
 type LinkUpdate struct {
@@ -38,4 +59,14 @@ type LinkUpdate struct {
 	Description string
 	TgChatIDs   []int64
 	UpdateInfo  *UpdateInfo
+	// Keywords — ключевые слова подписок получателей, найденные в тексте обновления. Они выделяются в уведомлении.
+	Keywords []string
+}
+
+// HeldUpdate — мгновенное уведомление чата, отложенное до конца его часов тишины.
+type HeldUpdate struct {
+	ID        int64
+	ChatID    int64
+	Update    *LinkUpdate
+	CreatedAt time.Time
 }
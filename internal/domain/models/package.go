@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// PackageRegistry обозначает реестр пакетов, версии которых можно отслеживать.
+type PackageRegistry string
+
+const (
+	GoModules PackageRegistry = "go"
+	NPM       PackageRegistry = "npm"
+	PyPI      PackageRegistry = "pypi"
+	Crates    PackageRegistry = "crates"
+)
+
+// PackageVersion описывает опубликованную версию пакета. PublishedAt может быть нулевым,
+// если реестр не сообщает время публикации этой версии.
+type PackageVersion struct {
+	Version     string
+	PublishedAt time.Time
+}
+
+// DisplayName возвращает название реестра для уведомлений.
+func (r PackageRegistry) DisplayName() string {
+	switch r {
+	case GoModules:
+		return "Go modules"
+	case NPM:
+		return "npm"
+	case PyPI:
+		return "PyPI"
+	case Crates:
+		return "crates.io"
+	default:
+		return string(r)
+	}
+}
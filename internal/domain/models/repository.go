@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// RepositoryRef идентифицирует репозиторий GitHub.
+type RepositoryRef struct {
+	Owner string
+	Name  string
+}
+
+// RepositorySnapshot описывает состояние репозитория GitHub, полученное пакетным запросом GraphQL API.
+type RepositorySnapshot struct {
+	PushedAt         time.Time
+	UpdatedAt        time.Time
+	IssuesUpdatedAt  time.Time
+	LatestReleaseAt  time.Time
+	OpenIssues       int
+	OpenPullRequests int
+}
+
+// LastActivity возвращает время последнего изменения репозитория: push, правки его настроек,
+// изменения issue и pull request или публикации релиза. Время релиза приходит только из GraphQL API,
+// при проверке через REST API оно нулевое.
+func (s *RepositorySnapshot) LastActivity() time.Time {
+	lastActivity := s.UpdatedAt
+
+	for _, activity := range []time.Time{s.PushedAt, s.IssuesUpdatedAt, s.LatestReleaseAt} {
+		if activity.After(lastActivity) {
+			lastActivity = activity
+		}
+	}
+
+	return lastActivity
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// WorkflowRun описывает завершённый запуск workflow GitHub Actions.
+// Conclusion — итог запуска в терминах API: success, failure, cancelled, timed_out и т.д.
+type WorkflowRun struct {
+	ID            int64
+	Number        int64
+	Name          string
+	Conclusion    string
+	URL           string
+	HeadSHA       string
+	CommitMessage string
+	Actor         string
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,85 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+)
+
+// cratesUserAgent представляет клиент в crates.io: запросы без User-Agent API отклоняет.
+const cratesUserAgent = "telegram-link-tracker-bot"
+
+// CratesClient получает версии пакетов Rust из crates.io.
+type CratesClient struct {
+	client  *resty.Client
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewCratesClient создаёт клиент crates.io API. Пустой baseURL означает crates.io.
+func NewCratesClient(baseURL string, cfg *config.Config, logger *slog.Logger) PackageVersionGetter {
+	if baseURL == "" {
+		baseURL = "https://crates.io"
+	}
+
+	client := httputil.CreateResilientHTTPClient(cfg, logger, "crates")
+	client.SetHeader("User-Agent", cratesUserAgent)
+
+	return &CratesClient{
+		client:  client,
+		baseURL: baseURL,
+		logger:  logger,
+	}
+}
+
+// GetVersions возвращает неотозванные (не yanked) версии пакета по времени публикации.
+func (c *CratesClient) GetVersions(ctx context.Context, name string) ([]models.PackageVersion, error) {
+	versionsURL := fmt.Sprintf("%s/api/v1/crates/%s/versions", c.baseURL, url.PathEscape(name))
+
+	var response struct {
+		Versions []struct {
+			Num       string    `json:"num"`
+			CreatedAt time.Time `json:"created_at"`
+			Yanked    bool      `json:"yanked"`
+		} `json:"versions"`
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		Get(versionsURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: versionsURL}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("crates.io вернул статус: %d", resp.StatusCode())
+	}
+
+	versions := make([]models.PackageVersion, 0, len(response.Versions))
+
+	for _, version := range response.Versions {
+		if version.Yanked {
+			continue
+		}
+
+		versions = append(versions, models.PackageVersion{Version: version.Num, PublishedAt: version.CreatedAt})
+	}
+
+	sortByPublication(versions)
+
+	return versions, nil
+}
@@ -0,0 +1,264 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+)
+
+type FeedClient struct {
+	client *resty.Client
+	logger *slog.Logger
+}
+
+type FeedGetter interface {
+	GetFeed(ctx context.Context, url string) ([]*models.FeedEntry, error)
+}
+
+func NewFeedClient(cfg *config.Config, logger *slog.Logger) FeedGetter {
+	client := httputil.CreatePublicHTTPClient(cfg, logger, "feed")
+
+	return &FeedClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+type rssFeed struct {
+	Channel struct {
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+	Author      string `xml:"author"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Description string `xml:"description"`
+}
+
+type atomFeed struct {
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID    string `xml:"id"`
+	Title string `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+	Author    struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	Summary string `xml:"summary"`
+	Content string `xml:"content"`
+}
+
+var (
+	rssDateLayouts = []string{
+		time.RFC1123Z,
+		time.RFC1123,
+		"Mon, 2 Jan 2006 15:04:05 -0700",
+		"Mon, 2 Jan 2006 15:04:05 MST",
+		time.RFC822Z,
+		time.RFC822,
+		time.RFC3339,
+	}
+
+	htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+)
+
+// GetFeed загружает ленту и возвращает её записи в порядке следования в документе.
+// Если к контексту привязаны валидаторы ссылки, запрос условный, и неизменившаяся лента не скачивается и не разбирается.
+func (c *FeedClient) GetFeed(ctx context.Context, url string) ([]*models.FeedEntry, error) {
+	request := c.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, */*;q=0.8").
+		SetDoNotParseResponse(true)
+
+	httputil.SetConditionalHeaders(ctx, request)
+
+	resp, err := request.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := httputil.ReadLimitedBody(resp, httputil.MaxPublicResponseSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsNotModified(resp) {
+		return nil, &customerrors.ErrNotModified{URL: url}
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: url}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("лента вернула статус: %d", resp.StatusCode())
+	}
+
+	entries, err := parseFeed(body)
+	if err != nil {
+		return nil, err
+	}
+
+	httputil.StoreValidators(ctx, resp)
+
+	return entries, nil
+}
+
+func parseFeed(data []byte) ([]*models.FeedEntry, error) {
+	root, err := feedRootElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root {
+	case "rss":
+		var feed rssFeed
+		if err := newFeedDecoder(data).Decode(&feed); err != nil {
+			return nil, fmt.Errorf("ошибка разбора RSS ленты: %w", err)
+		}
+
+		entries := make([]*models.FeedEntry, 0, len(feed.Channel.Items))
+		for i := range feed.Channel.Items {
+			entries = append(entries, rssItemToEntry(&feed.Channel.Items[i]))
+		}
+
+		return entries, nil
+	case "feed":
+		var feed atomFeed
+		if err := newFeedDecoder(data).Decode(&feed); err != nil {
+			return nil, fmt.Errorf("ошибка разбора Atom ленты: %w", err)
+		}
+
+		entries := make([]*models.FeedEntry, 0, len(feed.Entries))
+		for i := range feed.Entries {
+			entries = append(entries, atomEntryToEntry(&feed.Entries[i]))
+		}
+
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("неподдерживаемый формат ленты: %s", root)
+	}
+}
+
+// newFeedDecoder не отклоняет ленты с объявленной кодировкой, отличной от UTF-8:
+// содержимое разбирается как есть.
+func newFeedDecoder(data []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	return decoder
+}
+
+func feedRootElement(data []byte) (string, error) {
+	decoder := newFeedDecoder(data)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("ошибка разбора ленты: %w", err)
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+func rssItemToEntry(item *rssItem) *models.FeedEntry {
+	author := item.Creator
+	if author == "" {
+		author = item.Author
+	}
+
+	guid := firstNonEmpty(item.GUID, item.Link, item.Title)
+
+	return &models.FeedEntry{
+		GUID:        strings.TrimSpace(guid),
+		Title:       strings.TrimSpace(item.Title),
+		Author:      strings.TrimSpace(author),
+		Summary:     stripHTML(item.Description),
+		Link:        strings.TrimSpace(item.Link),
+		PublishedAt: parseFeedDate(item.PubDate, rssDateLayouts),
+	}
+}
+
+func atomEntryToEntry(entry *atomEntry) *models.FeedEntry {
+	var link string
+
+	for _, l := range entry.Links {
+		if l.Rel == "" || l.Rel == "alternate" {
+			link = l.Href
+			break
+		}
+	}
+
+	// published не меняется при редактировании записи, поэтому он предпочтительнее updated.
+	date := firstNonEmpty(entry.Published, entry.Updated)
+
+	return &models.FeedEntry{
+		GUID:        strings.TrimSpace(firstNonEmpty(entry.ID, link, entry.Title)),
+		Title:       strings.TrimSpace(entry.Title),
+		Author:      strings.TrimSpace(entry.Author.Name),
+		Summary:     stripHTML(firstNonEmpty(entry.Summary, entry.Content)),
+		Link:        strings.TrimSpace(link),
+		PublishedAt: parseFeedDate(date, []string{time.RFC3339}),
+	}
+}
+
+func parseFeedDate(value string, layouts []string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func stripHTML(text string) string {
+	text = htmlTagRegex.ReplaceAllString(text, "")
+
+	return strings.TrimSpace(html.UnescapeString(text))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,151 @@
+package clients_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSSFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>Blog</title>
+    <item>
+      <title>Second post</title>
+      <link>https://blog.example.com/second</link>
+      <guid>post-2</guid>
+      <pubDate>Tue, 02 Jan 2024 10:00:00 +0000</pubDate>
+      <dc:creator>alice</dc:creator>
+      <description>&lt;p&gt;Hello &amp;amp; welcome&lt;/p&gt;</description>
+    </item>
+    <item>
+      <title>First post</title>
+      <link>https://blog.example.com/first</link>
+      <pubDate>Mon, 1 Jan 2024 10:00:00 GMT</pubDate>
+      <description>Intro</description>
+    </item>
+  </channel>
+</rss>`
+
+const testAtomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Changelog</title>
+  <entry>
+    <id>tag:example.com,2024:1</id>
+    <title>v1.0.0</title>
+    <link rel="alternate" href="https://example.com/changelog/1"/>
+    <updated>2024-01-03T12:00:00Z</updated>
+    <author><name>bob</name></author>
+    <summary>First stable release</summary>
+  </entry>
+</feed>`
+
+func TestFeedClient_GetFeed(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+
+		body := testRSSFeed
+		if r.URL.Path == "/atom.xml" {
+			body = testAtomFeed
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+		AllowPrivateNetworks:       true,
+	}
+
+	client := clients.NewFeedClient(cfg, logger)
+
+	t.Run("RSS", func(t *testing.T) {
+		entries, err := client.GetFeed(context.Background(), server.URL+"/rss.xml")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		assert.Equal(t, "post-2", entries[0].GUID)
+		assert.Equal(t, "Second post", entries[0].Title)
+		assert.Equal(t, "alice", entries[0].Author)
+		assert.Equal(t, "Hello & welcome", entries[0].Summary)
+		assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), entries[0].PublishedAt.UTC())
+
+		assert.Equal(t, "https://blog.example.com/first", entries[1].GUID)
+		assert.False(t, entries[1].PublishedAt.IsZero())
+	})
+
+	t.Run("Atom", func(t *testing.T) {
+		entries, err := client.GetFeed(context.Background(), server.URL+"/atom.xml")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		assert.Equal(t, "tag:example.com,2024:1", entries[0].GUID)
+		assert.Equal(t, "v1.0.0", entries[0].Title)
+		assert.Equal(t, "bob", entries[0].Author)
+		assert.Equal(t, "First stable release", entries[0].Summary)
+		assert.Equal(t, "https://example.com/changelog/1", entries[0].Link)
+	})
+}
+
+func TestFeedClient_GetFeed_Conditional(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	const etag = `"feed-v1"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+
+		if _, err := w.Write([]byte(testAtomFeed)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+		AllowPrivateNetworks:   true,
+	}
+
+	client := clients.NewFeedClient(cfg, logger)
+
+	validators := &httputil.Validators{}
+	ctx := httputil.WithValidators(context.Background(), validators)
+
+	entries, err := client.GetFeed(ctx, server.URL+"/atom.xml")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, etag, validators.ETag)
+
+	_, err = client.GetFeed(ctx, server.URL+"/atom.xml")
+	require.ErrorIs(t, err, &customerrors.ErrNotModified{})
+}
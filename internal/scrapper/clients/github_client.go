@@ -2,28 +2,64 @@ package clients
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/go-resty/resty/v2"
 )
 
 type GitHubClient struct {
-	client  *resty.Client
-	token   string
-	baseURL string
-	logger  *slog.Logger
+	client    *resty.Client
+	token     string
+	baseURL   string
+	logger    *slog.Logger
+	rateLimit *httputil.RateLimitTracker
+
+	branchesMu sync.Mutex
+	branches   map[string]cachedBranch
+}
+
+// defaultBranchTTL — сколько хранится ветка по умолчанию репозитория: она меняется редко,
+// а запрос за ней при каждой проверке workflow удваивал бы расход квоты.
+const defaultBranchTTL = 24 * time.Hour
+
+type cachedBranch struct {
+	name      string
+	fetchedAt time.Time
 }
 
 type RepositoryUpdateGetter interface {
 	GetRepositoryLastUpdate(ctx context.Context, owner, repo string) (time.Time, error)
 	GetRepositoryDetails(ctx context.Context, owner, repo string) (*models.ContentDetails, error)
+	GetIssuesLastUpdate(ctx context.Context, owner, repo string) (time.Time, error)
+	GetIssuesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error)
+	GetReleasesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error)
+	GetLatestRelease(ctx context.Context, owner, repo string) (*models.UpdateInfo, error)
+	GetCommitsSince(ctx context.Context, owner, repo, ref, path string, since time.Time) ([]*models.UpdateInfo, error)
+	GetLatestCommit(ctx context.Context, owner, repo, ref, path string) (*models.UpdateInfo, error)
+	GetIssue(ctx context.Context, owner, repo string, number int64) (*models.UpdateInfo, error)
+	GetIssueCommentsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetIssueEventsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetPullReviewsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetPullCommitsSince(ctx context.Context, owner, repo string, number int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetWorkflowRuns(ctx context.Context, owner, repo, workflow string) ([]*models.WorkflowRun, error)
+	GetSecurityAdvisories(ctx context.Context, owner, repo string) ([]*models.SecurityAdvisory, error)
+	GetRepositorySnapshots(ctx context.Context, repos []models.RepositoryRef) (map[models.RepositoryRef]*models.RepositorySnapshot, error)
+	// RateLimit возвращает остаток квоты REST API GitHub по последнему ответу.
+	RateLimit() httputil.RateLimitStatus
 }
 
+// maxPages ограничивает число страниц списка, запрашиваемых за одну проверку.
+const maxPages = 10
+
 func NewGitHubClient(token, baseURL string, cfg *config.Config, logger *slog.Logger) RepositoryUpdateGetter {
 	if baseURL == "" {
 		baseURL = "https://api.github.com"
@@ -31,11 +67,39 @@ func NewGitHubClient(token, baseURL string, cfg *config.Config, logger *slog.Log
 
 	client := httputil.CreateResilientHTTPClient(cfg, logger, "github")
 
+	rateLimit := httputil.NewResourceRateLimitTracker(gitHubRateLimitResource, gitHubCoreResource)
+	httputil.TrackRateLimit(client, rateLimit, "github")
+
 	return &GitHubClient{
-		client:  client,
-		token:   token,
-		baseURL: baseURL,
-		logger:  logger,
+		client:    client,
+		token:     token,
+		baseURL:   baseURL,
+		logger:    logger,
+		rateLimit: rateLimit,
+		branches:  make(map[string]cachedBranch),
+	}
+}
+
+func (c *GitHubClient) RateLimit() httputil.RateLimitStatus {
+	return c.rateLimit.RateLimit()
+}
+
+const (
+	gitHubCoreResource    = "core"
+	gitHubGraphQLResource = "graphql"
+	gitHubSearchResource  = "search"
+)
+
+// gitHubRateLimitResource возвращает ресурс квоты GitHub, к которому относится запрос:
+// REST, GraphQL и поиск расходуют разные квоты.
+func gitHubRateLimitResource(url string) string {
+	switch {
+	case strings.HasSuffix(url, "/graphql"):
+		return gitHubGraphQLResource
+	case strings.Contains(url, "/search/"):
+		return gitHubSearchResource
+	default:
+		return gitHubCoreResource
 	}
 }
 
@@ -49,6 +113,83 @@ type Repository struct {
 	} `json:"owner"`
 }
 
+// Issue описывает элемент ответа /repos/{owner}/{repo}/issues.
+// Pull request'ы приходят тем же списком и отличаются наличием поля pull_request.
+type Issue struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	PullRequest *struct {
+		URL string `json:"url"`
+	} `json:"pull_request"`
+}
+
+type IssueComment struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type IssueEvent struct {
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Actor     struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	Label *struct {
+		Name string `json:"name"`
+	} `json:"label"`
+}
+
+type Review struct {
+	Body        string    `json:"body"`
+	State       string    `json:"state"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	User        struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type Commit struct {
+	SHA    string `json:"sha"`
+	Commit struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string `json:"name"`
+		} `json:"author"`
+		Committer struct {
+			Date time.Time `json:"date"`
+		} `json:"committer"`
+	} `json:"commit"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+type Release struct {
+	TagName     string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Body        string    `json:"body"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
+	CreatedAt   time.Time `json:"created_at"`
+	PublishedAt time.Time `json:"published_at"`
+	Author      struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
 func (c *GitHubClient) GetRepositoryLastUpdate(ctx context.Context, owner, repo string) (time.Time, error) {
 	url := fmt.Sprintf("%s/repos/%s/%s", c.baseURL, owner, repo)
 
@@ -60,7 +201,12 @@ func (c *GitHubClient) GetRepositoryLastUpdate(ctx context.Context, owner, repo
 		request.SetHeader("Authorization", "token "+c.token)
 	}
 
+	httputil.SetConditionalHeaders(ctx, request)
+
 	var repository struct {
+		FullName  string    `json:"full_name"`
+		HTMLURL   string    `json:"html_url"`
+		PushedAt  time.Time `json:"pushed_at"`
 		UpdatedAt time.Time `json:"updated_at"`
 	}
 
@@ -72,11 +218,31 @@ func (c *GitHubClient) GetRepositoryLastUpdate(ctx context.Context, owner, repo
 		return time.Time{}, err
 	}
 
+	if httputil.IsNotModified(resp) {
+		return time.Time{}, &customerrors.ErrNotModified{URL: url}
+	}
+
+	if httputil.IsGone(resp) {
+		return time.Time{}, &customerrors.ErrResourceGone{URL: url}
+	}
+
 	if !resp.IsSuccess() {
 		return time.Time{}, fmt.Errorf("GitHub API вернул статус: %d", resp.StatusCode())
 	}
 
-	return repository.UpdatedAt, nil
+	// Переименованный или переданный репозиторий API отдаёт через редирект 301 на новое имя.
+	if repository.HTMLURL != "" && !strings.EqualFold(repository.FullName, owner+"/"+repo) {
+		return time.Time{}, &customerrors.ErrResourceMoved{
+			URL:    fmt.Sprintf("https://github.com/%s/%s", owner, repo),
+			NewURL: repository.HTMLURL,
+		}
+	}
+
+	httputil.StoreValidators(ctx, resp)
+
+	snapshot := models.RepositorySnapshot{PushedAt: repository.PushedAt, UpdatedAt: repository.UpdatedAt}
+
+	return snapshot.LastActivity(), nil
 }
 
 func (c *GitHubClient) GetRepositoryDetails(ctx context.Context, owner, repo string) (*models.ContentDetails, error) {
@@ -114,3 +280,792 @@ func (c *GitHubClient) GetRepositoryDetails(ctx context.Context, owner, repo str
 
 	return details, nil
 }
+
+// GetIssuesLastUpdate возвращает время последнего изменения issue или pull request репозитория
+// или нулевое время, если их нет или issues в репозитории отключены. Новый комментарий тоже меняет updated_at
+// своего issue.
+func (c *GitHubClient) GetIssuesLastUpdate(ctx context.Context, owner, repo string) (time.Time, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues", c.baseURL, owner, repo)
+
+	params := map[string]string{
+		"state":     "all",
+		"sort":      "updated",
+		"direction": "desc",
+		"per_page":  "1",
+	}
+
+	var issues []Issue
+	if err := c.getJSON(ctx, url, params, &issues); err != nil && !issuesDisabled(err) {
+		return time.Time{}, err
+	}
+
+	if len(issues) == 0 {
+		return time.Time{}, nil
+	}
+
+	return issues[0].UpdatedAt, nil
+}
+
+func (c *GitHubClient) GetIssuesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues", c.baseURL, owner, repo)
+
+	params := map[string]string{
+		"state":     "all",
+		"sort":      "updated",
+		"direction": "asc",
+		"per_page":  "100",
+	}
+
+	if !since.IsZero() {
+		params["since"] = since.UTC().Format(time.RFC3339)
+	}
+
+	issues, err := getAllPages[Issue](ctx, c, url, params, nil)
+	if err != nil {
+		if issuesDisabled(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(issues))
+
+	for i := range issues {
+		issue := &issues[i]
+
+		// since отбирает элементы с updated_at >= since, поэтому граничный элемент уже был отправлен ранее.
+		if !since.IsZero() && !issue.UpdatedAt.After(since) {
+			continue
+		}
+
+		updates = append(updates, issueToUpdateInfo(issue))
+	}
+
+	return updates, nil
+}
+
+// GetReleasesSince возвращает релизы, опубликованные после since, в хронологическом порядке.
+// API отдаёт релизы от новых к старым, поэтому страницы запрашиваются, пока не встретится релиз не новее since.
+// issuesDisabled сообщает, что список issues ответил 404 или 410. Так GitHub отвечает, когда issues в репозитории
+// отключены, поэтому такой ответ не означает удаления самого репозитория.
+func issuesDisabled(err error) bool {
+	return stderrors.Is(err, &customerrors.ErrResourceGone{})
+}
+
+func (c *GitHubClient) GetReleasesSince(ctx context.Context, owner, repo string, since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/releases", c.baseURL, owner, repo)
+
+	releases, err := getAllPages(ctx, c, url, map[string]string{"per_page": "100"}, func(page []Release) bool {
+		return len(page) > 0 && !releasePublishedAt(&page[len(page)-1]).After(since)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(releases))
+
+	for i := len(releases) - 1; i >= 0; i-- {
+		if !releasePublishedAt(&releases[i]).After(since) {
+			continue
+		}
+
+		updates = append(updates, releaseToUpdateInfo(&releases[i]))
+	}
+
+	return updates, nil
+}
+
+// GetLatestRelease возвращает последний релиз репозитория или nil, если релизов нет.
+// Запрос условный: если релизы не менялись с прошлой проверки ссылки, возвращается ErrNotModified.
+func (c *GitHubClient) GetLatestRelease(ctx context.Context, owner, repo string) (*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/releases", c.baseURL, owner, repo)
+
+	var releases []Release
+	if err := c.getConditionalJSON(ctx, url, map[string]string{"per_page": "1"}, &releases); err != nil {
+		return nil, err
+	}
+
+	if len(releases) == 0 {
+		return nil, nil
+	}
+
+	return releaseToUpdateInfo(&releases[0]), nil
+}
+
+// releasePublishedAt возвращает дату публикации релиза. У черновиков её нет, для них используется дата создания.
+func releasePublishedAt(release *Release) time.Time {
+	if release.PublishedAt.IsZero() {
+		return release.CreatedAt
+	}
+
+	return release.PublishedAt
+}
+
+// GetCommitsSince возвращает коммиты ветки ref, затрагивающие path, в хронологическом порядке.
+// Пустой path означает все коммиты ветки.
+func (c *GitHubClient) GetCommitsSince(ctx context.Context, owner, repo, ref, path string,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/commits", c.baseURL, owner, repo)
+
+	params := commitsParams(ref, path, "100")
+	if !since.IsZero() {
+		params["since"] = since.UTC().Format(time.RFC3339)
+	}
+
+	commits, err := getAllPages[Commit](ctx, c, url, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(commits))
+
+	// API отдаёт коммиты от новых к старым.
+	for i := len(commits) - 1; i >= 0; i-- {
+		commit := &commits[i]
+
+		committedAt := commit.Commit.Committer.Date
+		if !since.IsZero() && !committedAt.After(since) {
+			continue
+		}
+
+		updates = append(updates, commitToUpdateInfo(commit))
+	}
+
+	return updates, nil
+}
+
+// GetLatestCommit возвращает последний коммит ветки ref, затрагивающий path, или nil, если коммитов нет.
+func (c *GitHubClient) GetLatestCommit(ctx context.Context, owner, repo, ref, path string) (*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/commits", c.baseURL, owner, repo)
+
+	var commits []Commit
+	if err := c.getJSON(ctx, url, commitsParams(ref, path, "1"), &commits); err != nil {
+		return nil, err
+	}
+
+	if len(commits) == 0 {
+		return nil, nil
+	}
+
+	return commitToUpdateInfo(&commits[0]), nil
+}
+
+// commitsParams возвращает параметры запроса списка коммитов ветки ref, затрагивающих path.
+func commitsParams(ref, path, perPage string) map[string]string {
+	params := map[string]string{
+		"sha":      ref,
+		"per_page": perPage,
+	}
+
+	if path != "" {
+		params["path"] = path
+	}
+
+	return params
+}
+
+// GetIssue возвращает текущее состояние issue или pull request.
+func (c *GitHubClient) GetIssue(ctx context.Context, owner, repo string, number int64) (*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d", c.baseURL, owner, repo, number)
+
+	var issue Issue
+	if err := c.getJSON(ctx, url, nil, &issue); err != nil {
+		return nil, err
+	}
+
+	return issueToUpdateInfo(&issue), nil
+}
+
+// GetIssueCommentsSince возвращает комментарии к issue или pull request, оставленные после since.
+func (c *GitHubClient) GetIssueCommentsSince(ctx context.Context, owner, repo string, number int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments", c.baseURL, owner, repo, number)
+
+	params := map[string]string{"per_page": "100"}
+	if !since.IsZero() {
+		params["since"] = since.UTC().Format(time.RFC3339)
+	}
+
+	comments, err := getAllPages[IssueComment](ctx, c, url, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(comments))
+
+	for i := range comments {
+		comment := &comments[i]
+
+		// since фильтрует по времени редактирования, нас интересуют только новые комментарии.
+		if !comment.CreatedAt.After(since) {
+			continue
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Author:      comment.User.Login,
+			UpdatedAt:   comment.CreatedAt,
+			ContentType: "issue_comment",
+			TextPreview: models.TextPreview(comment.Body, 200),
+			FullText:    comment.Body,
+		})
+	}
+
+	return updates, nil
+}
+
+// GetIssueEventsSince возвращает изменения меток и состояния issue или pull request после since.
+func (c *GitHubClient) GetIssueEventsSince(ctx context.Context, owner, repo string, number int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/events", c.baseURL, owner, repo, number)
+
+	// События отдаются от старых к новым без фильтра по времени, поэтому страницы обходятся с последней.
+	events, err := getLastPages(ctx, c, url, map[string]string{"per_page": "100"}, func(page []IssueEvent) bool {
+		return len(page) > 0 && !page[0].CreatedAt.After(since)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mergedAt := make(map[time.Time]bool)
+
+	for i := range events {
+		if events[i].Event == "merged" {
+			mergedAt[events[i].CreatedAt] = true
+		}
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(events))
+
+	for i := range events {
+		event := &events[i]
+
+		if !event.CreatedAt.After(since) {
+			continue
+		}
+
+		var (
+			text   string
+			labels []string
+		)
+
+		switch event.Event {
+		case "labeled", "unlabeled":
+			if event.Label == nil {
+				continue
+			}
+
+			text = "Добавлена метка: " + event.Label.Name
+			if event.Event == "unlabeled" {
+				text = "Снята метка: " + event.Label.Name
+			}
+
+			labels = []string{event.Label.Name}
+		case "closed":
+			// Слияние pull request сопровождается событием closed, отдельно о нём не сообщаем.
+			if mergedAt[event.CreatedAt] {
+				continue
+			}
+
+			text = "Закрыто"
+		case "reopened":
+			text = "Открыто повторно"
+		case "merged":
+			text = "Изменения влиты"
+		default:
+			continue
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Author:      event.Actor.Login,
+			UpdatedAt:   event.CreatedAt,
+			ContentType: event.Event,
+			TextPreview: text,
+			FullText:    text,
+			Labels:      labels,
+		})
+	}
+
+	return updates, nil
+}
+
+// GetPullReviewsSince возвращает ревью pull request, отправленные после since.
+func (c *GitHubClient) GetPullReviewsSince(ctx context.Context, owner, repo string, number int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews", c.baseURL, owner, repo, number)
+
+	reviews, err := getAllPages[Review](ctx, c, url, map[string]string{"per_page": "100"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(reviews))
+
+	for i := range reviews {
+		review := &reviews[i]
+
+		// У незавершённых ревью (PENDING) нет даты отправки.
+		if review.SubmittedAt.IsZero() || !review.SubmittedAt.After(since) {
+			continue
+		}
+
+		text := review.State
+		if review.Body != "" {
+			text = fmt.Sprintf("%s: %s", review.State, review.Body)
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Author:      review.User.Login,
+			UpdatedAt:   review.SubmittedAt,
+			ContentType: "review",
+			TextPreview: models.TextPreview(text, 200),
+			FullText:    text,
+		})
+	}
+
+	return updates, nil
+}
+
+// GetPullCommitsSince возвращает коммиты, добавленные в pull request после since.
+func (c *GitHubClient) GetPullCommitsSince(ctx context.Context, owner, repo string, number int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/commits", c.baseURL, owner, repo, number)
+
+	commits, err := getAllPages[Commit](ctx, c, url, map[string]string{"per_page": "100"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(commits))
+
+	for i := range commits {
+		if !commits[i].Commit.Committer.Date.After(since) {
+			continue
+		}
+
+		updates = append(updates, commitToUpdateInfo(&commits[i]))
+	}
+
+	return updates, nil
+}
+
+// WorkflowRun описывает элемент ответа /repos/{owner}/{repo}/actions/workflows/{workflow}/runs.
+type WorkflowRun struct {
+	ID         int64     `json:"id"`
+	RunNumber  int64     `json:"run_number"`
+	Name       string    `json:"name"`
+	Conclusion string    `json:"conclusion"`
+	HTMLURL    string    `json:"html_url"`
+	HeadSHA    string    `json:"head_sha"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	HeadCommit *struct {
+		Message string `json:"message"`
+	} `json:"head_commit"`
+	Actor struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+}
+
+// GetWorkflowRuns возвращает последние завершённые запуски workflow в ветке по умолчанию в хронологическом порядке.
+// Workflow задаётся именем файла или числовым ID.
+func (c *GitHubClient) GetWorkflowRuns(ctx context.Context, owner, repo, workflow string) ([]*models.WorkflowRun, error) {
+	branch, err := c.defaultBranch(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/workflows/%s/runs", c.baseURL, owner, repo, workflow)
+
+	var response struct {
+		WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+	}
+
+	params := map[string]string{
+		"branch":   branch,
+		"status":   "completed",
+		"per_page": "30",
+	}
+
+	if err := c.getJSON(ctx, url, params, &response); err != nil {
+		return nil, err
+	}
+
+	runs := make([]*models.WorkflowRun, 0, len(response.WorkflowRuns))
+
+	// API отдаёт запуски от новых к старым.
+	for i := len(response.WorkflowRuns) - 1; i >= 0; i-- {
+		run := &response.WorkflowRuns[i]
+
+		workflowRun := &models.WorkflowRun{
+			ID:         run.ID,
+			Number:     run.RunNumber,
+			Name:       run.Name,
+			Conclusion: run.Conclusion,
+			URL:        run.HTMLURL,
+			HeadSHA:    run.HeadSHA,
+			Actor:      run.Actor.Login,
+			UpdatedAt:  run.UpdatedAt,
+		}
+
+		if run.HeadCommit != nil {
+			workflowRun.CommitMessage = run.HeadCommit.Message
+		}
+
+		runs = append(runs, workflowRun)
+	}
+
+	return runs, nil
+}
+
+// defaultBranch возвращает ветку по умолчанию репозитория, запрашивая её не чаще раза в defaultBranchTTL.
+func (c *GitHubClient) defaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	key := strings.ToLower(owner + "/" + repo)
+
+	c.branchesMu.Lock()
+	cached, ok := c.branches[key]
+	c.branchesMu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < defaultBranchTTL {
+		return cached.name, nil
+	}
+
+	var repository struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/repos/%s/%s", c.baseURL, owner, repo), nil, &repository); err != nil {
+		return "", err
+	}
+
+	c.branchesMu.Lock()
+	c.branches[key] = cachedBranch{name: repository.DefaultBranch, fetchedAt: time.Now()}
+	c.branchesMu.Unlock()
+
+	return repository.DefaultBranch, nil
+}
+
+// SecurityAdvisory описывает элемент ответа /repos/{owner}/{repo}/security-advisories.
+type SecurityAdvisory struct {
+	GHSAID      string    `json:"ghsa_id"`
+	CVEID       *string   `json:"cve_id"`
+	HTMLURL     string    `json:"html_url"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description"`
+	Severity    string    `json:"severity"`
+	PublishedAt time.Time `json:"published_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Publisher   *struct {
+		Login string `json:"login"`
+	} `json:"publisher"`
+	Vulnerabilities []struct {
+		Package *struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		VulnerableVersionRange *string `json:"vulnerable_version_range"`
+		PatchedVersions        *string `json:"patched_versions"`
+	} `json:"vulnerabilities"`
+}
+
+// GetSecurityAdvisories возвращает опубликованные бюллетени безопасности репозитория, начиная с недавно изменённых.
+func (c *GitHubClient) GetSecurityAdvisories(ctx context.Context, owner, repo string) ([]*models.SecurityAdvisory, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/security-advisories", c.baseURL, owner, repo)
+
+	params := map[string]string{
+		"state":     "published",
+		"sort":      "updated",
+		"direction": "desc",
+		"per_page":  "100",
+	}
+
+	// Учтённые бюллетени сравниваются со всем списком, поэтому нужны все страницы, а не только первая.
+	response, err := getAllPages[SecurityAdvisory](ctx, c, url, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	advisories := make([]*models.SecurityAdvisory, 0, len(response))
+	for i := range response {
+		advisories = append(advisories, securityAdvisoryToModel(&response[i]))
+	}
+
+	return advisories, nil
+}
+
+func (c *GitHubClient) getJSON(ctx context.Context, url string, params map[string]string, result any) error {
+	_, err := c.getPage(ctx, url, params, result)
+
+	return err
+}
+
+// getAllPages собирает элементы списка со всех страниц, следуя заголовку Link rel="next".
+// Обход прекращается, когда страницы заканчиваются, done сообщает, что остальные страницы не нужны,
+// или после maxPages страниц.
+func getAllPages[T any](ctx context.Context, c *GitHubClient, url string, params map[string]string,
+	done func(page []T) bool) ([]T, error) {
+	var items []T
+
+	for page := 0; page < maxPages && url != ""; page++ {
+		var batch []T
+
+		next, err := c.getPage(ctx, url, params, &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, batch...)
+
+		if done != nil && done(batch) {
+			break
+		}
+
+		// Адрес следующей страницы уже содержит все параметры запроса.
+		url, params = next, nil
+	}
+
+	return items, nil
+}
+
+// getLastPages собирает элементы списка, упорядоченного от старых к новым, начиная с конца: первая страница
+// сообщает адрес последней в заголовке Link rel="last", дальше обход идёт по rel="prev". Обход прекращается,
+// когда done сообщает, что более ранние страницы не нужны, или после maxPages страниц.
+// Элементы возвращаются от старых к новым.
+func getLastPages[T any](ctx context.Context, c *GitHubClient, url string, params map[string]string,
+	done func(page []T) bool) ([]T, error) {
+	var first []T
+
+	links, err := c.getPageLinks(ctx, url, params, &first)
+	if err != nil {
+		return nil, err
+	}
+
+	url = pageURL(links, "last")
+	if url == "" {
+		return first, nil
+	}
+
+	var pages [][]T
+
+	for page := 0; page < maxPages && url != ""; page++ {
+		var batch []T
+
+		links, err := c.getPageLinks(ctx, url, nil, &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		pages = append(pages, batch)
+
+		if done(batch) {
+			break
+		}
+
+		url = pageURL(links, "prev")
+	}
+
+	var items []T
+	for i := len(pages) - 1; i >= 0; i-- {
+		items = append(items, pages[i]...)
+	}
+
+	return items, nil
+}
+
+// getPage запрашивает страницу списка и возвращает адрес следующей страницы или пустую строку.
+func (c *GitHubClient) getPage(ctx context.Context, url string, params map[string]string, result any) (string, error) {
+	links, err := c.getPageLinks(ctx, url, params, result)
+	if err != nil {
+		return "", err
+	}
+
+	return pageURL(links, "next"), nil
+}
+
+// getPageLinks запрашивает страницу списка и возвращает заголовок Link со ссылками на соседние страницы.
+func (c *GitHubClient) getPageLinks(ctx context.Context, url string, params map[string]string,
+	result any) (string, error) {
+	resp, err := c.get(c.newRequest(ctx, params), url, result)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Header().Get("Link"), nil
+}
+
+// getConditionalJSON запрашивает ресурс с валидаторами ссылки из контекста проверки и сохраняет новые валидаторы.
+// Если ресурс не изменился, возвращается ErrNotModified.
+func (c *GitHubClient) getConditionalJSON(ctx context.Context, url string, params map[string]string, result any) error {
+	request := c.newRequest(ctx, params)
+	httputil.SetConditionalHeaders(ctx, request)
+
+	resp, err := c.get(request, url, result)
+	if err != nil {
+		return err
+	}
+
+	httputil.StoreValidators(ctx, resp)
+
+	return nil
+}
+
+func (c *GitHubClient) newRequest(ctx context.Context, params map[string]string) *resty.Request {
+	request := c.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/vnd.github.v3+json").
+		SetQueryParams(params)
+
+	if c.token != "" {
+		request.SetHeader("Authorization", "token "+c.token)
+	}
+
+	return request
+}
+
+// get выполняет запрос и переводит неуспешный ответ в ошибку.
+func (c *GitHubClient) get(request *resty.Request, url string, result any) (*resty.Response, error) {
+	resp, err := request.
+		SetResult(result).
+		Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsNotModified(resp) {
+		return nil, &customerrors.ErrNotModified{URL: url}
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: url}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("GitHub API вернул статус: %d", resp.StatusCode())
+	}
+
+	return resp, nil
+}
+
+// pageURL извлекает из заголовка Link адрес страницы с отношением rel или возвращает пустую строку.
+func pageURL(header, rel string) string {
+	for _, part := range strings.Split(header, ",") {
+		target, params, ok := strings.Cut(part, ";")
+		if ok && strings.Contains(params, `rel="`+rel+`"`) {
+			return strings.Trim(strings.TrimSpace(target), "<>")
+		}
+	}
+
+	return ""
+}
+
+func issueToUpdateInfo(issue *Issue) *models.UpdateInfo {
+	contentType := "issue"
+	if issue.PullRequest != nil {
+		contentType = "pull_request"
+	}
+
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	return &models.UpdateInfo{
+		Title:       fmt.Sprintf("#%d %s", issue.Number, issue.Title),
+		Author:      issue.User.Login,
+		UpdatedAt:   issue.UpdatedAt,
+		ContentType: contentType,
+		TextPreview: models.TextPreview(issue.Body, 200),
+		FullText:    issue.Body,
+		Labels:      labels,
+	}
+}
+
+func commitToUpdateInfo(commit *Commit) *models.UpdateInfo {
+	author := commit.Commit.Author.Name
+	if commit.Author != nil && commit.Author.Login != "" {
+		author = commit.Author.Login
+	}
+
+	shortSHA := commit.SHA
+	if len(shortSHA) > 7 {
+		shortSHA = shortSHA[:7]
+	}
+
+	subject, _, _ := strings.Cut(commit.Commit.Message, "\n")
+
+	return &models.UpdateInfo{
+		Title:       fmt.Sprintf("%s %s", shortSHA, subject),
+		Author:      author,
+		UpdatedAt:   commit.Commit.Committer.Date,
+		ContentType: "commit",
+		TextPreview: models.TextPreview(commit.Commit.Message, 200),
+		FullText:    commit.Commit.Message,
+	}
+}
+
+func releaseToUpdateInfo(release *Release) *models.UpdateInfo {
+	title := release.TagName
+	if release.Name != "" && release.Name != release.TagName {
+		title = fmt.Sprintf("%s — %s", release.TagName, release.Name)
+	}
+
+	var labels []string
+	if release.Prerelease {
+		labels = append(labels, "prerelease")
+	}
+
+	if release.Draft {
+		labels = append(labels, "draft")
+	}
+
+	return &models.UpdateInfo{
+		Title:       title,
+		Author:      release.Author.Login,
+		UpdatedAt:   releasePublishedAt(release),
+		ContentType: "release",
+		TextPreview: models.TextPreview(release.Body, 200),
+		FullText:    release.Body,
+		Labels:      labels,
+	}
+}
+
+func securityAdvisoryToModel(advisory *SecurityAdvisory) *models.SecurityAdvisory {
+	result := &models.SecurityAdvisory{
+		GHSAID:      advisory.GHSAID,
+		URL:         advisory.HTMLURL,
+		Summary:     advisory.Summary,
+		Description: advisory.Description,
+		Severity:    advisory.Severity,
+		PublishedAt: advisory.PublishedAt,
+		UpdatedAt:   advisory.UpdatedAt,
+	}
+
+	if advisory.CVEID != nil {
+		result.CVEID = *advisory.CVEID
+	}
+
+	if advisory.Publisher != nil {
+		result.Publisher = advisory.Publisher.Login
+	}
+
+	for _, vulnerability := range advisory.Vulnerabilities {
+		var affected models.AdvisoryVulnerability
+
+		if vulnerability.Package != nil {
+			affected.Ecosystem = vulnerability.Package.Ecosystem
+			affected.Package = vulnerability.Package.Name
+		}
+
+		if vulnerability.VulnerableVersionRange != nil {
+			affected.VulnerableRange = *vulnerability.VulnerableVersionRange
+		}
+
+		if vulnerability.PatchedVersions != nil {
+			affected.PatchedVersions = *vulnerability.PatchedVersions
+		}
+
+		result.Vulnerabilities = append(result.Vulnerabilities, affected)
+	}
+
+	return result
+}
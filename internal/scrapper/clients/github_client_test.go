@@ -2,14 +2,20 @@ package clients_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,10 +31,10 @@ func TestGitHubClient_RetryBehavior(t *testing.T) {
 		if requestCount <= 2 {
 			w.WriteHeader(http.StatusInternalServerError)
 		} else {
-			w.WriteHeader(http.StatusOK)
 			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
 
-			response := `{"updated_at": "2023-01-01T10:00:00Z"}`
+			response := `{"updated_at": "2023-01-01T10:00:00Z", "pushed_at": "2023-01-02T10:00:00Z"}`
 			if _, err := w.Write([]byte(response)); err != nil {
 				t.Errorf("Failed to write response: %v", err)
 			}
@@ -57,8 +63,7 @@ func TestGitHubClient_RetryBehavior(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, 3, requestCount)
-
-	_ = lastUpdate
+	assert.Equal(t, time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC), lastUpdate)
 }
 
 func TestGitHubClient_NonRetryableStatus(t *testing.T) {
@@ -69,7 +74,7 @@ func TestGitHubClient_NonRetryableStatus(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		requestCount++
 
-		w.WriteHeader(http.StatusNotFound)
+		w.WriteHeader(http.StatusUnprocessableEntity)
 	}))
 	defer server.Close()
 
@@ -92,7 +97,745 @@ func TestGitHubClient_NonRetryableStatus(t *testing.T) {
 	_, err := client.GetRepositoryLastUpdate(ctx, "owner", "repo")
 
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "404")
+	assert.Contains(t, err.Error(), "422")
 
 	assert.Equal(t, 1, requestCount)
 }
+
+func TestGitHubClient_MovedAndGoneRepository(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/old-owner/repo":
+			http.Redirect(w, r, "/repositories/42", http.StatusMovedPermanently)
+		case "/repositories/42":
+			w.Header().Set("Content-Type", "application/json")
+
+			response := `{"full_name": "new-owner/repo", "html_url": "https://github.com/new-owner/repo",
+				"updated_at": "2024-01-01T00:00:00Z"}`
+			if _, err := w.Write([]byte(response)); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+	ctx := context.Background()
+
+	_, err := client.GetRepositoryLastUpdate(ctx, "old-owner", "repo")
+
+	var moved *customerrors.ErrResourceMoved
+
+	require.ErrorAs(t, err, &moved)
+	assert.Equal(t, "https://github.com/old-owner/repo", moved.URL)
+	assert.Equal(t, "https://github.com/new-owner/repo", moved.NewURL)
+
+	_, err = client.GetRepositoryLastUpdate(ctx, "owner", "deleted")
+	require.ErrorIs(t, err, &customerrors.ErrResourceGone{})
+
+	_, err = client.GetIssue(ctx, "owner", "deleted", 1)
+	require.ErrorIs(t, err, &customerrors.ErrResourceGone{})
+}
+
+func TestGitHubClient_IssuesDisabled(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	// Так GitHub отвечает на список issues репозитория, в котором они отключены.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/issues", r.URL.Path)
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+	ctx := context.Background()
+
+	lastUpdate, err := client.GetIssuesLastUpdate(ctx, "owner", "repo")
+	require.NoError(t, err)
+	assert.True(t, lastUpdate.IsZero())
+
+	updates, err := client.GetIssuesSince(ctx, "owner", "repo", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, updates)
+}
+
+func TestGitHubClient_ConditionalRequest(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	const etag = `"abc123"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Sun, 01 Jan 2023 10:00:00 GMT")
+
+		if _, err := w.Write([]byte(`{"updated_at": "2023-01-01T10:00:00Z"}`)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	validators := &httputil.Validators{}
+	ctx := httputil.WithValidators(context.Background(), validators)
+
+	lastUpdate, err := client.GetRepositoryLastUpdate(ctx, "owner", "repo")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC), lastUpdate)
+	assert.Equal(t, etag, validators.ETag)
+	assert.Equal(t, "Sun, 01 Jan 2023 10:00:00 GMT", validators.LastModified)
+
+	_, err = client.GetRepositoryLastUpdate(ctx, "owner", "repo")
+	require.ErrorIs(t, err, &customerrors.ErrNotModified{})
+	assert.Equal(t, etag, validators.ETag)
+}
+
+func TestGitHubClient_GetIssuesSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/issues", r.URL.Path)
+		assert.Equal(t, "all", r.URL.Query().Get("state"))
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[
+			{"number": 1, "title": "Old issue", "body": "old", "updated_at": "2024-01-01T10:00:00Z",
+			 "user": {"login": "alice"}},
+			{"number": 2, "title": "Bug report", "body": "Something is broken", "updated_at": "2024-01-01T11:00:00Z",
+			 "user": {"login": "bob"}, "labels": [{"name": "bug"}]},
+			{"number": 3, "title": "Fix bug", "body": "Fixes #2", "updated_at": "2024-01-01T12:00:00Z",
+			 "user": {"login": "carol"}, "pull_request": {"url": "https://api.github.com/repos/owner/repo/pulls/3"}}
+		]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetIssuesSince(context.Background(), "owner", "repo", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "#2 Bug report", updates[0].Title)
+	assert.Equal(t, "bob", updates[0].Author)
+	assert.Equal(t, "issue", updates[0].ContentType)
+	assert.Equal(t, "Something is broken", updates[0].TextPreview)
+	assert.Equal(t, []string{"bug"}, updates[0].Labels)
+
+	assert.Equal(t, "#3 Fix bug", updates[1].Title)
+	assert.Equal(t, "carol", updates[1].Author)
+	assert.Equal(t, "pull_request", updates[1].ContentType)
+}
+
+func TestGitHubClient_GetIssuesSince_FollowsNextPage(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[{"number": 2, "title": "Second", "updated_at": "2024-01-01T12:00:00Z", "user": {"login": "bob"}}]`
+
+		if r.URL.Query().Get("page") == "" {
+			assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/owner/repo/issues?page=2>; rel="next", `+
+				`<%s/repos/owner/repo/issues?page=2>; rel="last"`, server.URL, server.URL))
+
+			response = `[{"number": 1, "title": "First", "updated_at": "2024-01-01T11:00:00Z", "user": {"login": "alice"}}]`
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetIssuesSince(context.Background(), "owner", "repo", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "#1 First", updates[0].Title)
+	assert.Equal(t, "#2 Second", updates[1].Title)
+}
+
+func TestGitHubClient_GetReleasesSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/releases", r.URL.Path)
+		assert.Empty(t, r.URL.Query().Get("page"), "страница со старыми релизами не запрашивается")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", fmt.Sprintf(`<%s/repos/owner/repo/releases?page=2>; rel="next"`, server.URL))
+
+		response := `[
+			{"tag_name": "v1.2.0-rc1", "name": "", "body": "Release candidate", "prerelease": true,
+			 "created_at": "2024-01-01T12:00:00Z", "published_at": "2024-01-01T12:00:00Z", "author": {"login": "bob"}},
+			{"tag_name": "v1.1.0", "name": "Winter release", "body": "Changelog", "prerelease": false,
+			 "created_at": "2024-01-01T11:00:00Z", "published_at": "2024-01-01T11:00:00Z", "author": {"login": "alice"}},
+			{"tag_name": "v1.0.0", "name": "v1.0.0", "body": "Initial", "prerelease": false,
+			 "created_at": "2023-12-01T10:00:00Z", "published_at": "2023-12-01T10:00:00Z", "author": {"login": "alice"}}
+		]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetReleasesSince(context.Background(), "owner", "repo", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "v1.1.0 — Winter release", updates[0].Title)
+	assert.Equal(t, "alice", updates[0].Author)
+	assert.Equal(t, "release", updates[0].ContentType)
+	assert.Empty(t, updates[0].Labels)
+
+	assert.Equal(t, "v1.2.0-rc1", updates[1].Title)
+	assert.Equal(t, "Release candidate", updates[1].TextPreview)
+	assert.Equal(t, []string{"prerelease"}, updates[1].Labels)
+}
+
+func TestGitHubClient_GetLatestRelease(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	const etag = `"releases-v1"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/owner/deleted/releases" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+
+		response := `[{"tag_name": "v2.0.0", "body": "Notes", "created_at": "2024-01-01T12:00:00Z",
+			"published_at": "2024-01-02T12:00:00Z", "author": {"login": "bob"}}]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	validators := &httputil.Validators{}
+	ctx := httputil.WithValidators(context.Background(), validators)
+
+	latest, err := client.GetLatestRelease(ctx, "owner", "repo")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, "v2.0.0", latest.Title)
+	assert.Equal(t, time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC), latest.UpdatedAt)
+	assert.Equal(t, etag, validators.ETag)
+
+	_, err = client.GetLatestRelease(ctx, "owner", "repo")
+	require.ErrorIs(t, err, &customerrors.ErrNotModified{})
+
+	_, err = client.GetLatestRelease(context.Background(), "owner", "deleted")
+	require.ErrorIs(t, err, &customerrors.ErrResourceGone{})
+}
+
+func TestGitHubClient_GetCommitsSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/commits", r.URL.Path)
+		assert.Equal(t, "main", r.URL.Query().Get("sha"))
+		assert.Equal(t, "docs", r.URL.Query().Get("path"))
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[
+			{"sha": "bbbbbbbbbbbbbbbb", "commit": {"message": "Update docs\n\nMore details",
+			 "author": {"name": "Bob"}, "committer": {"date": "2024-01-01T12:00:00Z"}}, "author": null},
+			{"sha": "aaaaaaaaaaaaaaaa", "commit": {"message": "Add guide",
+			 "author": {"name": "Alice"}, "committer": {"date": "2024-01-01T11:00:00Z"}}, "author": {"login": "alice"}},
+			{"sha": "0000000000000000", "commit": {"message": "Old",
+			 "author": {"name": "Alice"}, "committer": {"date": "2024-01-01T10:00:00Z"}}, "author": {"login": "alice"}}
+		]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetCommitsSince(context.Background(), "owner", "repo", "main", "docs", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "aaaaaaa Add guide", updates[0].Title)
+	assert.Equal(t, "alice", updates[0].Author)
+	assert.Equal(t, "commit", updates[0].ContentType)
+
+	assert.Equal(t, "bbbbbbb Update docs", updates[1].Title)
+	assert.Equal(t, "Bob", updates[1].Author)
+}
+
+func TestGitHubClient_GetLatestCommit(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/commits", r.URL.Path)
+		assert.Equal(t, "main", r.URL.Query().Get("sha"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[{"sha": "bbbbbbbbbbbbbbbb", "commit": {"message": "Update docs",
+			"author": {"name": "Bob"}, "committer": {"date": "2024-01-01T12:00:00Z"}}, "author": null}]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	latest, err := client.GetLatestCommit(context.Background(), "owner", "repo", "main", "")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+
+	assert.Equal(t, "bbbbbbb Update docs", latest.Title)
+	assert.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), latest.UpdatedAt)
+}
+
+func TestGitHubClient_GetWorkflowRuns(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var repoRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/repos/owner/repo" {
+			repoRequests.Add(1)
+		}
+
+		response := `{"default_branch": "develop"}`
+
+		if r.URL.Path == "/repos/owner/repo/actions/workflows/ci.yml/runs" {
+			assert.Equal(t, "develop", r.URL.Query().Get("branch"))
+			assert.Equal(t, "completed", r.URL.Query().Get("status"))
+
+			response = `{"workflow_runs": [
+				{"id": 12, "run_number": 8, "name": "CI", "conclusion": "failure",
+				 "html_url": "https://github.com/owner/repo/actions/runs/12", "head_sha": "bbbbbbbbbb",
+				 "updated_at": "2024-01-02T10:00:00Z", "head_commit": {"message": "Break build"}, "actor": {"login": "bob"}},
+				{"id": 11, "run_number": 7, "name": "CI", "conclusion": "success",
+				 "html_url": "https://github.com/owner/repo/actions/runs/11", "head_sha": "aaaaaaaaaa",
+				 "updated_at": "2024-01-01T10:00:00Z", "head_commit": null, "actor": {"login": "alice"}}
+			]}`
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	runs, err := client.GetWorkflowRuns(context.Background(), "owner", "repo", "ci.yml")
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, int64(11), runs[0].ID)
+	assert.Equal(t, "success", runs[0].Conclusion)
+	assert.Empty(t, runs[0].CommitMessage)
+
+	assert.Equal(t, int64(8), runs[1].Number)
+	assert.Equal(t, "failure", runs[1].Conclusion)
+	assert.Equal(t, "Break build", runs[1].CommitMessage)
+	assert.Equal(t, "bob", runs[1].Actor)
+	assert.Equal(t, "https://github.com/owner/repo/actions/runs/12", runs[1].URL)
+
+	_, err = client.GetWorkflowRuns(context.Background(), "owner", "repo", "ci.yml")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repoRequests.Load(), "ветка по умолчанию должна запрашиваться один раз")
+}
+
+func TestGitHubClient_GetSecurityAdvisories(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/security-advisories", r.URL.Path)
+		assert.Equal(t, "published", r.URL.Query().Get("state"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// Второй бюллетень находится на следующей странице.
+		response := `[
+			{"ghsa_id": "GHSA-1111", "cve_id": null, "summary": "Open redirect", "severity": "low",
+			 "published_at": "2024-01-01T10:00:00Z", "updated_at": "2024-01-01T10:00:00Z", "publisher": null,
+			 "vulnerabilities": [{"package": null, "vulnerable_version_range": null, "patched_versions": null}]}
+		]`
+
+		if r.URL.Query().Get("page") != "2" {
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repos/owner/repo/security-advisories?state=published&page=2>; rel="next"`, server.URL))
+
+			response = `[
+			{"ghsa_id": "GHSA-2222", "cve_id": "CVE-2024-0002", "html_url": "https://github.com/owner/repo/security/advisories/GHSA-2222",
+			 "summary": "Path traversal", "severity": "high", "published_at": "2024-01-02T10:00:00Z",
+			 "updated_at": "2024-01-03T10:00:00Z", "publisher": {"login": "alice"},
+			 "vulnerabilities": [{"package": {"ecosystem": "npm", "name": "lib"},
+			  "vulnerable_version_range": "< 2.0.1", "patched_versions": "2.0.1"}]}
+		]`
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	advisories, err := client.GetSecurityAdvisories(context.Background(), "owner", "repo")
+	require.NoError(t, err)
+	require.Len(t, advisories, 2)
+
+	assert.Equal(t, "CVE-2024-0002", advisories[0].CVEID)
+	assert.Equal(t, "alice", advisories[0].Publisher)
+	assert.Equal(t, []models.AdvisoryVulnerability{
+		{Ecosystem: "npm", Package: "lib", VulnerableRange: "< 2.0.1", PatchedVersions: "2.0.1"},
+	}, advisories[0].Vulnerabilities)
+
+	assert.Empty(t, advisories[1].CVEID)
+	assert.Empty(t, advisories[1].Publisher)
+	assert.Equal(t, []models.AdvisoryVulnerability{{}}, advisories[1].Vulnerabilities)
+}
+
+func TestGitHubClient_GetIssueEventsSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/issues/7/events", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// Новые события находятся на второй странице.
+		response := `[
+			{"event": "merged", "created_at": "2024-01-01T12:00:00Z", "actor": {"login": "bob"}},
+			{"event": "closed", "created_at": "2024-01-01T12:00:00Z", "actor": {"login": "bob"}}
+		]`
+
+		if r.URL.Query().Get("page") == "2" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/owner/repo/issues/7/events?page=1>; rel="prev"`, server.URL))
+		} else {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/owner/repo/issues/7/events?page=2>; rel="next", `+
+				`<%s/repos/owner/repo/issues/7/events?page=2>; rel="last"`, server.URL, server.URL))
+
+			response = `[
+			{"event": "labeled", "created_at": "2024-01-01T09:00:00Z", "actor": {"login": "alice"}, "label": {"name": "old"}},
+			{"event": "labeled", "created_at": "2024-01-01T11:00:00Z", "actor": {"login": "alice"}, "label": {"name": "bug"}},
+			{"event": "subscribed", "created_at": "2024-01-01T11:30:00Z", "actor": {"login": "bob"}}
+		]`
+		}
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetIssueEventsSince(context.Background(), "owner", "repo", 7, since)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	assert.Equal(t, "labeled", updates[0].ContentType)
+	assert.Equal(t, []string{"bug"}, updates[0].Labels)
+	assert.Equal(t, "merged", updates[1].ContentType)
+	assert.Equal(t, "bob", updates[1].Author)
+}
+
+func TestGitHubClient_GetIssueEventsSince_StartsFromLastPage(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	const lastPage = 15
+
+	var (
+		server    *httptest.Server
+		requested []string
+	)
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+
+		requested = append(requested, page)
+
+		pageURL := func(n int) string {
+			return fmt.Sprintf("%s/repos/owner/repo/issues/7/events?page=%d", server.URL, n)
+		}
+
+		var number int
+		_, _ = fmt.Sscan(page, &number)
+
+		links := fmt.Sprintf(`<%s>; rel="last"`, pageURL(lastPage))
+		if number > 1 {
+			links += fmt.Sprintf(`, <%s>; rel="prev"`, pageURL(number-1))
+		}
+
+		w.Header().Set("Link", links)
+		w.Header().Set("Content-Type", "application/json")
+
+		// Больше 1000 событий: новое есть только на последней странице.
+		response := `[{"event": "labeled", "created_at": "2023-12-01T10:00:00Z", "actor": {"login": "alice"},
+			"label": {"name": "old"}}]`
+		if number == lastPage {
+			response = `[{"event": "labeled", "created_at": "2024-01-01T09:00:00Z", "actor": {"login": "alice"},
+				"label": {"name": "old"}},
+				{"event": "reopened", "created_at": "2024-01-01T11:00:00Z", "actor": {"login": "bob"}}]`
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("", server.URL, cfg, logger)
+
+	updates, err := client.GetIssueEventsSince(context.Background(), "owner", "repo", 7, since)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	assert.Equal(t, "reopened", updates[0].ContentType)
+	assert.Equal(t, []string{"1", "15"}, requested)
+}
+
+func TestGitHubClient_GetRepositorySnapshots(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var body struct {
+		Query     string            `json:"query"`
+		Variables map[string]string `json:"variables"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/graphql", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "bearer token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `{
+			"data": {
+				"r0": {
+					"pushedAt": "2024-01-02T10:00:00Z",
+					"updatedAt": "2024-01-01T10:00:00Z",
+					"latestRelease": {"publishedAt": "2024-01-04T10:00:00Z"},
+					"issues": {"totalCount": 5},
+					"pullRequests": {"totalCount": 2},
+					"latestIssue": {"nodes": [{"updatedAt": "2024-01-03T10:00:00Z"}]},
+					"latestPullRequest": {"nodes": []}
+				},
+				"r1": null
+			},
+			"errors": [{"message": "Could not resolve to a Repository", "path": ["r1"]}]
+		}`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewGitHubClient("token", server.URL, cfg, logger)
+
+	existing := models.RepositoryRef{Owner: "owner", Name: "repo"}
+	missing := models.RepositoryRef{Owner: "owner", Name: "deleted"}
+
+	snapshots, err := client.GetRepositorySnapshots(context.Background(), []models.RepositoryRef{existing, missing})
+	require.NoError(t, err)
+
+	assert.Contains(t, body.Query, "r0: repository(owner: $o0, name: $n0)")
+	assert.Contains(t, body.Query, "r1: repository(owner: $o1, name: $n1)")
+	assert.Contains(t, body.Query, "latestRelease { publishedAt }")
+	assert.Equal(t, "deleted", body.Variables["n1"])
+
+	require.Len(t, snapshots, 1)
+
+	snapshot := snapshots[existing]
+	require.NotNil(t, snapshot)
+	assert.Equal(t, 5, snapshot.OpenIssues)
+	assert.Equal(t, 2, snapshot.OpenPullRequests)
+	assert.Equal(t, time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC), snapshot.IssuesUpdatedAt)
+	assert.Equal(t, time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC), snapshot.LastActivity())
+}
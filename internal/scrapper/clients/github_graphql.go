@@ -0,0 +1,170 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// graphQLBatchSize ограничивает число репозиториев в одном запросе, чтобы не упираться в лимит сложности GraphQL API.
+const graphQLBatchSize = 50
+
+// repositorySnapshotFields запрашивает, кроме счётчиков и последнего релиза, последний изменённый issue
+// и pull request: новые issues, pull request'ы и комментарии не меняют pushedAt и updatedAt репозитория.
+const repositorySnapshotFields = `pushedAt updatedAt latestRelease { publishedAt } ` +
+	`issues(states: OPEN) { totalCount } pullRequests(states: OPEN) { totalCount } ` +
+	`latestIssue: issues(first: 1, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { updatedAt } } ` +
+	`latestPullRequest: pullRequests(first: 1, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { updatedAt } }`
+
+type graphQLRequest struct {
+	Query     string            `json:"query"`
+	Variables map[string]string `json:"variables"`
+}
+
+type graphQLRepository struct {
+	PushedAt      time.Time `json:"pushedAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	LatestRelease *struct {
+		PublishedAt time.Time `json:"publishedAt"`
+	} `json:"latestRelease"`
+	Issues struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"issues"`
+	PullRequests struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"pullRequests"`
+	LatestIssue       graphQLLatestNodes `json:"latestIssue"`
+	LatestPullRequest graphQLLatestNodes `json:"latestPullRequest"`
+}
+
+// graphQLLatestNodes — список из последнего изменённого issue или pull request.
+type graphQLLatestNodes struct {
+	Nodes []struct {
+		UpdatedAt time.Time `json:"updatedAt"`
+	} `json:"nodes"`
+}
+
+// updatedAt возвращает время изменения элемента списка или нулевое время, если список пуст.
+func (n *graphQLLatestNodes) updatedAt() time.Time {
+	if len(n.Nodes) == 0 {
+		return time.Time{}
+	}
+
+	return n.Nodes[0].UpdatedAt
+}
+
+type graphQLResponse struct {
+	Data   map[string]*graphQLRepository `json:"data"`
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+}
+
+// GetRepositorySnapshots получает состояние нескольких репозиториев через GraphQL API,
+// запрашивая их псевдонимами repository(owner:, name:) в одном запросе на каждые graphQLBatchSize репозиториев.
+// Репозитории, которые API не вернул (удалённые или недоступные), отсутствуют в результате.
+func (c *GitHubClient) GetRepositorySnapshots(ctx context.Context,
+	repos []models.RepositoryRef) (map[models.RepositoryRef]*models.RepositorySnapshot, error) {
+	snapshots := make(map[models.RepositoryRef]*models.RepositorySnapshot, len(repos))
+
+	for start := 0; start < len(repos); start += graphQLBatchSize {
+		end := min(start+graphQLBatchSize, len(repos))
+
+		if err := c.fetchRepositorySnapshots(ctx, repos[start:end], snapshots); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (c *GitHubClient) fetchRepositorySnapshots(ctx context.Context, repos []models.RepositoryRef,
+	snapshots map[models.RepositoryRef]*models.RepositorySnapshot) error {
+	request := buildRepositoriesQuery(repos)
+
+	httpRequest := c.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json")
+
+	if c.token != "" {
+		httpRequest.SetHeader("Authorization", "bearer "+c.token)
+	}
+
+	var response graphQLResponse
+
+	resp, err := httpRequest.
+		SetBody(request).
+		SetResult(&response).
+		Post(c.baseURL + "/graphql")
+
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("GitHub GraphQL API вернул статус: %d", resp.StatusCode())
+	}
+
+	for _, graphQLErr := range response.Errors {
+		c.logger.Warn("GitHub GraphQL API не вернул репозиторий",
+			"path", strings.Join(graphQLErr.Path, "."),
+			"error", graphQLErr.Message,
+		)
+	}
+
+	for i, ref := range repos {
+		repository := response.Data[fmt.Sprintf("r%d", i)]
+		if repository == nil {
+			continue
+		}
+
+		issuesUpdatedAt := repository.LatestIssue.updatedAt()
+		if pullUpdatedAt := repository.LatestPullRequest.updatedAt(); pullUpdatedAt.After(issuesUpdatedAt) {
+			issuesUpdatedAt = pullUpdatedAt
+		}
+
+		snapshot := &models.RepositorySnapshot{
+			PushedAt:         repository.PushedAt,
+			UpdatedAt:        repository.UpdatedAt,
+			IssuesUpdatedAt:  issuesUpdatedAt,
+			OpenIssues:       repository.Issues.TotalCount,
+			OpenPullRequests: repository.PullRequests.TotalCount,
+		}
+
+		if repository.LatestRelease != nil {
+			snapshot.LatestReleaseAt = repository.LatestRelease.PublishedAt
+		}
+
+		snapshots[ref] = snapshot
+	}
+
+	return nil
+}
+
+// buildRepositoriesQuery собирает запрос с псевдонимом rN для каждого репозитория.
+// Владелец и имя передаются переменными, чтобы не экранировать их внутри текста запроса.
+func buildRepositoriesQuery(repos []models.RepositoryRef) graphQLRequest {
+	params := make([]string, 0, len(repos)*2)
+	nodes := make([]string, 0, len(repos))
+	variables := make(map[string]string, len(repos)*2)
+
+	for i, ref := range repos {
+		owner, name := fmt.Sprintf("o%d", i), fmt.Sprintf("n%d", i)
+
+		params = append(params, fmt.Sprintf("$%s: String!, $%s: String!", owner, name))
+		nodes = append(nodes, fmt.Sprintf("r%d: repository(owner: $%s, name: $%s) { %s }",
+			i, owner, name, repositorySnapshotFields))
+
+		variables[owner] = ref.Owner
+		variables[name] = ref.Name
+	}
+
+	return graphQLRequest{
+		Query:     fmt.Sprintf("query(%s) { %s }", strings.Join(params, ", "), strings.Join(nodes, " ")),
+		Variables: variables,
+	}
+}
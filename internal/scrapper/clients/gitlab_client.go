@@ -0,0 +1,256 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+)
+
+type GitLabClient struct {
+	client  *resty.Client
+	token   string
+	baseURL string
+	logger  *slog.Logger
+}
+
+type ProjectUpdateGetter interface {
+	GetProjectLastActivity(ctx context.Context, project string) (time.Time, error)
+	GetProjectDetails(ctx context.Context, project string) (*models.ContentDetails, error)
+	GetMergeRequestsSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+	GetIssuesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+	GetReleasesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error)
+}
+
+// NewGitLabClient создаёт клиент GitLab API v4. baseURL указывает на /api/v4 конкретной инсталляции,
+// пустое значение означает gitlab.com.
+func NewGitLabClient(token, baseURL string, cfg *config.Config, logger *slog.Logger) ProjectUpdateGetter {
+	if baseURL == "" {
+		baseURL = "https://gitlab.com/api/v4"
+	}
+
+	client := httputil.CreateResilientHTTPClient(cfg, logger, "gitlab")
+
+	return &GitLabClient{
+		client:  client,
+		token:   token,
+		baseURL: baseURL,
+		logger:  logger,
+	}
+}
+
+type Project struct {
+	PathWithNamespace string    `json:"path_with_namespace"`
+	NameWithNamespace string    `json:"name_with_namespace"`
+	Description       string    `json:"description"`
+	LastActivityAt    time.Time `json:"last_activity_at"`
+	Namespace         struct {
+		Name string `json:"name"`
+	} `json:"namespace"`
+}
+
+// ProjectItem описывает merge request или issue: в GitLab API у них совпадает набор нужных нам полей.
+type ProjectItem struct {
+	IID         int       `json:"iid"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	State       string    `json:"state"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Labels      []string  `json:"labels"`
+	Author      struct {
+		Username string `json:"username"`
+	} `json:"author"`
+}
+
+type ProjectRelease struct {
+	TagName         string    `json:"tag_name"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	CreatedAt       time.Time `json:"created_at"`
+	ReleasedAt      time.Time `json:"released_at"`
+	UpcomingRelease bool      `json:"upcoming_release"`
+	Author          struct {
+		Username string `json:"username"`
+	} `json:"author"`
+}
+
+func (c *GitLabClient) GetProjectLastActivity(ctx context.Context, project string) (time.Time, error) {
+	var p Project
+	if err := c.getJSON(ctx, c.projectURL(project, ""), nil, &p); err != nil {
+		return time.Time{}, err
+	}
+
+	return p.LastActivityAt, nil
+}
+
+func (c *GitLabClient) GetProjectDetails(ctx context.Context, project string) (*models.ContentDetails, error) {
+	var p Project
+	if err := c.getJSON(ctx, c.projectURL(project, ""), nil, &p); err != nil {
+		return nil, err
+	}
+
+	title := p.NameWithNamespace
+	if title == "" {
+		title = p.PathWithNamespace
+	}
+
+	return &models.ContentDetails{
+		Title:       title,
+		Author:      p.Namespace.Name,
+		UpdatedAt:   p.LastActivityAt,
+		ContentText: p.Description,
+		LinkType:    models.GitLab,
+	}, nil
+}
+
+// GetMergeRequestsSince возвращает merge requests, изменённые после since, в хронологическом порядке.
+func (c *GitLabClient) GetMergeRequestsSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error) {
+	var items []ProjectItem
+	if err := c.getJSON(ctx, c.projectURL(project, "merge_requests"), updatedAfterParams(since), &items); err != nil {
+		return nil, err
+	}
+
+	return projectItemsToUpdateInfo(items, since, "!", "merge_request"), nil
+}
+
+// GetIssuesSince возвращает issues, изменённые после since, в хронологическом порядке.
+func (c *GitLabClient) GetIssuesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error) {
+	var items []ProjectItem
+	if err := c.getJSON(ctx, c.projectURL(project, "issues"), updatedAfterParams(since), &items); err != nil {
+		return nil, err
+	}
+
+	return projectItemsToUpdateInfo(items, since, "#", "gitlab_issue"), nil
+}
+
+// GetReleasesSince возвращает релизы, выпущенные после since, в хронологическом порядке.
+// Запланированные релизы (upcoming_release) пропускаются до наступления даты выпуска.
+func (c *GitLabClient) GetReleasesSince(ctx context.Context, project string, since time.Time) ([]*models.UpdateInfo, error) {
+	params := map[string]string{
+		"order_by": "released_at",
+		"sort":     "desc",
+		"per_page": "30",
+	}
+
+	var releases []ProjectRelease
+	if err := c.getJSON(ctx, c.projectURL(project, "releases"), params, &releases); err != nil {
+		return nil, err
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(releases))
+
+	for i := len(releases) - 1; i >= 0; i-- {
+		release := &releases[i]
+
+		releasedAt := release.ReleasedAt
+		if releasedAt.IsZero() {
+			releasedAt = release.CreatedAt
+		}
+
+		if release.UpcomingRelease || !releasedAt.After(since) {
+			continue
+		}
+
+		title := release.TagName
+		if release.Name != "" && release.Name != release.TagName {
+			title = fmt.Sprintf("%s — %s", release.TagName, release.Name)
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Title:       title,
+			Author:      release.Author.Username,
+			UpdatedAt:   releasedAt,
+			ContentType: "gitlab_release",
+			TextPreview: models.TextPreview(release.Description, 200),
+			FullText:    release.Description,
+		})
+	}
+
+	return updates, nil
+}
+
+// projectURL строит адрес ресурса проекта. Путь проекта передаётся в API целиком,
+// поэтому разделители групп необходимо экранировать.
+func (c *GitLabClient) projectURL(project, resource string) string {
+	projectURL := fmt.Sprintf("%s/projects/%s", c.baseURL, url.PathEscape(project))
+	if resource != "" {
+		projectURL += "/" + resource
+	}
+
+	return projectURL
+}
+
+func (c *GitLabClient) getJSON(ctx context.Context, url string, params map[string]string, result any) error {
+	request := c.client.R().
+		SetContext(ctx).
+		SetQueryParams(params)
+
+	if c.token != "" {
+		request.SetHeader("PRIVATE-TOKEN", c.token)
+	}
+
+	resp, err := request.
+		SetResult(result).
+		Get(url)
+
+	if err != nil {
+		return err
+	}
+
+	if httputil.IsGone(resp) {
+		return &customerrors.ErrResourceGone{URL: url}
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("GitLab API вернул статус: %d", resp.StatusCode())
+	}
+
+	return nil
+}
+
+func updatedAfterParams(since time.Time) map[string]string {
+	params := map[string]string{
+		"state":    "all",
+		"scope":    "all",
+		"order_by": "updated_at",
+		"sort":     "asc",
+		"per_page": "100",
+	}
+
+	if !since.IsZero() {
+		params["updated_after"] = since.UTC().Format(time.RFC3339)
+	}
+
+	return params
+}
+
+func projectItemsToUpdateInfo(items []ProjectItem, since time.Time, refPrefix, contentType string) []*models.UpdateInfo {
+	updates := make([]*models.UpdateInfo, 0, len(items))
+
+	for i := range items {
+		item := &items[i]
+
+		if !since.IsZero() && !item.UpdatedAt.After(since) {
+			continue
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Title:       fmt.Sprintf("%s%d %s", refPrefix, item.IID, item.Title),
+			Author:      item.Author.Username,
+			UpdatedAt:   item.UpdatedAt,
+			ContentType: contentType,
+			TextPreview: models.TextPreview(item.Description, 200),
+			FullText:    item.Description,
+			Labels:      item.Labels,
+		})
+	}
+
+	return updates
+}
@@ -0,0 +1,142 @@
+package clients_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabClient_GetProjectLastActivity(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/group%2Fsubgroup%2Fproject", r.URL.EscapedPath())
+		assert.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `{"path_with_namespace": "group/subgroup/project", "last_activity_at": "2024-01-01T10:00:00Z"}`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitLabClient("secret", server.URL+"/api/v4", cfg, logger)
+
+	lastActivity, err := client.GetProjectLastActivity(context.Background(), "group/subgroup/project")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), lastActivity.UTC())
+}
+
+func TestGitLabClient_GetMergeRequestsSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/group%2Fproject/merge_requests", r.URL.EscapedPath())
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("updated_after"))
+		assert.Equal(t, "all", r.URL.Query().Get("state"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[
+			{"iid": 3, "title": "Old MR", "updated_at": "2024-01-01T10:00:00Z", "author": {"username": "alice"}},
+			{"iid": 4, "title": "Fix build", "description": "Pipeline fix", "updated_at": "2024-01-01T11:00:00Z",
+			 "labels": ["ci"], "author": {"username": "bob"}}
+		]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitLabClient("", server.URL+"/api/v4", cfg, logger)
+
+	updates, err := client.GetMergeRequestsSince(context.Background(), "group/project", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	assert.Equal(t, "!4 Fix build", updates[0].Title)
+	assert.Equal(t, "bob", updates[0].Author)
+	assert.Equal(t, "merge_request", updates[0].ContentType)
+	assert.Equal(t, "Pipeline fix", updates[0].TextPreview)
+	assert.Equal(t, []string{"ci"}, updates[0].Labels)
+}
+
+func TestGitLabClient_GetReleasesSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/group%2Fproject/releases", r.URL.EscapedPath())
+
+		w.Header().Set("Content-Type", "application/json")
+
+		response := `[
+			{"tag_name": "v2.0.0", "released_at": "2024-02-01T00:00:00Z", "upcoming_release": true},
+			{"tag_name": "v1.1.0", "name": "Spring", "description": "Notes",
+			 "released_at": "2024-01-02T00:00:00Z", "author": {"username": "alice"}},
+			{"tag_name": "v1.0.0", "released_at": "2023-12-01T00:00:00Z"}
+		]`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewGitLabClient("", server.URL+"/api/v4", cfg, logger)
+
+	updates, err := client.GetReleasesSince(context.Background(), "group/project", since)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	assert.Equal(t, "v1.1.0 — Spring", updates[0].Title)
+	assert.Equal(t, "alice", updates[0].Author)
+	assert.Equal(t, "gitlab_release", updates[0].ContentType)
+}
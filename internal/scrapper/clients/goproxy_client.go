@@ -0,0 +1,126 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// GoProxyClient получает версии Go-модулей через GOPROXY-протокол.
+type GoProxyClient struct {
+	client  *resty.Client
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewGoProxyClient создаёт клиент модульного прокси. Пустой baseURL означает proxy.golang.org.
+func NewGoProxyClient(baseURL string, cfg *config.Config, logger *slog.Logger) PackageVersionGetter {
+	if baseURL == "" {
+		baseURL = "https://proxy.golang.org"
+	}
+
+	return &GoProxyClient{
+		client:  httputil.CreateResilientHTTPClient(cfg, logger, "goproxy"),
+		baseURL: baseURL,
+		logger:  logger,
+	}
+}
+
+// GetVersions возвращает релизные версии модуля по возрастанию semver. Время публикации прокси сообщает
+// только для последней версии, поэтому у остальных оно нулевое.
+// pkg.go.dev показывает и пакеты внутри модуля, поэтому модуль ищется от полного пути к родительским.
+func (c *GoProxyClient) GetVersions(ctx context.Context, path string) ([]models.PackageVersion, error) {
+	for {
+		versions, err := c.moduleVersions(ctx, path)
+		if !stderrors.Is(err, &customerrors.ErrResourceGone{}) {
+			return versions, err
+		}
+
+		i := strings.LastIndex(path, "/")
+		if i < 0 {
+			return nil, err
+		}
+
+		path = path[:i]
+	}
+}
+
+func (c *GoProxyClient) moduleVersions(ctx context.Context, path string) ([]models.PackageVersion, error) {
+	escaped, err := module.EscapePath(path)
+	if err != nil {
+		return nil, &customerrors.ErrInvalidURL{URL: path}
+	}
+
+	moduleURL := c.baseURL + "/" + escaped
+
+	body, err := c.get(ctx, moduleURL+"/@latest")
+	if err != nil {
+		return nil, err
+	}
+
+	var latest struct {
+		Version string    `json:"Version"`
+		Time    time.Time `json:"Time"`
+	}
+
+	if err := json.Unmarshal(body, &latest); err != nil {
+		return nil, fmt.Errorf("ошибка разбора ответа модульного прокси: %w", err)
+	}
+
+	body, err = c.get(ctx, moduleURL+"/@v/list")
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]string, 0)
+
+	for _, version := range strings.Fields(string(body)) {
+		if semver.IsValid(version) && version != latest.Version {
+			list = append(list, version)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return semver.Compare(list[i], list[j]) < 0
+	})
+
+	versions := make([]models.PackageVersion, 0, len(list)+1)
+	for _, version := range list {
+		versions = append(versions, models.PackageVersion{Version: version})
+	}
+
+	return append(versions, models.PackageVersion{Version: latest.Version, PublishedAt: latest.Time}), nil
+}
+
+func (c *GoProxyClient) get(ctx context.Context, url string) ([]byte, error) {
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: url}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("модульный прокси вернул статус: %d", resp.StatusCode())
+	}
+
+	return resp.Body(), nil
+}
@@ -4,9 +4,13 @@ package mocks
 
 import (
 	context "context"
-	time "time"
 
+	httputil "github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+
+	time "time"
 )
 
 // QuestionUpdateGetter is an autogenerated mock type for the QuestionUpdateGetter type
@@ -14,9 +18,99 @@ type QuestionUpdateGetter struct {
 	mock.Mock
 }
 
-// GetQuestionLastUpdate provides a mock function with given fields: ctx, questionID
-func (_m *QuestionUpdateGetter) GetQuestionLastUpdate(ctx context.Context, questionID int64) (time.Time, error) {
-	ret := _m.Called(ctx, questionID)
+// GetAnswersSince provides a mock function with given fields: ctx, site, questionID, since
+func (_m *QuestionUpdateGetter) GetAnswersSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, site, questionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnswersSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, site, questionID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, site, questionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, site, questionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommentsSince provides a mock function with given fields: ctx, site, questionID, since
+func (_m *QuestionUpdateGetter) GetCommentsSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, site, questionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentsSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, site, questionID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, site, questionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Time) error); ok {
+		r1 = rf(ctx, site, questionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuestionDetails provides a mock function with given fields: ctx, site, questionID
+func (_m *QuestionUpdateGetter) GetQuestionDetails(ctx context.Context, site string, questionID int64) (*models.ContentDetails, error) {
+	ret := _m.Called(ctx, site, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionDetails")
+	}
+
+	var r0 *models.ContentDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.ContentDetails, error)); ok {
+		return rf(ctx, site, questionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.ContentDetails); ok {
+		r0 = rf(ctx, site, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContentDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, site, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuestionLastUpdate provides a mock function with given fields: ctx, site, questionID
+func (_m *QuestionUpdateGetter) GetQuestionLastUpdate(ctx context.Context, site string, questionID int64) (time.Time, error) {
+	ret := _m.Called(ctx, site, questionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetQuestionLastUpdate")
@@ -24,17 +118,47 @@ func (_m *QuestionUpdateGetter) GetQuestionLastUpdate(ctx context.Context, quest
 
 	var r0 time.Time
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (time.Time, error)); ok {
-		return rf(ctx, questionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (time.Time, error)); ok {
+		return rf(ctx, site, questionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) time.Time); ok {
-		r0 = rf(ctx, questionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) time.Time); ok {
+		r0 = rf(ctx, site, questionID)
 	} else {
 		r0 = ret.Get(0).(time.Time)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, questionID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, site, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuestionsLastUpdate provides a mock function with given fields: ctx, site, questionIDs
+func (_m *QuestionUpdateGetter) GetQuestionsLastUpdate(ctx context.Context, site string, questionIDs []int64) (map[int64]time.Time, error) {
+	ret := _m.Called(ctx, site, questionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionsLastUpdate")
+	}
+
+	var r0 map[int64]time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int64) (map[int64]time.Time, error)); ok {
+		return rf(ctx, site, questionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int64) map[int64]time.Time); ok {
+		r0 = rf(ctx, site, questionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []int64) error); ok {
+		r1 = rf(ctx, site, questionIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,6 +166,24 @@ func (_m *QuestionUpdateGetter) GetQuestionLastUpdate(ctx context.Context, quest
 	return r0, r1
 }
 
+// RateLimit provides a mock function with no fields
+func (_m *QuestionUpdateGetter) RateLimit() httputil.RateLimitStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateLimit")
+	}
+
+	var r0 httputil.RateLimitStatus
+	if rf, ok := ret.Get(0).(func() httputil.RateLimitStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(httputil.RateLimitStatus)
+	}
+
+	return r0
+}
+
 // NewQuestionUpdateGetter creates a new instance of QuestionUpdateGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuestionUpdateGetter(t interface {
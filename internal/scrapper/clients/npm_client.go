@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+)
+
+// NPMClient получает версии пакетов из реестра npm.
+type NPMClient struct {
+	client  *resty.Client
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewNPMClient создаёт клиент реестра npm. Пустой baseURL означает registry.npmjs.org.
+func NewNPMClient(baseURL string, cfg *config.Config, logger *slog.Logger) PackageVersionGetter {
+	if baseURL == "" {
+		baseURL = "https://registry.npmjs.org"
+	}
+
+	return &NPMClient{
+		client:  httputil.CreateResilientHTTPClient(cfg, logger, "npm"),
+		baseURL: baseURL,
+		logger:  logger,
+	}
+}
+
+// GetVersions возвращает версии пакета по времени публикации из поля time документа пакета.
+// Имя пакета с областью видимости (@scope/name) передаётся одним сегментом пути.
+func (c *NPMClient) GetVersions(ctx context.Context, name string) ([]models.PackageVersion, error) {
+	packageURL := c.baseURL + "/" + url.PathEscape(name)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		Get(packageURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: packageURL}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("реестр npm вернул статус: %d", resp.StatusCode())
+	}
+
+	var document struct {
+		Time     map[string]json.RawMessage `json:"time"`
+		Versions map[string]json.RawMessage `json:"versions"`
+	}
+
+	if err := json.Unmarshal(resp.Body(), &document); err != nil {
+		return nil, fmt.Errorf("ошибка разбора ответа реестра npm: %w", err)
+	}
+
+	// Снятый с публикации пакет остаётся в реестре без версий, с отметкой unpublished.
+	if _, ok := document.Time["unpublished"]; ok {
+		return nil, &customerrors.ErrResourceGone{URL: packageURL}
+	}
+
+	versions := make([]models.PackageVersion, 0, len(document.Versions))
+
+	for version := range document.Versions {
+		var publishedAt time.Time
+		if err := json.Unmarshal(document.Time[version], &publishedAt); err != nil {
+			continue
+		}
+
+		versions = append(versions, models.PackageVersion{Version: version, PublishedAt: publishedAt})
+	}
+
+	sortByPublication(versions)
+
+	return versions, nil
+}
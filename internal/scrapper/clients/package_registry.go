@@ -0,0 +1,21 @@
+package clients
+
+import (
+	"context"
+	"sort"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+)
+
+// PackageVersionGetter получает историю версий пакета из реестра.
+type PackageVersionGetter interface {
+	// GetVersions возвращает опубликованные версии пакета в порядке публикации: последней идёт текущая версия.
+	GetVersions(ctx context.Context, name string) ([]models.PackageVersion, error)
+}
+
+// sortByPublication упорядочивает версии по времени публикации, сохраняя исходный порядок при совпадении.
+func sortByPublication(versions []models.PackageVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].PublishedAt.Before(versions[j].PublishedAt)
+	})
+}
@@ -0,0 +1,165 @@
+package clients_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPackageRegistryTestConfig() *config.Config {
+	return &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+}
+
+func writeTestResponse(t *testing.T, w http.ResponseWriter, body string) {
+	t.Helper()
+
+	if _, err := w.Write([]byte(body)); err != nil {
+		t.Errorf("Failed to write response: %v", err)
+	}
+}
+
+func TestGoProxyClient_GetVersions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/!azure/sdk/@latest":
+			writeTestResponse(t, w, `{"Version": "v1.10.0", "Time": "2024-01-02T10:00:00Z"}`)
+		case "/github.com/!azure/sdk/@v/list":
+			writeTestResponse(t, w, "v1.2.0\nv1.10.0\nv1.9.1\nbad\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := clients.NewGoProxyClient(server.URL, newPackageRegistryTestConfig(), logger)
+
+	versions, err := client.GetVersions(context.Background(), "github.com/Azure/sdk/storage")
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.PackageVersion{
+		{Version: "v1.2.0"},
+		{Version: "v1.9.1"},
+		{Version: "v1.10.0", PublishedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)},
+	}, versions)
+
+	_, err = client.GetVersions(context.Background(), "example.com/missing")
+	assert.ErrorIs(t, err, &customerrors.ErrResourceGone{})
+}
+
+func TestNPMClient_GetVersions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.EscapedPath() {
+		case "/@scope%2Flib":
+			writeTestResponse(t, w, `{
+				"time": {
+					"created": "2024-01-01T09:00:00Z",
+					"modified": "2024-01-03T09:00:00Z",
+					"1.0.0": "2024-01-01T10:00:00Z",
+					"1.1.0": "2024-01-02T10:00:00Z"
+				},
+				"versions": {"1.1.0": {}, "1.0.0": {}}
+			}`)
+		case "/removed":
+			writeTestResponse(t, w, `{"time": {"unpublished": {"time": "2024-01-01T10:00:00Z"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := clients.NewNPMClient(server.URL, newPackageRegistryTestConfig(), logger)
+
+	versions, err := client.GetVersions(context.Background(), "@scope/lib")
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.PackageVersion{
+		{Version: "1.0.0", PublishedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Version: "1.1.0", PublishedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)},
+	}, versions)
+
+	_, err = client.GetVersions(context.Background(), "removed")
+	assert.ErrorIs(t, err, &customerrors.ErrResourceGone{})
+}
+
+func TestPyPIClient_GetVersions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/pypi/requests/json", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		writeTestResponse(t, w, `{"releases": {
+			"2.0.0": [
+				{"upload_time_iso_8601": "2024-01-02T12:00:00Z", "yanked": false},
+				{"upload_time_iso_8601": "2024-01-02T10:00:00Z", "yanked": false}
+			],
+			"1.0.0": [{"upload_time_iso_8601": "2024-01-01T10:00:00Z", "yanked": false}],
+			"1.5.0": [{"upload_time_iso_8601": "2024-01-01T15:00:00Z", "yanked": true}],
+			"0.1.0": []
+		}}`)
+	}))
+	defer server.Close()
+
+	client := clients.NewPyPIClient(server.URL, newPackageRegistryTestConfig(), logger)
+
+	versions, err := client.GetVersions(context.Background(), "requests")
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.PackageVersion{
+		{Version: "1.0.0", PublishedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Version: "2.0.0", PublishedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)},
+	}, versions)
+}
+
+func TestCratesClient_GetVersions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/crates/serde/versions", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("User-Agent"))
+
+		w.Header().Set("Content-Type", "application/json")
+		writeTestResponse(t, w, `{"versions": [
+			{"num": "1.0.1", "created_at": "2024-01-02T10:00:00Z", "yanked": false},
+			{"num": "1.0.2", "created_at": "2024-01-03T10:00:00Z", "yanked": true},
+			{"num": "1.0.0", "created_at": "2024-01-01T10:00:00Z", "yanked": false}
+		]}`)
+	}))
+	defer server.Close()
+
+	client := clients.NewCratesClient(server.URL, newPackageRegistryTestConfig(), logger)
+
+	versions, err := client.GetVersions(context.Background(), "serde")
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.PackageVersion{
+		{Version: "1.0.0", PublishedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Version: "1.0.1", PublishedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)},
+	}, versions)
+}
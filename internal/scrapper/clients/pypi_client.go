@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+)
+
+// PyPIClient получает версии пакетов из PyPI через JSON API.
+type PyPIClient struct {
+	client  *resty.Client
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewPyPIClient создаёт клиент PyPI. Пустой baseURL означает pypi.org.
+func NewPyPIClient(baseURL string, cfg *config.Config, logger *slog.Logger) PackageVersionGetter {
+	if baseURL == "" {
+		baseURL = "https://pypi.org"
+	}
+
+	return &PyPIClient{
+		client:  httputil.CreateResilientHTTPClient(cfg, logger, "pypi"),
+		baseURL: baseURL,
+		logger:  logger,
+	}
+}
+
+type pypiFile struct {
+	UploadTime time.Time `json:"upload_time_iso_8601"`
+	Yanked     bool      `json:"yanked"`
+}
+
+// GetVersions возвращает версии пакета по времени публикации. Временем публикации версии считается
+// загрузка её первого файла; версии без файлов и полностью отозванные (yanked) пропускаются.
+func (c *PyPIClient) GetVersions(ctx context.Context, name string) ([]models.PackageVersion, error) {
+	packageURL := fmt.Sprintf("%s/pypi/%s/json", c.baseURL, url.PathEscape(name))
+
+	var response struct {
+		Releases map[string][]pypiFile `json:"releases"`
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		Get(packageURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: packageURL}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("PyPI вернул статус: %d", resp.StatusCode())
+	}
+
+	versions := make([]models.PackageVersion, 0, len(response.Releases))
+
+	for version, files := range response.Releases {
+		var publishedAt time.Time
+
+		for _, file := range files {
+			if !file.Yanked && (publishedAt.IsZero() || file.UploadTime.Before(publishedAt)) {
+				publishedAt = file.UploadTime
+			}
+		}
+
+		if publishedAt.IsZero() {
+			continue
+		}
+
+		versions = append(versions, models.PackageVersion{Version: version, PublishedAt: publishedAt})
+	}
+
+	sortByPublication(versions)
+
+	return versions, nil
+}
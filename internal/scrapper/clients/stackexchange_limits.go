@@ -0,0 +1,148 @@
+package clients
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/go-resty/resty/v2"
+)
+
+const stackOverflowService = "stackoverflow"
+
+// throttleViolationID — error_id, с которым StackExchange API отклоняет запросы сверх лимита или квоты.
+const throttleViolationID = 502
+
+// defaultThrottlePause используется, если в сообщении throttle_violation не указано время ожидания.
+const defaultThrottlePause = time.Minute
+
+var throttleSecondsPattern = regexp.MustCompile(`(\d+) seconds`)
+
+// stackExchangeWrapper содержит общие поля обёртки любого ответа StackExchange API.
+type stackExchangeWrapper struct {
+	Backoff        int    `json:"backoff"`
+	QuotaMax       int    `json:"quota_max"`
+	QuotaRemaining *int   `json:"quota_remaining"`
+	ErrorID        int    `json:"error_id"`
+	ErrorName      string `json:"error_name"`
+	ErrorMessage   string `json:"error_message"`
+}
+
+// stackExchangeLimits соблюдает ограничения StackExchange API, общие для всех воркеров одного клиента.
+// Поле backoff запрещает повторно вызывать тот же метод API указанное число секунд,
+// а throttle_violation и исчерпанная дневная квота останавливают все запросы.
+type stackExchangeLimits struct {
+	mu      sync.Mutex
+	backoff map[string]time.Time
+	quota   *httputil.RateLimitTracker
+}
+
+func newStackExchangeLimits(quota *httputil.RateLimitTracker) *stackExchangeLimits {
+	return &stackExchangeLimits{
+		backoff: make(map[string]time.Time),
+		quota:   quota,
+	}
+}
+
+// attach подключает соблюдение ограничений к HTTP-клиенту.
+func (l *stackExchangeLimits) attach(client *resty.Client) {
+	httputil.TrackRateLimit(client, l.quota, stackOverflowService)
+
+	client.OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+		if until, ok := l.backoffUntil(stackExchangeMethod(r.URL), time.Now()); ok {
+			return &customerrors.ErrRateLimited{Service: stackOverflowService, ResetAt: until}
+		}
+
+		return nil
+	})
+
+	client.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		return l.observe(stackExchangeMethod(resp.Request.URL), resp.Body(), time.Now())
+	})
+}
+
+func (l *stackExchangeLimits) backoffUntil(method string, now time.Time) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until, ok := l.backoff[method]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	if !now.Before(until) {
+		delete(l.backoff, method)
+		return time.Time{}, false
+	}
+
+	return until, true
+}
+
+// observe учитывает квоту, backoff и ошибку из тела ответа метода method.
+// Дневная квота сбрасывается в полночь UTC.
+func (l *stackExchangeLimits) observe(method string, body []byte, now time.Time) error {
+	var wrapper stackExchangeWrapper
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil
+	}
+
+	if wrapper.QuotaRemaining != nil {
+		midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		l.quota.Record(wrapper.QuotaMax, *wrapper.QuotaRemaining, midnight)
+	}
+
+	if wrapper.Backoff > 0 {
+		l.mu.Lock()
+		l.backoff[method] = now.Add(time.Duration(wrapper.Backoff) * time.Second)
+		l.mu.Unlock()
+	}
+
+	if wrapper.ErrorID == 0 {
+		return nil
+	}
+
+	if wrapper.ErrorID == throttleViolationID {
+		resumeAt := now.Add(throttlePause(wrapper.ErrorMessage))
+		l.quota.Pause(resumeAt)
+
+		return &customerrors.ErrRateLimited{Service: stackOverflowService, ResetAt: resumeAt}
+	}
+
+	return &customerrors.ErrStackExchangeAPI{
+		ID:      wrapper.ErrorID,
+		Name:    wrapper.ErrorName,
+		Message: wrapper.ErrorMessage,
+	}
+}
+
+// throttlePause извлекает время ожидания из сообщения вида "more requests available in 82639 seconds".
+func throttlePause(message string) time.Duration {
+	match := throttleSecondsPattern.FindStringSubmatch(message)
+	if match == nil {
+		return defaultThrottlePause
+	}
+
+	seconds, err := strconv.Atoi(match[1])
+	if err != nil {
+		return defaultThrottlePause
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// stackExchangeMethod возвращает метод API по URL запроса — полный путь вместе с ID,
+// поэтому backoff одного запроса не блокирует вызовы того же метода для других вопросов.
+func stackExchangeMethod(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	return strings.Trim(parsed.Path, "/")
+}
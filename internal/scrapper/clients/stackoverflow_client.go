@@ -4,24 +4,39 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/go-resty/resty/v2"
 )
 
+// maxQuestionIDsPerRequest — столько ID StackExchange API принимает в одном запросе /questions/{ids}.
+const maxQuestionIDsPerRequest = 100
+
 type StackOverflowClient struct {
-	client  *resty.Client
-	baseURL string
-	key     string
-	logger  *slog.Logger
+	client    *resty.Client
+	baseURL   string
+	key       string
+	logger    *slog.Logger
+	rateLimit *httputil.RateLimitTracker
 }
 
 type QuestionUpdateGetter interface {
-	GetQuestionLastUpdate(ctx context.Context, questionID int64) (time.Time, error)
-	GetQuestionDetails(ctx context.Context, questionID int64) (*models.ContentDetails, error)
+	GetQuestionLastUpdate(ctx context.Context, site string, questionID int64) (time.Time, error)
+	// GetQuestionsLastUpdate возвращает время последней активности нескольких вопросов сайта,
+	// запрашивая их пачками по 100 ID. Удалённые вопросы отсутствуют в результате.
+	GetQuestionsLastUpdate(ctx context.Context, site string, questionIDs []int64) (map[int64]time.Time, error)
+	GetQuestionDetails(ctx context.Context, site string, questionID int64) (*models.ContentDetails, error)
+	GetAnswersSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error)
+	GetCommentsSince(ctx context.Context, site string, questionID int64, since time.Time) ([]*models.UpdateInfo, error)
+	// RateLimit возвращает остаток дневной квоты StackExchange API и паузу после throttle_violation.
+	// Окно backoff действует только для вызванного метода: повторный вызов до его окончания вернёт ErrRateLimited.
+	RateLimit() httputil.RateLimitStatus
 }
 
 func NewStackOverflowClient(key, baseURL string, cfg *config.Config, logger *slog.Logger) QuestionUpdateGetter {
@@ -29,16 +44,24 @@ func NewStackOverflowClient(key, baseURL string, cfg *config.Config, logger *slo
 		baseURL = "https://api.stackexchange.com/2.3"
 	}
 
-	client := httputil.CreateResilientHTTPClient(cfg, logger, "stackoverflow")
+	client := httputil.CreateResilientHTTPClient(cfg, logger, stackOverflowService)
+
+	rateLimit := httputil.NewRateLimitTracker()
+	newStackExchangeLimits(rateLimit).attach(client)
 
 	return &StackOverflowClient{
-		client:  client,
-		baseURL: baseURL,
-		key:     key,
-		logger:  logger,
+		client:    client,
+		baseURL:   baseURL,
+		key:       key,
+		logger:    logger,
+		rateLimit: rateLimit,
 	}
 }
 
+func (c *StackOverflowClient) RateLimit() httputil.RateLimitStatus {
+	return c.rateLimit.RateLimit()
+}
+
 type StackOverflowResponse struct {
 	Items []Question `json:"items"`
 }
@@ -52,17 +75,28 @@ type Question struct {
 	} `json:"owner"`
 }
 
-func (c *StackOverflowClient) GetQuestionLastUpdate(ctx context.Context, questionID int64) (time.Time, error) {
+// Post описывает ответ или комментарий к вопросу.
+type Post struct {
+	CreationDate int64  `json:"creation_date"`
+	Body         string `json:"body"`
+	Owner        struct {
+		DisplayName string `json:"display_name"`
+	} `json:"owner"`
+}
+
+func (c *StackOverflowClient) GetQuestionLastUpdate(ctx context.Context, site string, questionID int64) (time.Time, error) {
 	url := fmt.Sprintf("%s/questions/%d", c.baseURL, questionID)
 
 	request := c.client.R().
 		SetContext(ctx).
-		SetQueryParam("site", "stackoverflow")
+		SetQueryParam("site", site)
 
 	if c.key != "" {
 		request.SetQueryParam("key", c.key)
 	}
 
+	httputil.SetConditionalHeaders(ctx, request)
+
 	var response struct {
 		Items []struct {
 			LastActivityDate int64 `json:"last_activity_date"`
@@ -77,12 +111,19 @@ func (c *StackOverflowClient) GetQuestionLastUpdate(ctx context.Context, questio
 		return time.Time{}, err
 	}
 
+	if httputil.IsNotModified(resp) {
+		return time.Time{}, &customerrors.ErrNotModified{URL: url}
+	}
+
 	if !resp.IsSuccess() {
 		return time.Time{}, fmt.Errorf("StackOverflow API вернул статус: %d", resp.StatusCode())
 	}
 
+	httputil.StoreValidators(ctx, resp)
+
+	// Удалённый вопрос API не возвращает, отвечая пустым списком.
 	if len(response.Items) == 0 {
-		return time.Time{}, fmt.Errorf("вопрос с ID %d не найден", questionID)
+		return time.Time{}, &customerrors.ErrResourceGone{URL: url}
 	}
 
 	lastUpdate := time.Unix(response.Items[0].LastActivityDate, 0)
@@ -90,12 +131,60 @@ func (c *StackOverflowClient) GetQuestionLastUpdate(ctx context.Context, questio
 	return lastUpdate, nil
 }
 
-func (c *StackOverflowClient) GetQuestionDetails(ctx context.Context, questionID int64) (*models.ContentDetails, error) {
+func (c *StackOverflowClient) GetQuestionsLastUpdate(ctx context.Context, site string,
+	questionIDs []int64) (map[int64]time.Time, error) {
+	lastUpdates := make(map[int64]time.Time, len(questionIDs))
+
+	for start := 0; start < len(questionIDs); start += maxQuestionIDsPerRequest {
+		end := min(start+maxQuestionIDsPerRequest, len(questionIDs))
+
+		ids := make([]string, 0, end-start)
+		for _, id := range questionIDs[start:end] {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+
+		request := c.client.R().
+			SetContext(ctx).
+			SetQueryParam("site", site).
+			SetQueryParam("pagesize", strconv.Itoa(maxQuestionIDsPerRequest))
+
+		if c.key != "" {
+			request.SetQueryParam("key", c.key)
+		}
+
+		var response struct {
+			Items []struct {
+				QuestionID       int64 `json:"question_id"`
+				LastActivityDate int64 `json:"last_activity_date"`
+			} `json:"items"`
+		}
+
+		resp, err := request.
+			SetResult(&response).
+			Get(fmt.Sprintf("%s/questions/%s", c.baseURL, strings.Join(ids, ";")))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("StackOverflow API вернул статус: %d", resp.StatusCode())
+		}
+
+		for _, item := range response.Items {
+			lastUpdates[item.QuestionID] = time.Unix(item.LastActivityDate, 0)
+		}
+	}
+
+	return lastUpdates, nil
+}
+
+func (c *StackOverflowClient) GetQuestionDetails(ctx context.Context, site string, questionID int64) (*models.ContentDetails, error) {
 	url := fmt.Sprintf("%s/questions/%d", c.baseURL, questionID)
 
 	request := c.client.R().
 		SetContext(ctx).
-		SetQueryParam("site", "stackoverflow").
+		SetQueryParam("site", site).
 		SetQueryParam("filter", "withbody")
 
 	if c.key != "" {
@@ -134,3 +223,68 @@ func (c *StackOverflowClient) GetQuestionDetails(ctx context.Context, questionID
 
 	return details, nil
 }
+
+func (c *StackOverflowClient) GetAnswersSince(ctx context.Context, site string, questionID int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	return c.getPostsSince(ctx, site, fmt.Sprintf("%s/questions/%d/answers", c.baseURL, questionID), "answer", since)
+}
+
+func (c *StackOverflowClient) GetCommentsSince(ctx context.Context, site string, questionID int64,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	return c.getPostsSince(ctx, site, fmt.Sprintf("%s/questions/%d/comments", c.baseURL, questionID), "comment", since)
+}
+
+func (c *StackOverflowClient) getPostsSince(ctx context.Context, site, url, contentType string,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	request := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("site", site).
+		SetQueryParam("filter", "withbody").
+		SetQueryParam("sort", "creation").
+		SetQueryParam("order", "asc")
+
+	if !since.IsZero() {
+		request.SetQueryParam("fromdate", strconv.FormatInt(since.Unix(), 10))
+	}
+
+	if c.key != "" {
+		request.SetQueryParam("key", c.key)
+	}
+
+	var response struct {
+		Items []Post `json:"items"`
+	}
+
+	resp, err := request.
+		SetResult(&response).
+		Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("StackOverflow API вернул статус: %d", resp.StatusCode())
+	}
+
+	updates := make([]*models.UpdateInfo, 0, len(response.Items))
+
+	for _, post := range response.Items {
+		createdAt := time.Unix(post.CreationDate, 0)
+
+		// fromdate включает границу, а пост с этим временем уже учтён при прошлой проверке.
+		if !since.IsZero() && !createdAt.After(since) {
+			continue
+		}
+
+		updates = append(updates, &models.UpdateInfo{
+			Author:      post.Owner.DisplayName,
+			UpdatedAt:   createdAt,
+			ContentType: contentType,
+			TextPreview: models.TextPreview(post.Body, 200),
+			FullText:    post.Body,
+		})
+	}
+
+	return updates, nil
+}
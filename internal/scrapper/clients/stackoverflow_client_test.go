@@ -0,0 +1,204 @@
+package clients_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackOverflowClient_GetAnswersAndCommentsSince(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	since := time.Unix(1700000000, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "superuser", r.URL.Query().Get("site"))
+		assert.Equal(t, "1700000000", r.URL.Query().Get("fromdate"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		var response string
+
+		switch r.URL.Path {
+		case "/questions/12345/answers":
+			response = `{"items": [
+				{"creation_date": 1700000000, "body": "already seen", "owner": {"display_name": "old"}},
+				{"creation_date": 1700000100, "body": "<p>Use a mutex</p>", "owner": {"display_name": "alice"}}
+			]}`
+		case "/questions/12345/comments":
+			response = `{"items": [
+				{"creation_date": 1700000200, "body": "Thanks!", "owner": {"display_name": "bob"}}
+			]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+	}
+
+	client := clients.NewStackOverflowClient("", server.URL, cfg, logger)
+	ctx := context.Background()
+
+	answers, err := client.GetAnswersSince(ctx, "superuser", 12345, since)
+	require.NoError(t, err)
+	require.Len(t, answers, 1)
+	assert.Equal(t, "answer", answers[0].ContentType)
+	assert.Equal(t, "alice", answers[0].Author)
+	assert.Equal(t, "<p>Use a mutex</p>", answers[0].TextPreview)
+
+	comments, err := client.GetCommentsSince(ctx, "superuser", 12345, since)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "comment", comments[0].ContentType)
+	assert.Equal(t, "bob", comments[0].Author)
+	assert.Equal(t, time.Unix(1700000200, 0), comments[0].UpdatedAt)
+}
+
+func TestStackOverflowClient_GetQuestionsLastUpdate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var requestCount atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+
+		assert.Equal(t, "math", r.URL.Query().Get("site"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/questions/4/answers" {
+			if _, err := w.Write([]byte(`{"items": []}`)); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+
+			return
+		}
+
+		assert.Equal(t, "/questions/1;2;3", r.URL.Path)
+
+		// Вопрос 3 удалён и не возвращается; backoff просит подождать перед следующим вызовом метода.
+		response := `{
+			"items": [
+				{"question_id": 1, "last_activity_date": 1700000100},
+				{"question_id": 2, "last_activity_date": 1700000200}
+			],
+			"backoff": 10,
+			"quota_max": 10000,
+			"quota_remaining": 9000
+		}`
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	client := clients.NewStackOverflowClient("", server.URL, cfg, logger)
+	ctx := context.Background()
+
+	lastUpdates, err := client.GetQuestionsLastUpdate(ctx, "math", []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]time.Time{
+		1: time.Unix(1700000100, 0),
+		2: time.Unix(1700000200, 0),
+	}, lastUpdates)
+
+	status := client.RateLimit()
+	assert.Equal(t, 10000, status.Limit)
+	assert.Equal(t, 9000, status.Remaining)
+	assert.False(t, status.Exhausted(time.Now()), "backoff не останавливает другие методы API")
+
+	_, err = client.GetQuestionsLastUpdate(ctx, "math", []int64{1, 2, 3})
+	require.ErrorIs(t, err, &customerrors.ErrRateLimited{})
+	assert.Equal(t, int32(1), requestCount.Load())
+
+	_, err = client.GetAnswersSince(ctx, "math", 4, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requestCount.Load())
+}
+
+func TestStackOverflowClient_ErrorWrapper(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var requestCount atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		response := `{"error_id": 400, "error_name": "bad_parameter", "error_message": "site is required"}`
+		if r.URL.Path == "/questions/1" {
+			response = `{"error_id": 502, "error_name": "throttle_violation",
+				"error_message": "too many requests from this IP, more requests available in 3600 seconds"}`
+		}
+
+		if _, err := w.Write([]byte(response)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             3,
+		RetryBackoff:           10 * time.Millisecond,
+		RetryableStatusCodes:   []int{400},
+	}
+
+	client := clients.NewStackOverflowClient("", server.URL, cfg, logger)
+	ctx := context.Background()
+
+	_, err := client.GetQuestionDetails(ctx, "", 2)
+
+	var apiErr *customerrors.ErrStackExchangeAPI
+
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 400, apiErr.ID)
+	assert.Equal(t, "bad_parameter", apiErr.Name)
+	assert.Equal(t, int32(1), requestCount.Load(), "ошибка из обёртки не должна повторяться")
+
+	before := time.Now()
+	_, err = client.GetQuestionLastUpdate(ctx, "stackoverflow", 1)
+
+	var rateLimited *customerrors.ErrRateLimited
+
+	require.ErrorAs(t, err, &rateLimited)
+	assert.WithinDuration(t, before.Add(time.Hour), rateLimited.ResetAt, time.Minute)
+	assert.True(t, client.RateLimit().Exhausted(time.Now()))
+
+	// После throttle_violation не отправляются запросы ни к одному методу.
+	_, err = client.GetAnswersSince(ctx, "stackoverflow", 1, time.Time{})
+	require.ErrorIs(t, err, &customerrors.ErrRateLimited{})
+	assert.Equal(t, int32(2), requestCount.Load())
+}
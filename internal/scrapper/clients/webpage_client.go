@@ -0,0 +1,112 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/net/html"
+)
+
+type WebPageClient struct {
+	client *resty.Client
+	logger *slog.Logger
+}
+
+type PageContentGetter interface {
+	GetPageContent(ctx context.Context, url, selector string) (*models.ContentDetails, error)
+}
+
+func NewWebPageClient(cfg *config.Config, logger *slog.Logger) PageContentGetter {
+	client := httputil.CreatePublicHTTPClient(cfg, logger, "webpage")
+
+	return &WebPageClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetPageContent загружает страницу и возвращает её нормализованный текст: по одной строке на текстовый узел,
+// со схлопнутыми пробелами и без скриптов и стилей. Если задан selector, учитываются только подходящие элементы.
+func (c *WebPageClient) GetPageContent(ctx context.Context, url, selector string) (*models.ContentDetails, error) {
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8").
+		SetDoNotParseResponse(true).
+		Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := httputil.ReadLimitedBody(resp, httputil.MaxPublicResponseSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if httputil.IsGone(resp) {
+		return nil, &customerrors.ErrResourceGone{URL: url}
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("страница вернула статус: %d", resp.StatusCode())
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка разбора HTML страницы: %w", err)
+	}
+
+	doc.Find("script, style, noscript, template").Remove()
+
+	title := strings.TrimSpace(doc.Find("title").First().Text())
+	if title == "" {
+		title = url
+	}
+
+	selection := doc.Find("body")
+	if selector != "" {
+		selection = doc.Find(selector)
+		if selection.Length() == 0 {
+			return nil, fmt.Errorf("на странице нет элементов, подходящих под селектор %q", selector)
+		}
+	}
+
+	var lines []string
+
+	for _, node := range selection.Nodes {
+		lines = appendNodeText(lines, node)
+	}
+
+	return &models.ContentDetails{
+		Title:       title,
+		UpdatedAt:   time.Now(),
+		ContentText: strings.Join(lines, "\n"),
+		LinkType:    models.WebPage,
+	}, nil
+}
+
+func appendNodeText(lines []string, node *html.Node) []string {
+	if node.Type == html.TextNode {
+		if text := strings.Join(strings.Fields(node.Data), " "); text != "" {
+			lines = append(lines, text)
+		}
+
+		return lines
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		lines = appendNodeText(lines, child)
+	}
+
+	return lines
+}
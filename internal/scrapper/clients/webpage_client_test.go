@@ -0,0 +1,109 @@
+package clients_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	"github.com/central-university-dev/go-Matthew11K/internal/config"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebPageClient_GetPageContent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		page := `<html><head><title> Service status </title><style>.x{}</style></head>
+<body>
+  <nav>Home   |   About</nav>
+  <div class="status"><b>API:</b>   operational
+    <script>track()</script>
+  </div>
+</body></html>`
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout:     5 * time.Second,
+		RetryCount:                 0,
+		RetryBackoff:               100 * time.Millisecond,
+		CBSlidingWindowSize:        100,
+		CBMinimumRequiredCalls:     10,
+		CBFailureRateThreshold:     90,
+		CBPermittedCallsInHalfOpen: 3,
+		CBWaitDurationInOpenState:  10 * time.Second,
+		AllowPrivateNetworks:       true,
+	}
+
+	client := clients.NewWebPageClient(cfg, logger)
+
+	t.Run("WholePage", func(t *testing.T) {
+		content, err := client.GetPageContent(context.Background(), server.URL, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Service status", content.Title)
+		assert.Equal(t, "Home | About\nAPI:\noperational", content.ContentText)
+	})
+
+	t.Run("Selector", func(t *testing.T) {
+		content, err := client.GetPageContent(context.Background(), server.URL, "div.status")
+		require.NoError(t, err)
+
+		assert.Equal(t, "API:\noperational", content.ContentText)
+	})
+
+	t.Run("SelectorNotFound", func(t *testing.T) {
+		_, err := client.GetPageContent(context.Background(), server.URL, "#missing")
+		assert.Error(t, err)
+	})
+}
+
+func TestWebPageClient_GetPageContent_Restrictions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := w.Write([]byte(strings.Repeat("a", httputil.MaxPublicResponseSize+1))); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		ExternalRequestTimeout: 5 * time.Second,
+		RetryCount:             0,
+		RetryBackoff:           100 * time.Millisecond,
+	}
+
+	t.Run("InternalAddresses", func(t *testing.T) {
+		client := clients.NewWebPageClient(cfg, logger)
+
+		for _, url := range []string{server.URL, "http://169.254.169.254/latest/meta-data/", "http://10.0.0.1/"} {
+			_, err := client.GetPageContent(context.Background(), url, "")
+			require.ErrorIs(t, err, &customerrors.ErrForbiddenAddress{}, url)
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		allowPrivate := *cfg
+		allowPrivate.AllowPrivateNetworks = true
+
+		client := clients.NewWebPageClient(&allowPrivate, logger)
+
+		_, err := client.GetPageContent(context.Background(), server.URL, "")
+		require.ErrorIs(t, err, &customerrors.ErrResponseTooLarge{})
+	})
+}
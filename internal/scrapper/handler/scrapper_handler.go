@@ -18,13 +18,19 @@ type ScrapperService interface {
 
 	DeleteChat(ctx context.Context, chatID int64) error
 
-	AddLink(ctx context.Context, chatID int64, url string, tags []string, filters []string) (*models.Link, error)
+	AddLink(ctx context.Context, chatID int64, url string, tags, filters, keywords []string,
+		mode models.NotificationMode) (*models.Link, error)
 
 	RemoveLink(ctx context.Context, chatID int64, url string) (*models.Link, error)
 
 	GetLinks(ctx context.Context, chatID int64) ([]*models.Link, error)
 
 	UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode, digestTime time.Time) error
+	UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error
+
+	MuteLink(ctx context.Context, chatID int64, url string, until time.Time, summary bool) (*models.Link, error)
+
+	UnmuteLink(ctx context.Context, chatID int64, url string) (*models.Link, error)
 }
 
 type TagService interface {
@@ -92,7 +98,13 @@ func (h *ScrapperHandler) LinksPost(ctx context.Context, req *v1_scrapper.AddLin
 
 	linkURL := req.Link.Value.String()
 
-	link, err := h.scrapperService.AddLink(ctx, params.TgChatID, linkURL, req.Tags, req.Filters)
+	// Без явного режима подписка получает уведомления в режиме, выбранном для чата.
+	var mode models.NotificationMode
+	if req.Mode.IsSet() {
+		mode = models.NotificationMode(req.Mode.Value)
+	}
+
+	link, err := h.scrapperService.AddLink(ctx, params.TgChatID, linkURL, req.Tags, req.Filters, req.Keywords, mode)
 	if err != nil {
 		var unsupportedLinkErr *domainerrors.ErrUnsupportedLinkType
 		if errors.As(err, &unsupportedLinkErr) {
@@ -112,6 +124,24 @@ func (h *ScrapperHandler) LinksPost(ctx context.Context, req *v1_scrapper.AddLin
 			return errResp, err
 		}
 
+		var invalidSelectorErr *domainerrors.ErrInvalidSelector
+		if errors.As(err, &invalidSelectorErr) {
+			errResp := &v1_scrapper.ApiErrorResponse{
+				Description: v1_scrapper.NewOptString("Некорректный CSS-селектор"),
+			}
+
+			return errResp, err
+		}
+
+		var invalidFilterErr *domainerrors.ErrInvalidFilter
+		if errors.As(err, &invalidFilterErr) {
+			errResp := &v1_scrapper.ApiErrorResponse{
+				Description: v1_scrapper.NewOptString(invalidFilterErr.Error()),
+			}
+
+			return errResp, err
+		}
+
 		errResp := &v1_scrapper.ApiErrorResponse{
 			Description: v1_scrapper.NewOptString("Ошибка при добавлении ссылки"),
 		}
@@ -119,17 +149,7 @@ func (h *ScrapperHandler) LinksPost(ctx context.Context, req *v1_scrapper.AddLin
 		return errResp, err
 	}
 
-	resp := &v1_scrapper.LinkResponse{
-		ID:      v1_scrapper.NewOptInt64(link.ID),
-		Tags:    link.Tags,
-		Filters: link.Filters,
-	}
-
-	if parsedURL, err := url.Parse(link.URL); err == nil {
-		resp.URL = v1_scrapper.NewOptURI(*parsedURL)
-	}
-
-	return resp, nil
+	return newLinkResponse(link), nil
 }
 
 func (h *ScrapperHandler) LinksDelete(ctx context.Context, req *v1_scrapper.RemoveLinkRequest,
@@ -162,17 +182,63 @@ func (h *ScrapperHandler) LinksDelete(ctx context.Context, req *v1_scrapper.Remo
 		return errResp, err
 	}
 
-	resp := &v1_scrapper.LinkResponse{
-		ID:      v1_scrapper.NewOptInt64(link.ID),
-		Tags:    link.Tags,
-		Filters: link.Filters,
+	return newLinkResponse(link), nil
+}
+
+func (h *ScrapperHandler) LinksMutePost(ctx context.Context, req *v1_scrapper.MuteLinkRequest,
+	params v1_scrapper.LinksMutePostParams) (v1_scrapper.LinksMutePostRes, error) {
+	link, err := h.scrapperService.MuteLink(ctx, params.TgChatID, req.Link.String(), req.MutedUntil, req.Summary.Or(true))
+	if err != nil {
+		if linkMissing(err) {
+			errResp := &v1_scrapper.LinksMutePostNotFound{
+				Description: v1_scrapper.NewOptString("Ссылка не найдена"),
+			}
+
+			return errResp, err
+		}
+
+		errResp := &v1_scrapper.LinksMutePostBadRequest{
+			Description: v1_scrapper.NewOptString("Ошибка при заглушении ссылки"),
+		}
+
+		if errors.Is(err, &domainerrors.ErrInvalidValue{}) {
+			errResp.Description = v1_scrapper.NewOptString("Время окончания заглушения должно быть в будущем")
+		}
+
+		return errResp, err
 	}
 
-	if parsedURL, err := url.Parse(link.URL); err == nil {
-		resp.URL = v1_scrapper.NewOptURI(*parsedURL)
+	return newLinkResponse(link), nil
+}
+
+func (h *ScrapperHandler) LinksMuteDelete(ctx context.Context, req *v1_scrapper.RemoveLinkRequest,
+	params v1_scrapper.LinksMuteDeleteParams) (v1_scrapper.LinksMuteDeleteRes, error) {
+	if !req.Link.IsSet() {
+		errResp := &v1_scrapper.LinksMuteDeleteBadRequest{
+			Description: v1_scrapper.NewOptString("URL не указан"),
+		}
+
+		return errResp, &domainerrors.ErrMissingRequiredField{FieldName: "Link"}
 	}
 
-	return resp, nil
+	link, err := h.scrapperService.UnmuteLink(ctx, params.TgChatID, req.Link.Value.String())
+	if err != nil {
+		if linkMissing(err) {
+			errResp := &v1_scrapper.LinksMuteDeleteNotFound{
+				Description: v1_scrapper.NewOptString("Ссылка не найдена"),
+			}
+
+			return errResp, err
+		}
+
+		errResp := &v1_scrapper.LinksMuteDeleteBadRequest{
+			Description: v1_scrapper.NewOptString("Ошибка при снятии заглушения"),
+		}
+
+		return errResp, err
+	}
+
+	return newLinkResponse(link), nil
 }
 
 func (h *ScrapperHandler) LinksGet(ctx context.Context, params v1_scrapper.LinksGetParams) (v1_scrapper.LinksGetRes, error) {
@@ -200,17 +266,7 @@ func (h *ScrapperHandler) LinksGet(ctx context.Context, params v1_scrapper.Links
 	}
 
 	for _, link := range links {
-		linkResp := v1_scrapper.LinkResponse{
-			ID:      v1_scrapper.NewOptInt64(link.ID),
-			Tags:    link.Tags,
-			Filters: link.Filters,
-		}
-
-		if parsedURL, err := url.Parse(link.URL); err == nil {
-			linkResp.URL = v1_scrapper.NewOptURI(*parsedURL)
-		}
-
-		resp.Links = append(resp.Links, linkResp)
+		resp.Links = append(resp.Links, *newLinkResponse(link))
 	}
 
 	return resp, nil
@@ -269,3 +325,78 @@ func (h *ScrapperHandler) NotificationSettingsPost(ctx context.Context, req *v1_
 
 	return &v1_scrapper.NotificationSettingsPostOK{}, nil
 }
+
+func (h *ScrapperHandler) QuietHoursPost(ctx context.Context, req *v1_scrapper.UpdateQuietHoursRequest,
+	params v1_scrapper.QuietHoursPostParams) (v1_scrapper.QuietHoursPostRes, error) {
+	var quietHours *models.QuietHours
+
+	if req.Enabled {
+		if !req.StartHour.IsSet() || !req.StartMinute.IsSet() || !req.EndHour.IsSet() || !req.EndMinute.IsSet() {
+			errResp := &v1_scrapper.QuietHoursPostBadRequest{
+				Description: v1_scrapper.NewOptString("Для часов тишины необходимо указать время начала и окончания"),
+			}
+
+			return errResp, &domainerrors.ErrMissingRequiredField{FieldName: "QuietHours"}
+		}
+
+		quietHours = &models.QuietHours{
+			Start:    time.Date(0, 1, 1, int(req.StartHour.Value), int(req.StartMinute.Value), 0, 0, time.UTC),
+			End:      time.Date(0, 1, 1, int(req.EndHour.Value), int(req.EndMinute.Value), 0, 0, time.UTC),
+			Timezone: req.Timezone.Or("UTC"),
+		}
+	}
+
+	err := h.scrapperService.UpdateQuietHours(ctx, params.TgChatID, quietHours)
+	if err != nil {
+		var chatNotFoundErr *domainerrors.ErrChatNotFound
+		if errors.As(err, &chatNotFoundErr) {
+			errResp := &v1_scrapper.QuietHoursPostNotFound{
+				Description: v1_scrapper.NewOptString("Чат не найден"),
+			}
+
+			return errResp, err
+		}
+
+		errResp := &v1_scrapper.QuietHoursPostBadRequest{
+			Description: v1_scrapper.NewOptString("Ошибка при обновлении часов тишины"),
+		}
+
+		if errors.Is(err, &domainerrors.ErrInvalidValue{}) {
+			errResp.Description = v1_scrapper.NewOptString("Неизвестный часовой пояс")
+		}
+
+		return errResp, err
+	}
+
+	return &v1_scrapper.QuietHoursPostOK{}, nil
+}
+
+// linkMissing сообщает, что ссылка не найдена или не отслеживается чатом.
+func linkMissing(err error) bool {
+	var (
+		linkNotFoundErr  *domainerrors.ErrLinkNotFound
+		linkNotInChatErr *domainerrors.ErrLinkNotInChat
+		chatNotFoundErr  *domainerrors.ErrChatNotFound
+	)
+
+	return errors.As(err, &linkNotFoundErr) || errors.As(err, &linkNotInChatErr) || errors.As(err, &chatNotFoundErr)
+}
+
+func newLinkResponse(link *models.Link) *v1_scrapper.LinkResponse {
+	resp := &v1_scrapper.LinkResponse{
+		ID:       v1_scrapper.NewOptInt64(link.ID),
+		Tags:     link.Tags,
+		Filters:  link.Filters,
+		Keywords: link.Keywords,
+	}
+
+	if parsedURL, err := url.Parse(link.URL); err == nil {
+		resp.URL = v1_scrapper.NewOptURI(*parsedURL)
+	}
+
+	if link.MutedUntil != nil {
+		resp.MutedUntil = v1_scrapper.NewOptDateTime(*link.MutedUntil)
+	}
+
+	return resp
+}
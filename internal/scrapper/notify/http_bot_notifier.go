@@ -42,8 +42,6 @@ func (n *HTTPBotNotifier) SendUpdate(ctx context.Context, update *models.LinkUpd
 		"chats", len(update.TgChatIDs),
 	)
 
-	description := formatDescription(update)
-
 	if update.UpdateInfo != nil {
 		n.logger.Info("Сформировано детализированное уведомление",
 			"linkID", update.ID,
@@ -55,7 +53,8 @@ func (n *HTTPBotNotifier) SendUpdate(ctx context.Context, update *models.LinkUpd
 	req := &v1_bot.LinkUpdate{
 		ID:          v1_bot.NewOptInt64(update.ID),
 		TgChatIds:   update.TgChatIDs,
-		Description: v1_bot.NewOptString(description),
+		Description: v1_bot.NewOptString(update.Description),
+		Keywords:    update.Keywords,
 	}
 
 	if update.URL != "" {
@@ -26,6 +26,7 @@ type LinkUpdateMessage struct {
 	Description string             `json:"description"`
 	TgChatIDs   []int64            `json:"tgChatIds"`
 	UpdateInfo  *models.UpdateInfo `json:"updateInfo,omitempty"`
+	Keywords    []string           `json:"keywords,omitempty"`
 }
 
 func NewKafkaBotNotifier(brokers []string, linkTopic, dlqTopic string, logger *slog.Logger) *KafkaBotNotifier {
@@ -67,9 +68,10 @@ func (n *KafkaBotNotifier) SendUpdate(ctx context.Context, update *models.LinkUp
 	message := LinkUpdateMessage{
 		ID:          update.ID,
 		URL:         update.URL,
-		Description: formatDescription(update),
+		Description: update.Description,
 		TgChatIDs:   update.TgChatIDs,
 		UpdateInfo:  update.UpdateInfo,
+		Keywords:    update.Keywords,
 	}
 
 	value, err := json.Marshal(message)
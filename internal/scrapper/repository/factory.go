@@ -16,9 +16,32 @@ import (
 type ContentDetailsRepository interface {
 	Save(ctx context.Context, details *models.ContentDetails) error
 	FindByLinkID(ctx context.Context, linkID int64) (*models.ContentDetails, error)
+	FindByURL(ctx context.Context, url string) (*models.ContentDetails, error)
 	Update(ctx context.Context, details *models.ContentDetails) error
 }
 
+type FeedEntryRepository interface {
+	GetSeenGUIDs(ctx context.Context, url string) ([]string, error)
+	ReplaceSeenGUIDs(ctx context.Context, url string, guids []string) error
+}
+
+type WorkflowRunRepository interface {
+	GetLastRun(ctx context.Context, url string) (*models.WorkflowRun, error)
+	SaveLastRun(ctx context.Context, url string, run *models.WorkflowRun) error
+}
+
+type AdvisoryRepository interface {
+	GetSeenAdvisories(ctx context.Context, url string) (keys []string, checked bool, err error)
+	ReplaceSeenAdvisories(ctx context.Context, url string, keys []string) error
+}
+
+type HeldUpdateRepository interface {
+	Add(ctx context.Context, chatID int64, update *models.LinkUpdate) error
+	FindChatIDs(ctx context.Context) ([]int64, error)
+	FindByChatID(ctx context.Context, chatID int64) ([]*models.HeldUpdate, error)
+	Delete(ctx context.Context, ids []int64) error
+}
+
 type Factory struct {
 	db     *database.PostgresDB
 	config *config.Config
@@ -73,3 +96,59 @@ func (f *Factory) CreateContentDetailsRepository() (ContentDetailsRepository, er
 		return repo, &errors.ErrUnknownDBAccessType{AccessType: string(f.config.DatabaseAccessType)}
 	}
 }
+
+func (f *Factory) CreateFeedEntryRepository() (FeedEntryRepository, error) {
+	switch f.config.DatabaseAccessType {
+	case config.SquirrelAccess:
+		f.logger.Info("Создание ORM (Squirrel) репозитория записей лент")
+		return orm.NewFeedEntryRepository(f.db), nil
+	case config.SQLAccess:
+		f.logger.Info("Создание SQL репозитория записей лент")
+		return sqlrepo.NewFeedEntryRepository(f.db), nil
+	default:
+		var repo FeedEntryRepository
+		return repo, &errors.ErrUnknownDBAccessType{AccessType: string(f.config.DatabaseAccessType)}
+	}
+}
+
+func (f *Factory) CreateWorkflowRunRepository() (WorkflowRunRepository, error) {
+	switch f.config.DatabaseAccessType {
+	case config.SquirrelAccess:
+		f.logger.Info("Создание ORM (Squirrel) репозитория запусков workflow")
+		return orm.NewWorkflowRunRepository(f.db), nil
+	case config.SQLAccess:
+		f.logger.Info("Создание SQL репозитория запусков workflow")
+		return sqlrepo.NewWorkflowRunRepository(f.db), nil
+	default:
+		var repo WorkflowRunRepository
+		return repo, &errors.ErrUnknownDBAccessType{AccessType: string(f.config.DatabaseAccessType)}
+	}
+}
+
+func (f *Factory) CreateAdvisoryRepository() (AdvisoryRepository, error) {
+	switch f.config.DatabaseAccessType {
+	case config.SquirrelAccess:
+		f.logger.Info("Создание ORM (Squirrel) репозитория бюллетеней безопасности")
+		return orm.NewAdvisoryRepository(f.db), nil
+	case config.SQLAccess:
+		f.logger.Info("Создание SQL репозитория бюллетеней безопасности")
+		return sqlrepo.NewAdvisoryRepository(f.db), nil
+	default:
+		var repo AdvisoryRepository
+		return repo, &errors.ErrUnknownDBAccessType{AccessType: string(f.config.DatabaseAccessType)}
+	}
+}
+
+func (f *Factory) CreateHeldUpdateRepository() (HeldUpdateRepository, error) {
+	switch f.config.DatabaseAccessType {
+	case config.SquirrelAccess:
+		f.logger.Info("Создание ORM (Squirrel) репозитория отложенных уведомлений")
+		return orm.NewHeldUpdateRepository(f.db), nil
+	case config.SQLAccess:
+		f.logger.Info("Создание SQL репозитория отложенных уведомлений")
+		return sqlrepo.NewHeldUpdateRepository(f.db), nil
+	default:
+		var repo HeldUpdateRepository
+		return repo, &errors.ErrUnknownDBAccessType{AccessType: string(f.config.DatabaseAccessType)}
+	}
+}
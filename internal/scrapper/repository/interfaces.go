@@ -14,11 +14,17 @@ type LinkRepository interface {
 	FindByChatID(ctx context.Context, chatID int64) ([]*models.Link, error)
 	DeleteByURL(ctx context.Context, url string, chatID int64) error
 	Update(ctx context.Context, link *models.Link) error
-	AddChatLink(ctx context.Context, chatID, linkID int64) error
+	AddSubscription(ctx context.Context, subscription *models.Subscription) error
+	MergeSubscription(ctx context.Context, subscription *models.Subscription) error
+	FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error)
+	MuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time, summary bool) error
+	UnmuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time) (missed int, unmuted bool, err error)
+	AddMutedUpdates(ctx context.Context, chatID, linkID int64, count int) error
+	FindExpiredMutes(ctx context.Context, now time.Time) ([]*models.Subscription, error)
 	FindDue(ctx context.Context, limit, offset int) ([]*models.Link, error)
 	Count(ctx context.Context) (int, error)
-	SaveTags(ctx context.Context, linkID int64, tags []string) error
-	SaveFilters(ctx context.Context, linkID int64, filters []string) error
+	SaveTags(ctx context.Context, chatID, linkID int64, tags []string) error
+	SaveFilters(ctx context.Context, chatID, linkID int64, filters []string) error
 	GetAll(ctx context.Context) ([]*models.Link, error)
 }
 
@@ -34,4 +40,5 @@ type ChatRepository interface {
 	ExistsChatLink(ctx context.Context, chatID, linkID int64) (bool, error)
 	UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode, digestTime time.Time) error
 	FindByDigestTime(ctx context.Context, hour, minute int) ([]*models.Chat, error)
+	UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error
 }
@@ -8,10 +8,11 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
-	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
 	"github.com/central-university-dev/go-Matthew11K/internal/database"
 	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
@@ -141,14 +142,12 @@ func clearTables(ctx context.Context, t *testing.T) {
 	t.Helper()
 
 	tables := []string{
+		"held_updates",
 		"chat_links",
 		"chat_state_data",
 		"chat_states",
-		"link_tags",
-		"filters",
 		"content_details",
 		"links",
-		"tags",
 		"chats",
 	}
 	for _, table := range tables {
@@ -167,8 +166,6 @@ func clearTables(ctx context.Context, t *testing.T) {
 
 	sequences := []string{
 		"links_id_seq",
-		"tags_id_seq",
-		"filters_id_seq",
 		"content_details_id_seq",
 		"chat_state_data_id_seq",
 	}
@@ -206,6 +203,9 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 	chatRepo, err := factory.CreateChatRepository()
 	require.NoError(t, err, "Ошибка создания ChatRepository для %s", accessType)
 
+	heldRepo, err := factory.CreateHeldUpdateRepository()
+	require.NoError(t, err, "Ошибка создания HeldUpdateRepository для %s", accessType)
+
 	t.Run("LinkRepository Save and FindByURL", func(t *testing.T) {
 		clearTables(ctx, t)
 
@@ -213,8 +213,6 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		link := &models.Link{
 			URL:         linkURL,
 			Type:        models.GitHub,
-			Tags:        []string{"tag1", string(accessType)},
-			Filters:     []string{"filter1"},
 			LastChecked: time.Now().Truncate(time.Microsecond),
 			LastUpdated: time.Now().Truncate(time.Microsecond),
 		}
@@ -230,8 +228,6 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		assert.Equal(t, link.ID, foundLink.ID, "ID mismatch for %s", accessType)
 		assert.Equal(t, link.URL, foundLink.URL, "URL mismatch for %s", accessType)
 		assert.Equal(t, link.Type, foundLink.Type, "Type mismatch for %s", accessType)
-		assert.ElementsMatch(t, link.Tags, foundLink.Tags, "Tags mismatch for %s", accessType)
-		assert.ElementsMatch(t, link.Filters, foundLink.Filters, "Filters mismatch for %s", accessType)
 
 		assert.WithinDuration(t, link.LastChecked, foundLink.LastChecked, time.Second, "LastChecked mismatch for %s", accessType)
 		assert.WithinDuration(t, link.LastUpdated, foundLink.LastUpdated, time.Second, "LastUpdated mismatch for %s", accessType)
@@ -254,7 +250,7 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		clearTables(ctx, t)
 
 		linkURL := fmt.Sprintf("https://update-test.com/link-%s-%d", accessType, time.Now().UnixNano())
-		linkToUpdate := &models.Link{URL: linkURL, Type: models.StackOverflow, CreatedAt: time.Now()}
+		linkToUpdate := &models.Link{URL: linkURL, Type: models.StackOverflow, Site: "superuser", CreatedAt: time.Now()}
 		err = linkRepo.Save(ctx, linkToUpdate)
 		require.NoError(t, err)
 
@@ -270,9 +266,27 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		require.NoError(t, err)
 		assert.WithinDuration(t, newCheckedTime, updatedLink.LastChecked, time.Second, "Updated LastChecked mismatch for %s", accessType)
 		assert.WithinDuration(t, newUpdatedTime, updatedLink.LastUpdated, time.Second, "Updated LastUpdated mismatch for %s", accessType)
+		assert.Equal(t, "superuser", updatedLink.Site, "Site mismatch for %s", accessType)
+		assert.True(t, updatedLink.Active, "Saved link should be active for %s", accessType)
+
+		linkToUpdate.Active = false
+		err = linkRepo.Update(ctx, linkToUpdate)
+		require.NoError(t, err, "Deactivating update failed for %s", accessType)
+
+		deactivatedLink, err := linkRepo.FindByID(ctx, linkToUpdate.ID)
+		require.NoError(t, err)
+		assert.False(t, deactivatedLink.Active, "Link should be inactive for %s", accessType)
+
+		dueLinks, err := linkRepo.FindDue(ctx, 0, 0)
+		require.NoError(t, err, "FindDue failed for %s", accessType)
+		assert.Empty(t, dueLinks, "Inactive link should not be due for %s", accessType)
+
+		allLinks, err := linkRepo.GetAll(ctx)
+		require.NoError(t, err, "GetAll failed for %s", accessType)
+		assert.Empty(t, allLinks, "GetAll should skip inactive links for %s", accessType)
 	})
 
-	t.Run("LinkRepository AddChatLink, FindByChatID, DeleteByURL", func(t *testing.T) {
+	t.Run("LinkRepository AddSubscription, FindByChatID, DeleteByURL", func(t *testing.T) {
 		clearTables(ctx, t)
 
 		chatID := time.Now().UnixNano()
@@ -289,10 +303,10 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		err = linkRepo.Save(ctx, link2)
 		require.NoError(t, err)
 
-		err = linkRepo.AddChatLink(ctx, chatID, link1.ID)
-		require.NoError(t, err, "AddChatLink 1 failed for %s", accessType)
-		err = linkRepo.AddChatLink(ctx, chatID, link2.ID)
-		require.NoError(t, err, "AddChatLink 2 failed for %s", accessType)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID, LinkID: link1.ID, Tags: []string{"work"}})
+		require.NoError(t, err, "AddSubscription 1 failed for %s", accessType)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID, LinkID: link2.ID})
+		require.NoError(t, err, "AddSubscription 2 failed for %s", accessType)
 
 		links, err := linkRepo.FindByChatID(ctx, chatID)
 		require.NoError(t, err, "FindByChatID failed for %s", accessType)
@@ -303,6 +317,9 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 			foundIDs = append(foundIDs, l.ID)
 		}
 
+		assert.Equal(t, []string{"work"}, links[0].Tags, "Subscription tags mismatch for %s", accessType)
+		assert.Empty(t, links[1].Tags, "Second subscription should have no tags for %s", accessType)
+
 		assert.Contains(t, foundIDs, link1.ID, "Link1 ID not found for %s", accessType)
 		assert.Contains(t, foundIDs, link2.ID, "Link2 ID not found for %s", accessType)
 
@@ -323,12 +340,6 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		_, err = testDB.Pool.Exec(ctx, "DELETE FROM chat_links")
 		require.NoError(t, err, "Failed to clear chat_links table")
 
-		_, err = testDB.Pool.Exec(ctx, "DELETE FROM filters")
-		require.NoError(t, err, "Failed to clear filters table")
-
-		_, err = testDB.Pool.Exec(ctx, "DELETE FROM link_tags")
-		require.NoError(t, err, "Failed to clear link_tags table")
-
 		_, err = testDB.Pool.Exec(ctx, "DELETE FROM links")
 		require.NoError(t, err, "Failed to clear links table")
 
@@ -380,49 +391,192 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		assert.Empty(t, dueLinks3, "Page 3 should be empty for %s", accessType)
 	})
 
-	t.Run("LinkRepository SaveTags and SaveFilters", func(t *testing.T) {
+	t.Run("LinkRepository subscriptions are scoped per chat", func(t *testing.T) {
 		clearTables(ctx, t)
 
-		linkURL := fmt.Sprintf("tags-filters-%s.com", accessType)
-		link := &models.Link{
-			URL:     linkURL,
-			Type:    models.GitHub,
-			Tags:    []string{"initial_tag"},
-			Filters: []string{"initial_filter"},
-		}
+		chatID1 := time.Now().UnixNano() + 4
+		chatID2 := time.Now().UnixNano() + 5
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID1}))
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID2}))
+
+		link := &models.Link{URL: fmt.Sprintf("tags-filters-%s.com", accessType), Type: models.GitHub}
 		err := linkRepo.Save(ctx, link)
 		require.NoError(t, err)
-		require.NotZero(t, link.ID)
 
-		foundLink, err := linkRepo.FindByID(ctx, link.ID)
-		require.NoError(t, err)
-		assert.Equal(t, []string{"initial_tag"}, foundLink.Tags, "Initial tags mismatch for %s", accessType)
-		assert.Equal(t, []string{"initial_filter"}, foundLink.Filters, "Initial filters mismatch for %s", accessType)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{
+			ChatID:   chatID1,
+			LinkID:   link.ID,
+			Tags:     []string{"initial_tag"},
+			Filters:  []string{"user=bot"},
+			Keywords: []string{"deadlock"},
+		})
+		require.NoError(t, err, "AddSubscription 1 failed for %s", accessType)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{
+			ChatID: chatID2,
+			LinkID: link.ID,
+			Mode:   models.NotificationModeDigest,
+		})
+		require.NoError(t, err, "AddSubscription 2 failed for %s", accessType)
 
 		newTags := []string{"updated_tag1", "updated_tag2"}
-		err = linkRepo.SaveTags(ctx, link.ID, newTags)
+		err = linkRepo.SaveTags(ctx, chatID1, link.ID, newTags)
 		require.NoError(t, err, "SaveTags failed for %s", accessType)
 
-		newFilters := []string{"updated_filter1"}
-		err = linkRepo.SaveFilters(ctx, link.ID, newFilters)
+		newFilters := []string{"user=dependabot"}
+		err = linkRepo.SaveFilters(ctx, chatID2, link.ID, newFilters)
 		require.NoError(t, err, "SaveFilters failed for %s", accessType)
 
-		foundLinkUpdated, err := linkRepo.FindByID(ctx, link.ID)
+		subscriptions, err := linkRepo.FindSubscriptions(ctx, link.ID)
+		require.NoError(t, err, "FindSubscriptions failed for %s", accessType)
+		require.Len(t, subscriptions, 2, "Should find 2 subscriptions for %s", accessType)
+
+		assert.Equal(t, chatID1, subscriptions[0].ChatID)
+		assert.Equal(t, newTags, subscriptions[0].Tags, "Chat 1 tags mismatch for %s", accessType)
+		assert.Equal(t, []string{"user=bot"}, subscriptions[0].Filters, "Chat 1 filters mismatch for %s", accessType)
+		assert.Equal(t, []string{"deadlock"}, subscriptions[0].Keywords, "Chat 1 keywords mismatch for %s", accessType)
+		assert.Empty(t, subscriptions[0].Mode, "Chat 1 should inherit chat mode for %s", accessType)
+
+		assert.Equal(t, chatID2, subscriptions[1].ChatID)
+		assert.Empty(t, subscriptions[1].Tags, "Chat 2 should not see chat 1 tags for %s", accessType)
+		assert.Equal(t, newFilters, subscriptions[1].Filters, "Chat 2 filters mismatch for %s", accessType)
+		assert.Empty(t, subscriptions[1].Keywords, "Chat 2 should not see chat 1 keywords for %s", accessType)
+		assert.Equal(t, models.NotificationModeDigest, subscriptions[1].Mode, "Chat 2 mode mismatch for %s", accessType)
+
+		foundLink, err := linkRepo.FindByID(ctx, link.ID)
+		require.NoError(t, err)
+		assert.Empty(t, foundLink.Tags, "Shared link should carry no tags for %s", accessType)
+
+		err = linkRepo.SaveTags(ctx, -1, link.ID, []string{"orphan"})
+		require.Error(t, err, "SaveTags without subscription should fail for %s", accessType)
+		assert.IsType(t, &customerrors.ErrLinkNotInChat{}, err, "Error type should be ErrLinkNotInChat for %s", accessType)
+	})
+
+	t.Run("LinkRepository MergeSubscription", func(t *testing.T) {
+		clearTables(ctx, t)
+
+		chatID := time.Now().UnixNano() + 8
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID}))
+
+		link := &models.Link{URL: fmt.Sprintf("merge-%s.com", accessType), Type: models.GitHub}
+		require.NoError(t, linkRepo.Save(ctx, link))
+
+		require.NoError(t, linkRepo.AddSubscription(ctx, &models.Subscription{
+			ChatID:   chatID,
+			LinkID:   link.ID,
+			Tags:     []string{"work"},
+			Filters:  []string{"user=bot", "user=dependabot"},
+			Keywords: []string{"deadlock"},
+			Mode:     models.NotificationModeDigest,
+		}))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, linkRepo.MuteSubscription(ctx, chatID, link.ID, now.Add(2*time.Hour), false))
+		require.NoError(t, linkRepo.AddMutedUpdates(ctx, chatID, link.ID, 2))
+
+		movedMute := now.Add(time.Hour)
+		err := linkRepo.MergeSubscription(ctx, &models.Subscription{
+			ChatID:       chatID,
+			LinkID:       link.ID,
+			Tags:         []string{"release", "work"},
+			Filters:      []string{"user=bot"},
+			Keywords:     []string{"cve"},
+			Mode:         models.NotificationModeInstant,
+			MutedUntil:   &movedMute,
+			MuteSummary:  true,
+			MutedUpdates: 3,
+		})
+		require.NoError(t, err, "MergeSubscription failed for %s", accessType)
+
+		subscriptions, err := linkRepo.FindSubscriptions(ctx, link.ID)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+
+		assert.Equal(t, []string{"release", "work"}, subscriptions[0].Tags, "Tags should be merged for %s", accessType)
+		assert.Equal(t, []string{"user=bot"}, subscriptions[0].Filters, "Only common filters should remain for %s", accessType)
+		assert.Equal(t, []string{"cve", "deadlock"}, subscriptions[0].Keywords, "Keywords should be merged for %s", accessType)
+		assert.Equal(t, models.NotificationModeDigest, subscriptions[0].Mode, "Mode should be kept for %s", accessType)
+		require.NotNil(t, subscriptions[0].MutedUntil, "Both muted subscriptions stay muted for %s", accessType)
+		assert.True(t, movedMute.Equal(*subscriptions[0].MutedUntil), "Earlier mute should win for %s", accessType)
+		assert.True(t, subscriptions[0].MuteSummary, "Summary should be kept for %s", accessType)
+		assert.Equal(t, 5, subscriptions[0].MutedUpdates, "Missed updates should add up for %s", accessType)
+
+		require.NoError(t, linkRepo.MergeSubscription(ctx, &models.Subscription{ChatID: chatID, LinkID: link.ID}))
+
+		subscriptions, err = linkRepo.FindSubscriptions(ctx, link.ID)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+		assert.Empty(t, subscriptions[0].Filters, "Merging with an unfiltered subscription drops filters for %s", accessType)
+		assert.Empty(t, subscriptions[0].Keywords, "Merging with a subscription without keywords drops them for %s", accessType)
+		assert.Nil(t, subscriptions[0].MutedUntil, "Merging with an unmuted subscription unmutes for %s", accessType)
+	})
+
+	t.Run("LinkRepository MuteSubscription, AddMutedUpdates, FindExpiredMutes", func(t *testing.T) {
+		clearTables(ctx, t)
+
+		chatID1 := time.Now().UnixNano() + 6
+		chatID2 := time.Now().UnixNano() + 7
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID1}))
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID2}))
+
+		link := &models.Link{URL: fmt.Sprintf("mute-%s.com", accessType), Type: models.GitHub}
+		require.NoError(t, linkRepo.Save(ctx, link))
+		require.NoError(t, linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID1, LinkID: link.ID}))
+		require.NoError(t, linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID2, LinkID: link.ID}))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, linkRepo.MuteSubscription(ctx, chatID1, link.ID, now.Add(time.Hour), false),
+			"MuteSubscription failed for %s", accessType)
+		require.NoError(t, linkRepo.MuteSubscription(ctx, chatID2, link.ID, now.Add(2*time.Hour), true))
+		require.NoError(t, linkRepo.AddMutedUpdates(ctx, chatID2, link.ID, 2))
+		require.NoError(t, linkRepo.AddMutedUpdates(ctx, chatID2, link.ID, 1))
+
+		subscriptions, err := linkRepo.FindSubscriptions(ctx, link.ID)
 		require.NoError(t, err)
-		sort.Strings(newTags)
-		sort.Strings(foundLinkUpdated.Tags)
-		assert.Equal(t, newTags, foundLinkUpdated.Tags, "Updated tags mismatch for %s", accessType)
-		assert.Equal(t, newFilters, foundLinkUpdated.Filters, "Updated filters mismatch for %s", accessType)
+		require.Len(t, subscriptions, 2)
+		require.NotNil(t, subscriptions[0].MutedUntil, "Chat 1 should be muted for %s", accessType)
+		assert.True(t, now.Add(time.Hour).Equal(*subscriptions[0].MutedUntil), "Chat 1 mute mismatch for %s", accessType)
+		assert.False(t, subscriptions[0].MuteSummary)
+		assert.Equal(t, 3, subscriptions[1].MutedUpdates, "Chat 2 missed updates mismatch for %s", accessType)
 
-		err = linkRepo.SaveTags(ctx, link.ID, []string{})
+		links, err := linkRepo.FindByChatID(ctx, chatID1)
 		require.NoError(t, err)
-		err = linkRepo.SaveFilters(ctx, link.ID, []string{})
+		require.Len(t, links, 1)
+		require.NotNil(t, links[0].MutedUntil, "FindByChatID should return mute for %s", accessType)
+
+		expired, err := linkRepo.FindExpiredMutes(ctx, now.Add(90*time.Minute))
+		require.NoError(t, err, "FindExpiredMutes failed for %s", accessType)
+		require.Len(t, expired, 1, "Only chat 1 mute should expire for %s", accessType)
+		assert.Equal(t, chatID1, expired[0].ChatID)
+
+		_, unmuted, err := linkRepo.UnmuteSubscription(ctx, chatID2, link.ID, now.Add(time.Hour))
+		require.NoError(t, err, "UnmuteSubscription failed for %s", accessType)
+		assert.False(t, unmuted, "A mute lasting past until should stay for %s", accessType)
+
+		missed, unmuted, err := linkRepo.UnmuteSubscription(ctx, chatID2, link.ID, now.Add(2*time.Hour))
+		require.NoError(t, err, "UnmuteSubscription failed for %s", accessType)
+		assert.True(t, unmuted, "Chat 2 should be unmuted for %s", accessType)
+		assert.Equal(t, 3, missed, "Missed updates should be returned for %s", accessType)
+
+		_, unmuted, err = linkRepo.UnmuteSubscription(ctx, chatID2, link.ID, now.Add(2*time.Hour))
 		require.NoError(t, err)
+		assert.False(t, unmuted, "A released mute should not be released twice for %s", accessType)
+
+		require.NoError(t, linkRepo.AddMutedUpdates(ctx, chatID2, link.ID, 1))
 
-		foundLinkEmpty, err := linkRepo.FindByID(ctx, link.ID)
+		subscriptions, err = linkRepo.FindSubscriptions(ctx, link.ID)
 		require.NoError(t, err)
-		assert.Empty(t, foundLinkEmpty.Tags, "Tags should be empty after delete for %s", accessType)
-		assert.Empty(t, foundLinkEmpty.Filters, "Filters should be empty after delete for %s", accessType)
+		assert.Nil(t, subscriptions[1].MutedUntil, "Chat 2 should be unmuted for %s", accessType)
+		assert.Equal(t, 3, subscriptions[1].MutedUpdates, "Unmuted subscriptions should not count updates for %s", accessType)
+
+		require.NoError(t, linkRepo.MuteSubscription(ctx, chatID2, link.ID, now.Add(time.Hour), true))
+
+		subscriptions, err = linkRepo.FindSubscriptions(ctx, link.ID)
+		require.NoError(t, err)
+		assert.Zero(t, subscriptions[1].MutedUpdates, "Missed updates should reset on mute for %s", accessType)
+
+		err = linkRepo.MuteSubscription(ctx, -1, link.ID, now.Add(time.Hour), true)
+		require.Error(t, err, "MuteSubscription without subscription should fail for %s", accessType)
+		assert.IsType(t, &customerrors.ErrLinkNotInChat{}, err, "Error type should be ErrLinkNotInChat for %s", accessType)
 	})
 
 	t.Run("ChatRepository Save, FindByID, Delete", func(t *testing.T) {
@@ -472,9 +626,9 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		err = linkRepo.Save(ctx, link)
 		require.NoError(t, err)
 
-		err = linkRepo.AddChatLink(ctx, chatID1, link.ID)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID1, LinkID: link.ID})
 		require.NoError(t, err)
-		err = linkRepo.AddChatLink(ctx, chatID2, link.ID)
+		err = linkRepo.AddSubscription(ctx, &models.Subscription{ChatID: chatID2, LinkID: link.ID})
 		require.NoError(t, err)
 
 		foundChats, err := chatRepo.FindByLinkID(ctx, link.ID)
@@ -494,6 +648,63 @@ func runTestsForConfig(t *testing.T, accessType config.AccessType) {
 		assert.IsType(t, &customerrors.ErrLinkNotFound{}, err, "Error type should be ErrLinkNotFound for %s", accessType)
 		assert.Nil(t, emptyChats, "Result slice should be nil when error occurs for %s", accessType)
 	})
+
+	t.Run("ChatRepository UpdateQuietHours and HeldUpdateRepository", func(t *testing.T) {
+		clearTables(ctx, t)
+
+		chatID := time.Now().UnixNano() + 4
+		require.NoError(t, chatRepo.Save(ctx, &models.Chat{ID: chatID}))
+
+		quietHours := &models.QuietHours{
+			Start:    time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC),
+			End:      time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC),
+			Timezone: "Europe/Moscow",
+		}
+		require.NoError(t, chatRepo.UpdateQuietHours(ctx, chatID, quietHours), "UpdateQuietHours failed for %s", accessType)
+
+		chat, err := chatRepo.FindByID(ctx, chatID)
+		require.NoError(t, err)
+		require.NotNil(t, chat.QuietHours, "QuietHours should be set for %s", accessType)
+		assert.Equal(t, "23:00", chat.QuietHours.Start.Format("15:04"))
+		assert.Equal(t, "08:30", chat.QuietHours.End.Format("15:04"))
+		assert.Equal(t, "Europe/Moscow", chat.QuietHours.Timezone)
+
+		update := &models.LinkUpdate{
+			ID:          1,
+			URL:         "https://github.com/owner/repo",
+			Description: "Новый issue",
+			UpdateInfo:  &models.UpdateInfo{Title: "#1 Hang", Author: "alice", ContentType: "issue"},
+			Keywords:    []string{"hang"},
+		}
+		require.NoError(t, heldRepo.Add(ctx, chatID, update), "Add failed for %s", accessType)
+
+		chatIDs, err := heldRepo.FindChatIDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{chatID}, chatIDs)
+
+		held, err := heldRepo.FindByChatID(ctx, chatID)
+		require.NoError(t, err, "FindByChatID failed for %s", accessType)
+		require.Len(t, held, 1)
+		assert.Equal(t, update.URL, held[0].Update.URL)
+		assert.Equal(t, update.Keywords, held[0].Update.Keywords)
+		assert.Equal(t, "#1 Hang", held[0].Update.UpdateInfo.Title)
+		assert.Equal(t, []int64{chatID}, held[0].Update.TgChatIDs)
+
+		require.NoError(t, heldRepo.Delete(ctx, []int64{held[0].ID}))
+
+		held, err = heldRepo.FindByChatID(ctx, chatID)
+		require.NoError(t, err)
+		assert.Empty(t, held)
+
+		require.NoError(t, chatRepo.UpdateQuietHours(ctx, chatID, nil))
+
+		chat, err = chatRepo.FindByID(ctx, chatID)
+		require.NoError(t, err)
+		assert.Nil(t, chat.QuietHours, "QuietHours should be cleared for %s", accessType)
+
+		err = chatRepo.UpdateQuietHours(ctx, -1, nil)
+		assert.IsType(t, &customerrors.ErrChatNotFound{}, err)
+	})
 }
 
 func TestLinkRepository_Implementations(t *testing.T) {
@@ -504,3 +715,73 @@ func TestLinkRepository_Implementations(t *testing.T) {
 		runTestsForConfig(t, config.SquirrelAccess)
 	})
 }
+
+// TestCanonicalizeLinkURLsMigration проверяет, что SQL-миграция приводит ссылки к тому же виду,
+// что и common.CanonicalizeURL, которым пользуются репозитории.
+func TestCanonicalizeLinkURLsMigration(t *testing.T) {
+	ctx := context.Background()
+
+	script, err := os.ReadFile("../../../migrations/000005_canonicalize_link_urls.up.sql")
+	require.NoError(t, err)
+
+	// Сравнивается только вычисление канонических адресов, без объединения дубликатов.
+	canonicalize, _, found := strings.Cut(string(script), "CREATE TEMP TABLE link_merge")
+	require.True(t, found)
+
+	urls := []string{
+		"https://github.com/o/r",
+		"http://www.github.com/o/r/",
+		"github.com/O/R.git",
+		"https://github.com/Owner/Repo/blob/main/README.md#L10",
+		"https://github.com/o/r/?tab=readme",
+		"http://www.github.com/Owner/",
+		"https://stackoverflow.com/questions/12345/how-to-lock?answertab=votes#tab-top",
+		"http://www.superuser.com/q/42",
+		"https://unix.stackexchange.com/questions/7/title#css=div",
+		"http://GitLab.com/group/project.git",
+		"https://gitlab.com/group/sub/project/",
+		"http://www.gitlab.com",
+		"HTTPS://Example.COM:443/status/?lang=en#css=div.status",
+		"https://www.example.com/docs/",
+		"http://example.com/",
+		"http://example.com:80/?page=2#top",
+		" https://example.com:8080/a ",
+	}
+
+	tx, err := testDB.Pool.Begin(ctx)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	_, err = tx.Exec(ctx, "DELETE FROM links")
+	require.NoError(t, err)
+
+	for _, url := range urls {
+		_, err = tx.Exec(ctx, "INSERT INTO links (url, type) VALUES ($1, $2)", url, models.WebPage)
+		require.NoError(t, err)
+	}
+
+	_, err = tx.Exec(ctx, canonicalize)
+	require.NoError(t, err)
+
+	rows, err := tx.Query(ctx, "SELECT l.url, c.canonical FROM links l JOIN link_canonical c ON c.id = l.id")
+	require.NoError(t, err)
+
+	defer rows.Close()
+
+	checked := 0
+
+	for rows.Next() {
+		var url, canonical string
+
+		require.NoError(t, rows.Scan(&url, &canonical))
+		assert.Equal(t, common.CanonicalizeURL(url), canonical, "canonical URL mismatch for %q", url)
+
+		checked++
+	}
+
+	require.NoError(t, rows.Err())
+	assert.Equal(t, len(urls), checked)
+}
@@ -44,6 +44,36 @@ func (_m *ContentDetailsRepository) FindByLinkID(ctx context.Context, linkID int
 	return r0, r1
 }
 
+// FindByURL provides a mock function with given fields: ctx, url
+func (_m *ContentDetailsRepository) FindByURL(ctx context.Context, url string) (*models.ContentDetails, error) {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByURL")
+	}
+
+	var r0 *models.ContentDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.ContentDetails, error)); ok {
+		return rf(ctx, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.ContentDetails); ok {
+		r0 = rf(ctx, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContentDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, details
 func (_m *ContentDetailsRepository) Save(ctx context.Context, details *models.ContentDetails) error {
 	ret := _m.Called(ctx, details)
@@ -7,6 +7,8 @@ import (
 
 	models "github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // LinkRepository is an autogenerated mock type for the LinkRepository type
@@ -14,17 +16,35 @@ type LinkRepository struct {
 	mock.Mock
 }
 
-// AddChatLink provides a mock function with given fields: ctx, chatID, linkID
-func (_m *LinkRepository) AddChatLink(ctx context.Context, chatID int64, linkID int64) error {
-	ret := _m.Called(ctx, chatID, linkID)
+// AddMutedUpdates provides a mock function with given fields: ctx, chatID, linkID, count
+func (_m *LinkRepository) AddMutedUpdates(ctx context.Context, chatID int64, linkID int64, count int) error {
+	ret := _m.Called(ctx, chatID, linkID, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMutedUpdates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, chatID, linkID, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSubscription provides a mock function with given fields: ctx, subscription
+func (_m *LinkRepository) AddSubscription(ctx context.Context, subscription *models.Subscription) error {
+	ret := _m.Called(ctx, subscription)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddChatLink")
+		panic("no return value specified for AddSubscription")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
-		r0 = rf(ctx, chatID, linkID)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Subscription) error); ok {
+		r0 = rf(ctx, subscription)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -32,6 +52,34 @@ func (_m *LinkRepository) AddChatLink(ctx context.Context, chatID int64, linkID
 	return r0
 }
 
+// Count provides a mock function with given fields: ctx
+func (_m *LinkRepository) Count(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteByURL provides a mock function with given fields: ctx, url, chatID
 func (_m *LinkRepository) DeleteByURL(ctx context.Context, url string, chatID int64) error {
 	ret := _m.Called(ctx, url, chatID)
@@ -140,6 +188,96 @@ func (_m *LinkRepository) FindByURL(ctx context.Context, url string) (*models.Li
 	return r0, r1
 }
 
+// FindDue provides a mock function with given fields: ctx, limit, offset
+func (_m *LinkRepository) FindDue(ctx context.Context, limit int, offset int) ([]*models.Link, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDue")
+	}
+
+	var r0 []*models.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*models.Link, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*models.Link); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindExpiredMutes provides a mock function with given fields: ctx, now
+func (_m *LinkRepository) FindExpiredMutes(ctx context.Context, now time.Time) ([]*models.Subscription, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpiredMutes")
+	}
+
+	var r0 []*models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*models.Subscription, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*models.Subscription); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSubscriptions provides a mock function with given fields: ctx, linkID
+func (_m *LinkRepository) FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error) {
+	ret := _m.Called(ctx, linkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSubscriptions")
+	}
+
+	var r0 []*models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Subscription, error)); ok {
+		return rf(ctx, linkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Subscription); ok {
+		r0 = rf(ctx, linkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, linkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	ret := _m.Called(ctx)
@@ -170,6 +308,42 @@ func (_m *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	return r0, r1
 }
 
+// MergeSubscription provides a mock function with given fields: ctx, subscription
+func (_m *LinkRepository) MergeSubscription(ctx context.Context, subscription *models.Subscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Subscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MuteSubscription provides a mock function with given fields: ctx, chatID, linkID, until, summary
+func (_m *LinkRepository) MuteSubscription(ctx context.Context, chatID int64, linkID int64, until time.Time, summary bool) error {
+	ret := _m.Called(ctx, chatID, linkID, until, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time, bool) error); ok {
+		r0 = rf(ctx, chatID, linkID, until, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Save provides a mock function with given fields: ctx, link
 func (_m *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	ret := _m.Called(ctx, link)
@@ -188,6 +362,77 @@ func (_m *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	return r0
 }
 
+// SaveFilters provides a mock function with given fields: ctx, chatID, linkID, filters
+func (_m *LinkRepository) SaveFilters(ctx context.Context, chatID int64, linkID int64, filters []string) error {
+	ret := _m.Called(ctx, chatID, linkID, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFilters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) error); ok {
+		r0 = rf(ctx, chatID, linkID, filters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveTags provides a mock function with given fields: ctx, chatID, linkID, tags
+func (_m *LinkRepository) SaveTags(ctx context.Context, chatID int64, linkID int64, tags []string) error {
+	ret := _m.Called(ctx, chatID, linkID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) error); ok {
+		r0 = rf(ctx, chatID, linkID, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnmuteSubscription provides a mock function with given fields: ctx, chatID, linkID, until
+func (_m *LinkRepository) UnmuteSubscription(ctx context.Context, chatID int64, linkID int64, until time.Time) (int, bool, error) {
+	ret := _m.Called(ctx, chatID, linkID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmuteSubscription")
+	}
+
+	var r0 int
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) (int, bool, error)); ok {
+		return rf(ctx, chatID, linkID, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) int); ok {
+		r0 = rf(ctx, chatID, linkID, until)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time) bool); ok {
+		r1 = rf(ctx, chatID, linkID, until)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, time.Time) error); ok {
+		r2 = rf(ctx, chatID, linkID, until)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Update provides a mock function with given fields: ctx, link
 func (_m *LinkRepository) Update(ctx context.Context, link *models.Link) error {
 	ret := _m.Called(ctx, link)
@@ -272,6 +272,24 @@ func (_m *ChatRepository) UpdateNotificationSettings(ctx context.Context, chatID
 	return r0
 }
 
+// UpdateQuietHours provides a mock function with given fields: ctx, chatID, quietHours
+func (_m *ChatRepository) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	ret := _m.Called(ctx, chatID, quietHours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuietHours")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *models.QuietHours) error); ok {
+		r0 = rf(ctx, chatID, quietHours)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewChatRepository creates a new instance of ChatRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChatRepository(t interface {
@@ -0,0 +1,74 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+	"github.com/jackc/pgx/v5"
+)
+
+type AdvisoryRepository struct {
+	db *database.PostgresDB
+	sq sq.StatementBuilderType
+}
+
+func NewAdvisoryRepository(db *database.PostgresDB) *AdvisoryRepository {
+	return &AdvisoryRepository{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *AdvisoryRepository) GetSeenAdvisories(ctx context.Context, url string) (keys []string, checked bool, err error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("sa.keys").
+		From("seen_advisories sa").
+		Join("links l ON l.id = sa.link_id").
+		Where(sq.Eq{"l.url": url})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, false, &customerrors.ErrBuildSQLQuery{Operation: "получение учтённых бюллетеней", Cause: err}
+	}
+
+	err = querier.QueryRow(ctx, query, args...).Scan(&keys)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, &customerrors.ErrSQLExecution{Operation: "получение учтённых бюллетеней", Cause: err}
+	}
+
+	return keys, true, nil
+}
+
+// ReplaceSeenAdvisories заменяет ключи учтённых бюллетеней ссылки текущими.
+func (r *AdvisoryRepository) ReplaceSeenAdvisories(ctx context.Context, url string, keys []string) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	insertQuery := r.sq.Insert("seen_advisories").
+		Columns("link_id", "keys", "checked_at").
+		Select(r.sq.Select("id").
+			Column("?::text[]", nonNilStrings(keys)).
+			Column("NOW()").
+			From("links").
+			Where(sq.Eq{"url": url})).
+		Suffix("ON CONFLICT (link_id) DO UPDATE SET keys = EXCLUDED.keys, checked_at = EXCLUDED.checked_at")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "сохранение учтённых бюллетеней", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение учтённых бюллетеней", Cause: err}
+	}
+
+	return nil
+}
@@ -70,7 +70,8 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	selectQuery := r.sq.Select(
-		"c.id", "c.notification_mode", "c.digest_time", "c.created_at", "c.updated_at",
+		"c.id", "c.notification_mode", "c.digest_time", "c.quiet_start", "c.quiet_end", "c.timezone",
+		"c.created_at", "c.updated_at",
 		"COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links",
 	).
 		From("chats c").
@@ -87,7 +88,9 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 
 	var chat models.Chat
 
-	var notificationMode string
+	var notificationMode, timezone string
+
+	var quietStart, quietEnd *time.Time
 
 	var linksArr []int64
 
@@ -95,6 +98,9 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 		&chat.ID,
 		&notificationMode,
 		&chat.DigestTime,
+		&quietStart,
+		&quietEnd,
+		&timezone,
 		&chat.CreatedAt,
 		&chat.UpdatedAt,
 		&linksArr,
@@ -108,6 +114,7 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 	}
 
 	chat.NotificationMode = models.NotificationMode(notificationMode)
+	chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 	chat.Links = linksArr
 
 	return &chat, nil
@@ -190,18 +197,59 @@ func (r *ChatRepository) UpdateNotificationSettings(ctx context.Context, chatID
 	return nil
 }
 
+// UpdateQuietHours сохраняет часы тишины чата. Значение nil отключает их.
+func (r *ChatRepository) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	var start, end *time.Time
+
+	timezone := "UTC"
+
+	if quietHours != nil {
+		start, end = &quietHours.Start, &quietHours.End
+		timezone = quietHours.Timezone
+	}
+
+	updateQuery := r.sq.Update("chats").
+		Set("quiet_start", start).
+		Set("quiet_end", end).
+		Set("timezone", timezone).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": chatID})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "обновление часов тишины", Cause: err}
+	}
+
+	result, err := querier.Exec(ctx, query, args...)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "обновление часов тишины", Cause: err}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrChatNotFound{ChatID: chatID}
+	}
+
+	return nil
+}
+
 func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int) ([]*models.Chat, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	timeStr := fmt.Sprintf("%02d:%02d", hour, minute)
 
 	selectQuery := r.sq.Select(
-		"c.id", "c.notification_mode", "c.digest_time", "c.created_at", "c.updated_at",
+		"c.id", "c.notification_mode", "c.digest_time", "c.quiet_start", "c.quiet_end", "c.timezone",
+		"c.created_at", "c.updated_at",
 		"COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links",
 	).
 		From("chats c").
 		LeftJoin("chat_links cl ON c.id = cl.chat_id").
-		Where(sq.Eq{"c.notification_mode": models.NotificationModeDigest}).
+		Where(sq.Or{
+			sq.Eq{"c.notification_mode": models.NotificationModeDigest},
+			sq.Expr("EXISTS (SELECT 1 FROM chat_links dl WHERE dl.chat_id = c.id AND dl.mode = ?)", models.NotificationModeDigest),
+		}).
 		Where(sq.Expr("to_char(c.digest_time, 'HH24:MI') = ?", timeStr)).
 		GroupBy("c.id").
 		OrderBy("c.id")
@@ -228,7 +276,9 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 	for rows.Next() {
 		var chat models.Chat
 
-		var notificationMode string
+		var notificationMode, timezone string
+
+		var quietStart, quietEnd *time.Time
 
 		var linksArr []int64
 
@@ -236,6 +286,9 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 			&chat.ID,
 			&notificationMode,
 			&chat.DigestTime,
+			&quietStart,
+			&quietEnd,
+			&timezone,
 			&chat.CreatedAt,
 			&chat.UpdatedAt,
 			&linksArr,
@@ -248,6 +301,7 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = linksArr
 		chats = append(chats, &chat)
 	}
@@ -333,7 +387,10 @@ func (r *ChatRepository) RemoveLink(ctx context.Context, chatID, linkID int64) e
 func (r *ChatRepository) FindByLinkID(ctx context.Context, linkID int64) ([]*models.Chat, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-	selectQuery := r.sq.Select("c.id", "c.notification_mode", "c.digest_time", "c.created_at", "c.updated_at").
+	selectQuery := r.sq.Select(
+		"c.id", "c.notification_mode", "c.digest_time", "c.quiet_start", "c.quiet_end", "c.timezone",
+		"c.created_at", "c.updated_at",
+	).
 		From("chats c").
 		Join("chat_links cl ON c.id = cl.chat_id").
 		Where(sq.Eq{"cl.link_id": linkID})
@@ -354,14 +411,18 @@ func (r *ChatRepository) FindByLinkID(ctx context.Context, linkID int64) ([]*mod
 	for rows.Next() {
 		chat := &models.Chat{}
 
-		var notificationMode string
+		var notificationMode, timezone string
 
-		err := rows.Scan(&chat.ID, &notificationMode, &chat.DigestTime, &chat.CreatedAt, &chat.UpdatedAt)
+		var quietStart, quietEnd *time.Time
+
+		err := rows.Scan(&chat.ID, &notificationMode, &chat.DigestTime, &quietStart, &quietEnd, &timezone,
+			&chat.CreatedAt, &chat.UpdatedAt)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "чтение чата", Cause: err}
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = []int64{}
 		chats = append(chats, chat)
 	}
@@ -389,7 +450,8 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	selectQuery := r.sq.Select(
-		"c.id", "c.notification_mode", "c.digest_time", "c.created_at", "c.updated_at",
+		"c.id", "c.notification_mode", "c.digest_time", "c.quiet_start", "c.quiet_end", "c.timezone",
+		"c.created_at", "c.updated_at",
 		"COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links",
 	).
 		From("chats c").
@@ -413,7 +475,9 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 	for rows.Next() {
 		var chat models.Chat
 
-		var notificationMode string
+		var notificationMode, timezone string
+
+		var quietStart, quietEnd *time.Time
 
 		var linksArr []int64
 
@@ -421,6 +485,9 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 			&chat.ID,
 			&notificationMode,
 			&chat.DigestTime,
+			&quietStart,
+			&quietEnd,
+			&timezone,
 			&chat.CreatedAt,
 			&chat.UpdatedAt,
 			&linksArr,
@@ -430,6 +497,7 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = linksArr
 		chats = append(chats, &chat)
 	}
@@ -459,3 +527,12 @@ func (r *ChatRepository) ExistsChatLink(ctx context.Context, chatID, linkID int6
 
 	return exists, nil
 }
+
+// quietHours собирает часы тишины из столбцов чата. Пустой quiet_start означает, что они не заданы.
+func quietHours(start, end *time.Time, timezone string) *models.QuietHours {
+	if start == nil || end == nil {
+		return nil
+	}
+
+	return &models.QuietHours{Start: *start, End: *end, Timezone: timezone}
+}
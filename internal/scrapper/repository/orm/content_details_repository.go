@@ -86,3 +86,35 @@ func (r *ContentDetailsRepository) FindByLinkID(ctx context.Context, linkID int6
 
 	return details, nil
 }
+
+func (r *ContentDetailsRepository) FindByURL(ctx context.Context, url string) (*models.ContentDetails, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("cd.link_id", "cd.title", "cd.author", "cd.updated_at", "cd.content_text", "cd.link_type").
+		From("content_details cd").
+		Join("links l ON l.id = cd.link_id").
+		Where(sq.Eq{"l.url": url})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: "получение деталей контента по URL", Cause: err}
+	}
+
+	details := &models.ContentDetails{}
+
+	var linkTypeStr string
+
+	err = querier.QueryRow(ctx, query, args...).
+		Scan(&details.LinkID, &details.Title, &details.Author, &details.UpdatedAt, &details.ContentText, &linkTypeStr)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &customerrors.ErrLinkNotFound{URL: url}
+		}
+
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение деталей контента по URL", Cause: err}
+	}
+
+	details.LinkType = models.LinkType(linkTypeStr)
+
+	return details, nil
+}
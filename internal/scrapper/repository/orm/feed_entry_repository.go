@@ -0,0 +1,97 @@
+package orm
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+)
+
+type FeedEntryRepository struct {
+	db *database.PostgresDB
+	sq sq.StatementBuilderType
+}
+
+func NewFeedEntryRepository(db *database.PostgresDB) *FeedEntryRepository {
+	return &FeedEntryRepository{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *FeedEntryRepository) GetSeenGUIDs(ctx context.Context, url string) ([]string, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("fe.guid").
+		From("feed_entries fe").
+		Join("links l ON l.id = fe.link_id").
+		Where(sq.Eq{"l.url": url})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: "получение просмотренных записей ленты", Cause: err}
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение просмотренных записей ленты", Cause: err}
+	}
+
+	defer rows.Close()
+
+	var guids []string
+
+	for rows.Next() {
+		var guid string
+		if err := rows.Scan(&guid); err != nil {
+			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование записей ленты", Cause: err}
+		}
+
+		guids = append(guids, guid)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "чтение записей ленты", Cause: err}
+	}
+
+	return guids, nil
+}
+
+// ReplaceSeenGUIDs оставляет в хранилище только переданные GUID: записи, выпавшие из ленты, больше не нужны.
+func (r *FeedEntryRepository) ReplaceSeenGUIDs(ctx context.Context, url string, guids []string) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	insertQuery := r.sq.Insert("feed_entries").
+		Columns("link_id", "guid").
+		Select(r.sq.Select("l.id", "g.guid").
+			From("links l").
+			JoinClause("CROSS JOIN unnest(?::text[]) AS g(guid)", guids).
+			Where(sq.Eq{"l.url": url})).
+		Suffix("ON CONFLICT (link_id, guid) DO NOTHING")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "сохранение записей ленты", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение записей ленты", Cause: err}
+	}
+
+	deleteQuery := r.sq.Delete("feed_entries").
+		Where(sq.Expr("link_id = (SELECT id FROM links WHERE url = ?)", url)).
+		Where(sq.Expr("NOT (guid = ANY(?::text[]))", guids))
+
+	query, args, err = deleteQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "удаление устаревших записей ленты", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "удаление устаревших записей ленты", Cause: err}
+	}
+
+	return nil
+}
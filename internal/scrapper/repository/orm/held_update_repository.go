@@ -0,0 +1,139 @@
+package orm
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+)
+
+type HeldUpdateRepository struct {
+	db *database.PostgresDB
+	sq sq.StatementBuilderType
+}
+
+func NewHeldUpdateRepository(db *database.PostgresDB) *HeldUpdateRepository {
+	return &HeldUpdateRepository{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *HeldUpdateRepository) Add(ctx context.Context, chatID int64, update *models.LinkUpdate) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	keywords := update.Keywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+
+	insertQuery := r.sq.Insert("held_updates").
+		Columns("chat_id", "link_id", "url", "description", "update_info", "keywords").
+		Values(chatID, update.ID, update.URL, update.Description, update.UpdateInfo, keywords)
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "сохранение отложенного уведомления", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение отложенного уведомления", Cause: err}
+	}
+
+	return nil
+}
+
+func (r *HeldUpdateRepository) FindChatIDs(ctx context.Context) ([]int64, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("DISTINCT chat_id").From("held_updates").OrderBy("chat_id")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: "поиск чатов с отложенными уведомлениями", Cause: err}
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "поиск чатов с отложенными уведомлениями", Cause: err}
+	}
+	defer rows.Close()
+
+	var chatIDs []int64
+
+	for rows.Next() {
+		var chatID int64
+		if err := rows.Scan(&chatID); err != nil {
+			return nil, &customerrors.ErrSQLScan{Entity: "ID чата", Cause: err}
+		}
+
+		chatIDs = append(chatIDs, chatID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов", Cause: err}
+	}
+
+	return chatIDs, nil
+}
+
+func (r *HeldUpdateRepository) FindByChatID(ctx context.Context, chatID int64) ([]*models.HeldUpdate, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("id", "chat_id", "link_id", "url", "description", "update_info", "keywords", "created_at").
+		From("held_updates").
+		Where(sq.Eq{"chat_id": chatID}).
+		OrderBy("id")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: "получение отложенных уведомлений", Cause: err}
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение отложенных уведомлений", Cause: err}
+	}
+	defer rows.Close()
+
+	var held []*models.HeldUpdate
+
+	for rows.Next() {
+		item := &models.HeldUpdate{Update: &models.LinkUpdate{}}
+
+		err := rows.Scan(&item.ID, &item.ChatID, &item.Update.ID, &item.Update.URL, &item.Update.Description,
+			&item.Update.UpdateInfo, &item.Update.Keywords, &item.CreatedAt)
+		if err != nil {
+			return nil, &customerrors.ErrSQLScan{Entity: "отложенное уведомление", Cause: err}
+		}
+
+		item.Update.TgChatIDs = []int64{item.ChatID}
+		held = append(held, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов", Cause: err}
+	}
+
+	return held, nil
+}
+
+func (r *HeldUpdateRepository) Delete(ctx context.Context, ids []int64) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	deleteQuery := r.sq.Delete("held_updates").Where(sq.Eq{"id": ids})
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "удаление отложенных уведомлений", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "удаление отложенных уведомлений", Cause: err}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/database"
 	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
@@ -30,14 +31,17 @@ func NewLinkRepository(db *database.PostgresDB) *LinkRepository {
 func (r *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	link.URL = common.CanonicalizeURL(link.URL)
+
 	now := time.Now()
 	if link.CreatedAt.IsZero() {
 		link.CreatedAt = now
 	}
 
 	insertQuery := r.sq.Insert("links").
-		Columns("url", "type", "last_checked", "last_updated", "created_at").
-		Values(link.URL, link.Type, link.LastChecked, link.LastUpdated, link.CreatedAt).
+		Columns("url", "type", "site", "etag", "last_modified", "last_checked", "last_updated", "created_at").
+		Values(link.URL, link.Type, link.Site, link.ETag, link.LastModified,
+			link.LastChecked, link.LastUpdated, link.CreatedAt).
 		Suffix("RETURNING id")
 
 	query, args, err := insertQuery.ToSql()
@@ -58,127 +62,7 @@ func (r *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	}
 
 	link.ID = id
-
-	if len(link.Tags) > 0 {
-		err = r.saveTags(ctx, querier, id, link.Tags)
-		if err != nil {
-			return err
-		}
-	}
-
-	if len(link.Filters) > 0 {
-		err = r.saveFilters(ctx, querier, id, link.Filters)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-//nolint:funlen // Функция целостная
-func (r *LinkRepository) saveTags(ctx context.Context, querier txs.Querier, linkID int64, tags []string) error {
-	if len(tags) == 0 {
-		return nil
-	}
-
-	{
-		insert := r.sq.Insert("tags").Columns("name")
-		for _, tag := range tags {
-			insert = insert.Values(tag)
-		}
-
-		insert = insert.Suffix("ON CONFLICT (name) DO NOTHING")
-
-		query, args, err := insert.ToSql()
-		if err != nil {
-			return &customerrors.ErrBuildSQLQuery{Operation: "batch-сохранение тегов", Cause: err}
-		}
-
-		_, err = querier.Exec(ctx, query, args...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "batch-сохранение тегов", Cause: err}
-		}
-	}
-
-	{
-		selectQuery := r.sq.Select("id", "name").From("tags").Where(sq.Eq{"name": tags})
-
-		query, args, err := selectQuery.ToSql()
-		if err != nil {
-			return &customerrors.ErrBuildSQLQuery{Operation: "получение id тегов", Cause: err}
-		}
-
-		rows, err := querier.Query(ctx, query, args...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "получение id тегов", Cause: err}
-		}
-
-		defer rows.Close()
-
-		ids := make(map[string]int64, len(tags))
-
-		for rows.Next() {
-			var id int64
-
-			var name string
-			if err := rows.Scan(&id, &name); err != nil {
-				return &customerrors.ErrSQLExecution{Operation: "сканирование id тегов", Cause: err}
-			}
-
-			ids[name] = id
-		}
-
-		if err := rows.Err(); err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "чтение id тегов", Cause: err}
-		}
-
-		insert := r.sq.Insert("link_tags").Columns("link_id", "tag_id")
-
-		for _, tag := range tags {
-			id, ok := ids[tag]
-			if !ok {
-				return &customerrors.ErrSQLExecution{Operation: "batch-связывание ссылки с тегом", Cause: fmt.Errorf("id не найден для тега %s", tag)}
-			}
-
-			insert = insert.Values(linkID, id)
-		}
-
-		insert = insert.Suffix("ON CONFLICT DO NOTHING")
-
-		query, args, err = insert.ToSql()
-		if err != nil {
-			return &customerrors.ErrBuildSQLQuery{Operation: "batch-связывание ссылки с тегами", Cause: err}
-		}
-
-		_, err = querier.Exec(ctx, query, args...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "batch-связывание ссылки с тегами", Cause: err}
-		}
-	}
-
-	return nil
-}
-
-func (r *LinkRepository) saveFilters(ctx context.Context, querier txs.Querier, linkID int64, filters []string) error {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	insert := r.sq.Insert("filters").Columns("value", "link_id")
-	for _, filter := range filters {
-		insert = insert.Values(filter, linkID)
-	}
-
-	query, args, err := insert.ToSql()
-	if err != nil {
-		return &customerrors.ErrBuildSQLQuery{Operation: "batch-сохранение фильтров", Cause: err}
-	}
-
-	_, err = querier.Exec(ctx, query, args...)
-	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "batch-сохранение фильтров", Cause: err}
-	}
+	link.Active = true
 
 	return nil
 }
@@ -187,16 +71,11 @@ func (r *LinkRepository) FindByID(ctx context.Context, id int64) (*models.Link,
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	selectQuery := r.sq.Select(
-		"l.id", "l.url", "l.type", "l.last_checked", "l.last_updated", "l.created_at",
-		"COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags",
-		"COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters",
+		"l.id", "l.url", "l.type", "l.site", "l.etag", "l.last_modified", "l.active", "l.gone_checks",
+		"l.last_checked", "l.last_updated", "l.created_at",
 	).
 		From("links l").
-		LeftJoin("link_tags lt ON l.id = lt.link_id").
-		LeftJoin("tags t ON lt.tag_id = t.id").
-		LeftJoin("filters f ON l.id = f.link_id").
-		Where(sq.Eq{"l.id": id}).
-		GroupBy("l.id")
+		Where(sq.Eq{"l.id": id})
 
 	query, args, err := selectQuery.ToSql()
 	if err != nil {
@@ -207,17 +86,18 @@ func (r *LinkRepository) FindByID(ctx context.Context, id int64) (*models.Link,
 
 	var link models.Link
 
-	var tagsArr, filtersArr []string
-
 	err = row.Scan(
 		&link.ID,
 		&link.URL,
 		&link.Type,
+		&link.Site,
+		&link.ETag,
+		&link.LastModified,
+		&link.Active,
+		&link.GoneChecks,
 		&link.LastChecked,
 		&link.LastUpdated,
 		&link.CreatedAt,
-		&tagsArr,
-		&filtersArr,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -227,26 +107,20 @@ func (r *LinkRepository) FindByID(ctx context.Context, id int64) (*models.Link,
 		return nil, &customerrors.ErrSQLExecution{Operation: "поиск ссылки по ID", Cause: err}
 	}
 
-	link.Tags = tagsArr
-	link.Filters = filtersArr
-
 	return &link, nil
 }
 
 func (r *LinkRepository) FindByURL(ctx context.Context, url string) (*models.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	url = common.CanonicalizeURL(url)
+
 	selectQuery := r.sq.Select(
-		"l.id", "l.url", "l.type", "l.last_checked", "l.last_updated", "l.created_at",
-		"COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags",
-		"COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters",
+		"l.id", "l.url", "l.type", "l.site", "l.etag", "l.last_modified", "l.active", "l.gone_checks",
+		"l.last_checked", "l.last_updated", "l.created_at",
 	).
 		From("links l").
-		LeftJoin("link_tags lt ON l.id = lt.link_id").
-		LeftJoin("tags t ON lt.tag_id = t.id").
-		LeftJoin("filters f ON l.id = f.link_id").
 		Where(sq.Eq{"l.url": url}).
-		GroupBy("l.id").
 		Limit(1)
 
 	query, args, err := selectQuery.ToSql()
@@ -258,17 +132,18 @@ func (r *LinkRepository) FindByURL(ctx context.Context, url string) (*models.Lin
 
 	var link models.Link
 
-	var tagsArr, filtersArr []string
-
 	err = row.Scan(
 		&link.ID,
 		&link.URL,
 		&link.Type,
+		&link.Site,
+		&link.ETag,
+		&link.LastModified,
+		&link.Active,
+		&link.GoneChecks,
 		&link.LastChecked,
 		&link.LastUpdated,
 		&link.CreatedAt,
-		&tagsArr,
-		&filtersArr,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -278,9 +153,6 @@ func (r *LinkRepository) FindByURL(ctx context.Context, url string) (*models.Lin
 		return nil, &customerrors.ErrSQLExecution{Operation: "поиск ссылки по URL", Cause: err}
 	}
 
-	link.Tags = tagsArr
-	link.Filters = filtersArr
-
 	return &link, nil
 }
 
@@ -288,17 +160,14 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	selectQuery := r.sq.Select(
-		"l.id", "l.url", "l.type", "l.last_checked", "l.last_updated", "l.created_at",
-		"COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags",
-		"COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters",
+		"l.id", "l.url", "l.type", "l.site", "l.etag", "l.last_modified", "l.active", "l.gone_checks",
+		"l.last_checked", "l.last_updated", "l.created_at",
+		"cl.tags", "cl.filters", "cl.keywords", "cl.muted_until",
 	).
 		From("links l").
 		Join("chat_links cl ON l.id = cl.link_id").
-		LeftJoin("link_tags lt ON l.id = lt.link_id").
-		LeftJoin("tags t ON lt.tag_id = t.id").
-		LeftJoin("filters f ON l.id = f.link_id").
 		Where(sq.Eq{"cl.chat_id": chatID}).
-		GroupBy("l.id")
+		OrderBy("cl.created_at", "l.id")
 
 	query, args, err := selectQuery.ToSql()
 	if err != nil {
@@ -316,17 +185,24 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 	for rows.Next() {
 		var link models.Link
 
-		var tagsArr, filtersArr []string
+		var tagsArr, filtersArr, keywordsArr []string
 
 		err := rows.Scan(
 			&link.ID,
 			&link.URL,
 			&link.Type,
+			&link.Site,
+			&link.ETag,
+			&link.LastModified,
+			&link.Active,
+			&link.GoneChecks,
 			&link.LastChecked,
 			&link.LastUpdated,
 			&link.CreatedAt,
 			&tagsArr,
 			&filtersArr,
+			&keywordsArr,
+			&link.MutedUntil,
 		)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование ссылки", Cause: err}
@@ -334,6 +210,7 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 
 		link.Tags = tagsArr
 		link.Filters = filtersArr
+		link.Keywords = keywordsArr
 		links = append(links, &link)
 	}
 
@@ -392,6 +269,11 @@ func (r *LinkRepository) Update(ctx context.Context, link *models.Link) error {
 	updateQuery := r.sq.Update("links").
 		Set("url", link.URL).
 		Set("type", link.Type).
+		Set("site", link.Site).
+		Set("etag", link.ETag).
+		Set("last_modified", link.LastModified).
+		Set("active", link.Active).
+		Set("gone_checks", link.GoneChecks).
 		Set("last_checked", link.LastChecked).
 		Set("last_updated", link.LastUpdated).
 		Where(sq.Eq{"id": link.ID})
@@ -406,71 +288,230 @@ func (r *LinkRepository) Update(ctx context.Context, link *models.Link) error {
 		return &customerrors.ErrSQLExecution{Operation: "обновление ссылки", Cause: err}
 	}
 
-	if len(link.Tags) > 0 {
-		deleteTagsQuery := r.sq.Delete("link_tags").Where(sq.Eq{"link_id": link.ID})
-
-		query, args, err = deleteTagsQuery.ToSql()
-		if err != nil {
-			return &customerrors.ErrBuildSQLQuery{Operation: "удаление тегов", Cause: err}
-		}
+	return nil
+}
 
-		_, err = querier.Exec(ctx, query, args...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "удаление тегов", Cause: err}
-		}
+// AddSubscription подписывает чат на ссылку. Повторная подписка заменяет теги, фильтры, ключевые слова
+// и режим уведомлений.
+func (r *LinkRepository) AddSubscription(ctx context.Context, subscription *models.Subscription) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-		err = r.saveTags(ctx, querier, link.ID, link.Tags)
-		if err != nil {
-			return err
-		}
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now()
 	}
 
-	if len(link.Filters) > 0 {
-		deleteFiltersQuery := r.sq.Delete("filters").Where(sq.Eq{"link_id": link.ID})
-
-		query, args, err = deleteFiltersQuery.ToSql()
-		if err != nil {
-			return &customerrors.ErrBuildSQLQuery{Operation: "удаление фильтров", Cause: err}
-		}
+	insertQuery := r.sq.Insert("chat_links").
+		Columns("chat_id", "link_id", "tags", "filters", "keywords", "mode", "created_at").
+		Values(subscription.ChatID, subscription.LinkID, nonNilStrings(subscription.Tags),
+			nonNilStrings(subscription.Filters), nonNilStrings(subscription.Keywords), subscription.Mode,
+			subscription.CreatedAt).
+		Suffix("ON CONFLICT (chat_id, link_id) DO UPDATE " +
+			"SET tags = EXCLUDED.tags, filters = EXCLUDED.filters, keywords = EXCLUDED.keywords, mode = EXCLUDED.mode")
 
-		_, err = querier.Exec(ctx, query, args...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "удаление фильтров", Cause: err}
-		}
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "добавление подписки", Cause: err}
+	}
 
-		err = r.saveFilters(ctx, querier, link.ID, link.Filters)
-		if err != nil {
-			return err
-		}
+	_, err = querier.Exec(ctx, query, args...)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "добавление подписки чата на ссылку", Cause: err}
 	}
 
 	return nil
 }
 
-func (r *LinkRepository) AddChatLink(ctx context.Context, chatID, linkID int64) error {
+// MergeSubscription добавляет подписку чата на ссылку, а если чат уже подписан, объединяет подписки так,
+// чтобы чат получал всё, что получал по любой из них: теги и ключевые слова объединяются, из фильтров
+// остаются общие, режим уведомлений сохраняется прежний. Объединённая подписка заглушена, только если
+// заглушены обе, и до более ранней из заглушек; пропущенные обновления складываются.
+func (r *LinkRepository) MergeSubscription(ctx context.Context, subscription *models.Subscription) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now()
+	}
+
 	insertQuery := r.sq.Insert("chat_links").
-		Columns("chat_id", "link_id").
-		Values(chatID, linkID).
-		Suffix("ON CONFLICT DO NOTHING")
+		Columns("chat_id", "link_id", "tags", "filters", "keywords", "mode", "muted_until", "mute_summary",
+			"muted_updates", "created_at").
+		Values(subscription.ChatID, subscription.LinkID, nonNilStrings(subscription.Tags),
+			nonNilStrings(subscription.Filters), nonNilStrings(subscription.Keywords), subscription.Mode,
+			subscription.MutedUntil, subscription.MuteSummary, subscription.MutedUpdates, subscription.CreatedAt).
+		Suffix("ON CONFLICT (chat_id, link_id) DO UPDATE " +
+			"SET tags = ARRAY(SELECT DISTINCT unnest(chat_links.tags || EXCLUDED.tags) ORDER BY 1), " +
+			"filters = ARRAY(SELECT unnest(chat_links.filters) INTERSECT SELECT unnest(EXCLUDED.filters) ORDER BY 1), " +
+			"keywords = CASE WHEN chat_links.keywords = '{}' OR EXCLUDED.keywords = '{}' THEN '{}'::TEXT[] " +
+			"ELSE ARRAY(SELECT DISTINCT unnest(chat_links.keywords || EXCLUDED.keywords) ORDER BY 1) END, " +
+			"muted_until = CASE WHEN chat_links.muted_until IS NULL OR EXCLUDED.muted_until IS NULL THEN NULL " +
+			"ELSE LEAST(chat_links.muted_until, EXCLUDED.muted_until) END, " +
+			"mute_summary = chat_links.mute_summary OR EXCLUDED.mute_summary, " +
+			"muted_updates = chat_links.muted_updates + EXCLUDED.muted_updates")
 
 	query, args, err := insertQuery.ToSql()
 	if err != nil {
-		return &customerrors.ErrBuildSQLQuery{Operation: "добавление связи", Cause: err}
+		return &customerrors.ErrBuildSQLQuery{Operation: "объединение подписок", Cause: err}
 	}
 
 	_, err = querier.Exec(ctx, query, args...)
 	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "добавление связи чата и ссылки", Cause: err}
+		return &customerrors.ErrSQLExecution{Operation: "объединение подписок чата на ссылку", Cause: err}
+	}
+
+	return nil
+}
+
+// FindSubscriptions возвращает подписки всех чатов на ссылку.
+func (r *LinkRepository) FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error) {
+	return r.findSubscriptions(ctx, sq.Eq{"link_id": linkID}, "chat_id", "запрос подписок на ссылку")
+}
+
+// MuteSubscription заглушает подписку чата на ссылку до until и обнуляет счётчик пропущенных обновлений.
+func (r *LinkRepository) MuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time, summary bool) error {
+	updateQuery := r.sq.Update("chat_links").
+		Set("muted_until", until).
+		Set("mute_summary", summary).
+		Set("muted_updates", 0).
+		Where(sq.Eq{"chat_id": chatID, "link_id": linkID})
+
+	return r.execSubscriptionUpdate(ctx, updateQuery, chatID, linkID, "заглушение подписки")
+}
+
+// UnmuteSubscription снимает заглушку с подписки чата на ссылку, если подписка заглушена не дольше чем до until,
+// и возвращает число пропущенных обновлений. unmuted = false, если заглушку уже сняли или продлили: так сводку
+// пропущенных обновлений получит только тот, кто действительно снял заглушку.
+func (r *LinkRepository) UnmuteSubscription(ctx context.Context, chatID, linkID int64,
+	until time.Time) (missed int, unmuted bool, err error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	updateQuery := r.sq.Update("chat_links").
+		Set("muted_until", nil).
+		Where(sq.Eq{"chat_id": chatID, "link_id": linkID}).
+		Where(sq.LtOrEq{"muted_until": until}).
+		Suffix("RETURNING muted_updates")
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return 0, false, &customerrors.ErrBuildSQLQuery{Operation: "снятие заглушки с подписки", Cause: err}
+	}
+
+	err = querier.QueryRow(ctx, query, args...).Scan(&missed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, &customerrors.ErrSQLExecution{Operation: "снятие заглушки с подписки", Cause: err}
+	}
+
+	return missed, true, nil
+}
+
+// AddMutedUpdates увеличивает счётчик обновлений, пропущенных заглушенной подпиской.
+// Если заглушку уже сняли, счётчик не меняется: его обнулит следующая заглушка.
+func (r *LinkRepository) AddMutedUpdates(ctx context.Context, chatID, linkID int64, count int) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	updateQuery := r.sq.Update("chat_links").
+		Set("muted_updates", sq.Expr("muted_updates + ?", count)).
+		Where(sq.Eq{"chat_id": chatID, "link_id": linkID}).
+		Where(sq.NotEq{"muted_until": nil})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "подсчёт пропущенных обновлений", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "подсчёт пропущенных обновлений", Cause: err}
+	}
+
+	return nil
+}
+
+// FindExpiredMutes возвращает подписки, заглушка которых закончилась к моменту now.
+func (r *LinkRepository) FindExpiredMutes(ctx context.Context, now time.Time) ([]*models.Subscription, error) {
+	return r.findSubscriptions(ctx, sq.LtOrEq{"muted_until": now}, "muted_until, chat_id", "поиск истёкших заглушек")
+}
+
+func (r *LinkRepository) findSubscriptions(ctx context.Context, where sq.Sqlizer, orderBy string,
+	operation string) ([]*models.Subscription, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("chat_id", "link_id", "tags", "filters", "keywords", "mode",
+		"muted_until", "mute_summary", "muted_updates", "created_at").
+		From("chat_links").
+		Where(where).
+		OrderBy(orderBy)
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: operation, Cause: err}
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: operation, Cause: err}
+	}
+	defer rows.Close()
+
+	var subscriptions []*models.Subscription
+
+	for rows.Next() {
+		subscription := &models.Subscription{}
+
+		err := rows.Scan(
+			&subscription.ChatID,
+			&subscription.LinkID,
+			&subscription.Tags,
+			&subscription.Filters,
+			&subscription.Keywords,
+			&subscription.Mode,
+			&subscription.MutedUntil,
+			&subscription.MuteSummary,
+			&subscription.MutedUpdates,
+			&subscription.CreatedAt,
+		)
+		if err != nil {
+			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование подписки", Cause: err}
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов запроса подписок", Cause: err}
+	}
+
+	return subscriptions, nil
+}
+
+func (r *LinkRepository) execSubscriptionUpdate(ctx context.Context, updateQuery sq.UpdateBuilder,
+	chatID, linkID int64, operation string) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: operation, Cause: err}
+	}
+
+	result, err := querier.Exec(ctx, query, args...)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: operation, Cause: err}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrLinkNotInChat{ChatID: chatID, LinkID: linkID}
 	}
 
 	return nil
 }
 
 func (r *LinkRepository) FindDue(ctx context.Context, limit, offset int) ([]*models.Link, error) {
-	selectQuery := r.sq.Select("id", "url", "type", "last_checked", "last_updated", "created_at").
+	selectQuery := r.sq.Select("id", "url", "type", "site", "etag", "last_modified", "active", "gone_checks",
+		"last_checked", "last_updated", "created_at").
 		From("links").
+		Where(sq.Eq{"active": true}).
 		OrderBy("last_checked NULLS FIRST, last_updated NULLS FIRST, created_at ASC, id ASC")
 
 	if limit > 0 {
@@ -497,7 +538,8 @@ func (r *LinkRepository) FindDue(ctx context.Context, limit, offset int) ([]*mod
 	for rows.Next() {
 		var link models.Link
 
-		err = rows.Scan(&link.ID, &link.URL, &link.Type, &link.LastChecked, &link.LastUpdated, &link.CreatedAt)
+		err = rows.Scan(&link.ID, &link.URL, &link.Type, &link.Site, &link.ETag, &link.LastModified, &link.Active, &link.GoneChecks,
+			&link.LastChecked, &link.LastUpdated, &link.CreatedAt)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование ссылки", Cause: err}
 		}
@@ -533,71 +575,51 @@ func (r *LinkRepository) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-func (r *LinkRepository) SaveTags(ctx context.Context, linkID int64, tags []string) error {
-	querier := txs.GetQuerier(ctx, r.db.Pool)
-
-	deleteQuery := r.sq.Delete("link_tags").
-		Where(sq.Eq{"link_id": linkID})
-
-	query, args, err := deleteQuery.ToSql()
-	if err != nil {
-		return &customerrors.ErrBuildSQLQuery{Operation: "удаление тегов", Cause: err}
-	}
-
-	_, err = querier.Exec(ctx, query, args...)
-	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "удаление существующих тегов", Cause: err}
-	}
-
-	if len(tags) > 0 {
-		err = r.saveTags(ctx, querier, linkID, tags)
-		if err != nil {
-			return err
-		}
-	}
+// SaveTags заменяет теги подписки чата на ссылку.
+func (r *LinkRepository) SaveTags(ctx context.Context, chatID, linkID int64, tags []string) error {
+	return r.updateSubscription(ctx, chatID, linkID, "tags", tags, "сохранение тегов подписки")
+}
 
-	return nil
+// SaveFilters заменяет фильтры подписки чата на ссылку.
+func (r *LinkRepository) SaveFilters(ctx context.Context, chatID, linkID int64, filters []string) error {
+	return r.updateSubscription(ctx, chatID, linkID, "filters", filters, "сохранение фильтров подписки")
 }
 
-func (r *LinkRepository) SaveFilters(ctx context.Context, linkID int64, filters []string) error {
+func (r *LinkRepository) updateSubscription(ctx context.Context, chatID, linkID int64,
+	column string, values []string, operation string) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-	deleteQuery := r.sq.Delete("filters").
-		Where(sq.Eq{"link_id": linkID})
+	updateQuery := r.sq.Update("chat_links").
+		Set(column, nonNilStrings(values)).
+		Where(sq.Eq{"chat_id": chatID, "link_id": linkID})
 
-	query, args, err := deleteQuery.ToSql()
+	query, args, err := updateQuery.ToSql()
 	if err != nil {
-		return &customerrors.ErrBuildSQLQuery{Operation: "удаление фильтров", Cause: err}
+		return &customerrors.ErrBuildSQLQuery{Operation: operation, Cause: err}
 	}
 
-	_, err = querier.Exec(ctx, query, args...)
+	result, err := querier.Exec(ctx, query, args...)
 	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "удаление существующих фильтров", Cause: err}
+		return &customerrors.ErrSQLExecution{Operation: operation, Cause: err}
 	}
 
-	if len(filters) > 0 {
-		err = r.saveFilters(ctx, querier, linkID, filters)
-		if err != nil {
-			return err
-		}
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrLinkNotInChat{ChatID: chatID, LinkID: linkID}
 	}
 
 	return nil
 }
 
+// GetAll возвращает все активные ссылки: неактивные, как и в FindDue, больше не проверяются.
 func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	selectQuery := r.sq.Select(
-		"l.id", "l.url", "l.type", "l.last_checked", "l.last_updated", "l.created_at",
-		"COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags",
-		"COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters",
+		"l.id", "l.url", "l.type", "l.site", "l.etag", "l.last_modified", "l.active", "l.gone_checks",
+		"l.last_checked", "l.last_updated", "l.created_at",
 	).
 		From("links l").
-		LeftJoin("link_tags lt ON l.id = lt.link_id").
-		LeftJoin("tags t ON lt.tag_id = t.id").
-		LeftJoin("filters f ON l.id = f.link_id").
-		GroupBy("l.id").
+		Where(sq.Eq{"l.active": true}).
 		OrderBy("l.id")
 
 	query, args, err := selectQuery.ToSql()
@@ -616,24 +638,23 @@ func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	for rows.Next() {
 		var link models.Link
 
-		var tagsArr, filtersArr []string
-
 		err := rows.Scan(
 			&link.ID,
 			&link.URL,
 			&link.Type,
+			&link.Site,
+			&link.ETag,
+			&link.LastModified,
+			&link.Active,
+			&link.GoneChecks,
 			&link.LastChecked,
 			&link.LastUpdated,
 			&link.CreatedAt,
-			&tagsArr,
-			&filtersArr,
 		)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование ссылки", Cause: err}
 		}
 
-		link.Tags = tagsArr
-		link.Filters = filtersArr
 		links = append(links, &link)
 	}
 
@@ -647,6 +668,8 @@ func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 func (r *LinkRepository) DeleteByURL(ctx context.Context, url string, chatID int64) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	url = common.CanonicalizeURL(url)
+
 	linkID, err := r.getLinkIDByURL(ctx, querier, url)
 	if err != nil {
 		return err
@@ -659,3 +682,12 @@ func (r *LinkRepository) DeleteByURL(ctx context.Context, url string, chatID int
 
 	return nil
 }
+
+// nonNilStrings заменяет nil на пустой срез: столбцы tags, filters и keywords не допускают NULL.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
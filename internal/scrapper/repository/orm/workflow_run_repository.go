@@ -0,0 +1,78 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+	"github.com/jackc/pgx/v5"
+)
+
+type WorkflowRunRepository struct {
+	db *database.PostgresDB
+	sq sq.StatementBuilderType
+}
+
+func NewWorkflowRunRepository(db *database.PostgresDB) *WorkflowRunRepository {
+	return &WorkflowRunRepository{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *WorkflowRunRepository) GetLastRun(ctx context.Context, url string) (*models.WorkflowRun, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	selectQuery := r.sq.Select("wr.run_id", "wr.conclusion", "wr.updated_at").
+		From("workflow_runs wr").
+		Join("links l ON l.id = wr.link_id").
+		Where(sq.Eq{"l.url": url})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, &customerrors.ErrBuildSQLQuery{Operation: "получение последнего запуска workflow", Cause: err}
+	}
+
+	run := &models.WorkflowRun{}
+
+	err = querier.QueryRow(ctx, query, args...).Scan(&run.ID, &run.Conclusion, &run.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение последнего запуска workflow", Cause: err}
+	}
+
+	return run, nil
+}
+
+func (r *WorkflowRunRepository) SaveLastRun(ctx context.Context, url string, run *models.WorkflowRun) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	insertQuery := r.sq.Insert("workflow_runs").
+		Columns("link_id", "run_id", "conclusion", "updated_at").
+		Select(r.sq.Select("id").
+			Column("?::bigint", run.ID).
+			Column("?::text", run.Conclusion).
+			Column("?::timestamptz", run.UpdatedAt).
+			From("links").
+			Where(sq.Eq{"url": url})).
+		Suffix("ON CONFLICT (link_id) DO UPDATE SET run_id = EXCLUDED.run_id, " +
+			"conclusion = EXCLUDED.conclusion, updated_at = EXCLUDED.updated_at")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return &customerrors.ErrBuildSQLQuery{Operation: "сохранение последнего запуска workflow", Cause: err}
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение последнего запуска workflow", Cause: err}
+	}
+
+	return nil
+}
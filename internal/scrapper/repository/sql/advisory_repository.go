@@ -0,0 +1,54 @@
+package sql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+	"github.com/jackc/pgx/v5"
+)
+
+type AdvisoryRepository struct {
+	db *database.PostgresDB
+}
+
+func NewAdvisoryRepository(db *database.PostgresDB) *AdvisoryRepository {
+	return &AdvisoryRepository{db: db}
+}
+
+func (r *AdvisoryRepository) GetSeenAdvisories(ctx context.Context, url string) (keys []string, checked bool, err error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	err = querier.QueryRow(ctx, `
+		SELECT sa.keys
+		FROM seen_advisories sa
+		JOIN links l ON l.id = sa.link_id
+		WHERE l.url = $1`, url).Scan(&keys)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, &customerrors.ErrSQLExecution{Operation: "получение учтённых бюллетеней", Cause: err}
+	}
+
+	return keys, true, nil
+}
+
+// ReplaceSeenAdvisories заменяет ключи учтённых бюллетеней ссылки текущими.
+func (r *AdvisoryRepository) ReplaceSeenAdvisories(ctx context.Context, url string, keys []string) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	_, err := querier.Exec(ctx, `
+		INSERT INTO seen_advisories (link_id, keys, checked_at)
+		SELECT id, $2, NOW() FROM links WHERE url = $1
+		ON CONFLICT (link_id) DO UPDATE
+		SET keys = EXCLUDED.keys, checked_at = EXCLUDED.checked_at`, url, nonNilStrings(keys))
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение учтённых бюллетеней", Cause: err}
+	}
+
+	return nil
+}
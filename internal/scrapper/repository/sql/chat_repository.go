@@ -62,7 +62,8 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	row := querier.QueryRow(ctx, `
-		SELECT c.id, c.notification_mode, c.digest_time, c.created_at, c.updated_at,
+		SELECT c.id, c.notification_mode, c.digest_time, c.quiet_start, c.quiet_end, c.timezone,
+			c.created_at, c.updated_at,
 			COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links
 		FROM chats c
 		LEFT JOIN chat_links cl ON c.id = cl.chat_id
@@ -72,7 +73,9 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 
 	var chat models.Chat
 
-	var notificationMode string
+	var notificationMode, timezone string
+
+	var quietStart, quietEnd *time.Time
 
 	var linksArr []int64
 
@@ -80,6 +83,9 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 		&chat.ID,
 		&notificationMode,
 		&chat.DigestTime,
+		&quietStart,
+		&quietEnd,
+		&timezone,
 		&chat.CreatedAt,
 		&chat.UpdatedAt,
 		&linksArr,
@@ -93,6 +99,7 @@ func (r *ChatRepository) FindByID(ctx context.Context, id int64) (*models.Chat,
 	}
 
 	chat.NotificationMode = models.NotificationMode(notificationMode)
+	chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 	chat.Links = linksArr
 
 	return &chat, nil
@@ -154,17 +161,46 @@ func (r *ChatRepository) UpdateNotificationSettings(
 	return nil
 }
 
+// UpdateQuietHours сохраняет часы тишины чата. Значение nil отключает их.
+func (r *ChatRepository) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	var start, end *time.Time
+
+	timezone := "UTC"
+
+	if quietHours != nil {
+		start, end = &quietHours.Start, &quietHours.End
+		timezone = quietHours.Timezone
+	}
+
+	result, err := querier.Exec(ctx,
+		"UPDATE chats SET quiet_start = $1, quiet_end = $2, timezone = $3, updated_at = $4 WHERE id = $5",
+		start, end, timezone, time.Now(), chatID)
+	if err != nil {
+		return fmt.Errorf("ошибка при обновлении часов тишины: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrChatNotFound{ChatID: chatID}
+	}
+
+	return nil
+}
+
 func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int) ([]*models.Chat, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	timeStr := fmt.Sprintf("%02d:%02d", hour, minute)
 
 	rows, err := querier.Query(ctx, `
-		SELECT c.id, c.notification_mode, c.digest_time, c.created_at, c.updated_at,
+		SELECT c.id, c.notification_mode, c.digest_time, c.quiet_start, c.quiet_end, c.timezone,
+			c.created_at, c.updated_at,
 			COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links
 		FROM chats c
 		LEFT JOIN chat_links cl ON c.id = cl.chat_id
-		WHERE c.notification_mode = $1 AND 
+		WHERE (c.notification_mode = $1 OR
+		       EXISTS (SELECT 1 FROM chat_links dl WHERE dl.chat_id = c.id AND dl.mode = $1)) AND
 		      to_char(c.digest_time, 'HH24:MI') = $2
 		GROUP BY c.id
 		ORDER BY c.id
@@ -179,7 +215,9 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 	for rows.Next() {
 		var chat models.Chat
 
-		var notificationMode string
+		var notificationMode, timezone string
+
+		var quietStart, quietEnd *time.Time
 
 		var linksArr []int64
 
@@ -187,6 +225,9 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 			&chat.ID,
 			&notificationMode,
 			&chat.DigestTime,
+			&quietStart,
+			&quietEnd,
+			&timezone,
 			&chat.CreatedAt,
 			&chat.UpdatedAt,
 			&linksArr,
@@ -196,6 +237,7 @@ func (r *ChatRepository) FindByDigestTime(ctx context.Context, hour, minute int)
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = linksArr
 		chats = append(chats, &chat)
 	}
@@ -281,7 +323,8 @@ func (r *ChatRepository) FindByLinkID(ctx context.Context, linkID int64) ([]*mod
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	rows, err := querier.Query(ctx,
-		`SELECT c.id, c.notification_mode, c.digest_time, c.created_at, c.updated_at
+		`SELECT c.id, c.notification_mode, c.digest_time, c.quiet_start, c.quiet_end, c.timezone,
+			c.created_at, c.updated_at
 		FROM chats c
 		JOIN chat_links cl ON c.id = cl.chat_id
 		WHERE cl.link_id = $1`,
@@ -296,14 +339,18 @@ func (r *ChatRepository) FindByLinkID(ctx context.Context, linkID int64) ([]*mod
 	for rows.Next() {
 		chat := &models.Chat{}
 
-		var notificationMode string
+		var notificationMode, timezone string
 
-		err := rows.Scan(&chat.ID, &notificationMode, &chat.DigestTime, &chat.CreatedAt, &chat.UpdatedAt)
+		var quietStart, quietEnd *time.Time
+
+		err := rows.Scan(&chat.ID, &notificationMode, &chat.DigestTime, &quietStart, &quietEnd, &timezone,
+			&chat.CreatedAt, &chat.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при сканировании чата: %w", err)
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = []int64{}
 		chats = append(chats, chat)
 	}
@@ -328,7 +375,8 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	rows, err := querier.Query(ctx, `
-		SELECT c.id, c.notification_mode, c.digest_time, c.created_at, c.updated_at,
+		SELECT c.id, c.notification_mode, c.digest_time, c.quiet_start, c.quiet_end, c.timezone,
+			c.created_at, c.updated_at,
 			COALESCE(array_agg(cl.link_id) FILTER (WHERE cl.link_id IS NOT NULL), '{}') AS links
 		FROM chats c
 		LEFT JOIN chat_links cl ON c.id = cl.chat_id
@@ -345,7 +393,9 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 	for rows.Next() {
 		var chat models.Chat
 
-		var notificationMode string
+		var notificationMode, timezone string
+
+		var quietStart, quietEnd *time.Time
 
 		var linksArr []int64
 
@@ -353,6 +403,9 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 			&chat.ID,
 			&notificationMode,
 			&chat.DigestTime,
+			&quietStart,
+			&quietEnd,
+			&timezone,
 			&chat.CreatedAt,
 			&chat.UpdatedAt,
 			&linksArr,
@@ -362,6 +415,7 @@ func (r *ChatRepository) GetAll(ctx context.Context) ([]*models.Chat, error) {
 		}
 
 		chat.NotificationMode = models.NotificationMode(notificationMode)
+		chat.QuietHours = quietHours(quietStart, quietEnd, timezone)
 		chat.Links = linksArr
 		chats = append(chats, &chat)
 	}
@@ -385,3 +439,12 @@ func (r *ChatRepository) ExistsChatLink(ctx context.Context, chatID, linkID int6
 
 	return exists, nil
 }
+
+// quietHours собирает часы тишины из столбцов чата. Пустой quiet_start означает, что они не заданы.
+func quietHours(start, end *time.Time, timezone string) *models.QuietHours {
+	if start == nil || end == nil {
+		return nil
+	}
+
+	return &models.QuietHours{Start: *start, End: *end, Timezone: timezone}
+}
@@ -73,3 +73,29 @@ func (r *ContentDetailsRepository) FindByLinkID(ctx context.Context, linkID int6
 
 	return details, nil
 }
+
+func (r *ContentDetailsRepository) FindByURL(ctx context.Context, url string) (*models.ContentDetails, error) {
+	details := &models.ContentDetails{}
+
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	var linkTypeStr string
+	err := querier.QueryRow(ctx,
+		`SELECT cd.link_id, cd.title, cd.author, cd.updated_at, cd.content_text, cd.link_type
+		 FROM content_details cd
+		 JOIN links l ON l.id = cd.link_id
+		 WHERE l.url = $1`, url).
+		Scan(&details.LinkID, &details.Title, &details.Author, &details.UpdatedAt, &details.ContentText, &linkTypeStr)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &customerrors.ErrLinkNotFound{URL: url}
+		}
+
+		return nil, fmt.Errorf("ошибка при поиске деталей контента: %w", err)
+	}
+
+	details.LinkType = models.LinkType(linkTypeStr)
+
+	return details, nil
+}
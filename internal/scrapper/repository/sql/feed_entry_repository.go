@@ -0,0 +1,74 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+)
+
+type FeedEntryRepository struct {
+	db *database.PostgresDB
+}
+
+func NewFeedEntryRepository(db *database.PostgresDB) *FeedEntryRepository {
+	return &FeedEntryRepository{db: db}
+}
+
+func (r *FeedEntryRepository) GetSeenGUIDs(ctx context.Context, url string) ([]string, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT fe.guid
+		FROM feed_entries fe
+		JOIN links l ON l.id = fe.link_id
+		WHERE l.url = $1`, url)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение просмотренных записей ленты", Cause: err}
+	}
+
+	defer rows.Close()
+
+	var guids []string
+
+	for rows.Next() {
+		var guid string
+		if err := rows.Scan(&guid); err != nil {
+			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование записей ленты", Cause: err}
+		}
+
+		guids = append(guids, guid)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "чтение записей ленты", Cause: err}
+	}
+
+	return guids, nil
+}
+
+// ReplaceSeenGUIDs оставляет в хранилище только переданные GUID: записи, выпавшие из ленты, больше не нужны.
+func (r *FeedEntryRepository) ReplaceSeenGUIDs(ctx context.Context, url string, guids []string) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	_, err := querier.Exec(ctx, `
+		INSERT INTO feed_entries (link_id, guid)
+		SELECT l.id, g.guid
+		FROM links l, unnest($2::text[]) AS g(guid)
+		WHERE l.url = $1
+		ON CONFLICT (link_id, guid) DO NOTHING`, url, guids)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение записей ленты", Cause: err}
+	}
+
+	_, err = querier.Exec(ctx, `
+		DELETE FROM feed_entries
+		WHERE link_id = (SELECT id FROM links WHERE url = $1)
+		AND NOT (guid = ANY($2::text[]))`, url, guids)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "удаление устаревших записей ленты", Cause: err}
+	}
+
+	return nil
+}
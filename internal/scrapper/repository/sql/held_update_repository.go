@@ -0,0 +1,110 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+)
+
+type HeldUpdateRepository struct {
+	db *database.PostgresDB
+}
+
+func NewHeldUpdateRepository(db *database.PostgresDB) *HeldUpdateRepository {
+	return &HeldUpdateRepository{db: db}
+}
+
+func (r *HeldUpdateRepository) Add(ctx context.Context, chatID int64, update *models.LinkUpdate) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	keywords := update.Keywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+
+	_, err := querier.Exec(ctx, `
+		INSERT INTO held_updates (chat_id, link_id, url, description, update_info, keywords)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		chatID, update.ID, update.URL, update.Description, update.UpdateInfo, keywords)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение отложенного уведомления", Cause: err}
+	}
+
+	return nil
+}
+
+func (r *HeldUpdateRepository) FindChatIDs(ctx context.Context) ([]int64, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	rows, err := querier.Query(ctx, "SELECT DISTINCT chat_id FROM held_updates ORDER BY chat_id")
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "поиск чатов с отложенными уведомлениями", Cause: err}
+	}
+	defer rows.Close()
+
+	var chatIDs []int64
+
+	for rows.Next() {
+		var chatID int64
+		if err := rows.Scan(&chatID); err != nil {
+			return nil, &customerrors.ErrSQLScan{Entity: "ID чата", Cause: err}
+		}
+
+		chatIDs = append(chatIDs, chatID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов", Cause: err}
+	}
+
+	return chatIDs, nil
+}
+
+func (r *HeldUpdateRepository) FindByChatID(ctx context.Context, chatID int64) ([]*models.HeldUpdate, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT id, chat_id, link_id, url, description, update_info, keywords, created_at
+		FROM held_updates
+		WHERE chat_id = $1
+		ORDER BY id`, chatID)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение отложенных уведомлений", Cause: err}
+	}
+	defer rows.Close()
+
+	var held []*models.HeldUpdate
+
+	for rows.Next() {
+		item := &models.HeldUpdate{Update: &models.LinkUpdate{}}
+
+		err := rows.Scan(&item.ID, &item.ChatID, &item.Update.ID, &item.Update.URL, &item.Update.Description,
+			&item.Update.UpdateInfo, &item.Update.Keywords, &item.CreatedAt)
+		if err != nil {
+			return nil, &customerrors.ErrSQLScan{Entity: "отложенное уведомление", Cause: err}
+		}
+
+		item.Update.TgChatIDs = []int64{item.ChatID}
+		held = append(held, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов", Cause: err}
+	}
+
+	return held, nil
+}
+
+func (r *HeldUpdateRepository) Delete(ctx context.Context, ids []int64) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	_, err := querier.Exec(ctx, "DELETE FROM held_updates WHERE id = ANY($1)", ids)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "удаление отложенных уведомлений", Cause: err}
+	}
+
+	return nil
+}
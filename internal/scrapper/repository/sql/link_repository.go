@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/database"
 	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
@@ -26,6 +26,8 @@ func NewLinkRepository(db *database.PostgresDB) *LinkRepository {
 func (r *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	link.URL = common.CanonicalizeURL(link.URL)
+
 	now := time.Now()
 	if link.CreatedAt.IsZero() {
 		link.CreatedAt = now
@@ -34,8 +36,9 @@ func (r *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	var id int64
 
 	err := querier.QueryRow(ctx,
-		"INSERT INTO links (url, type, last_checked, last_updated, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id",
-		link.URL, link.Type, link.LastChecked, link.LastUpdated, link.CreatedAt).Scan(&id)
+		`INSERT INTO links (url, type, site, etag, last_modified, last_checked, last_updated, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+		link.URL, link.Type, link.Site, link.ETag, link.LastModified, link.LastChecked, link.LastUpdated, link.CreatedAt).Scan(&id)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -46,126 +49,7 @@ func (r *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	}
 
 	link.ID = id
-
-	if len(link.Tags) > 0 {
-		err = r.saveTags(ctx, querier, id, link.Tags)
-		if err != nil {
-			return err
-		}
-	}
-
-	if len(link.Filters) > 0 {
-		err = r.saveFilters(ctx, querier, id, link.Filters)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-//nolint:funlen // Функция целостная
-func (r *LinkRepository) saveTags(ctx context.Context, querier txs.Querier, linkID int64, tags []string) error {
-	if len(tags) == 0 {
-		return nil
-	}
-
-	{
-		values := make([]any, 0, len(tags))
-
-		placeholders := make([]string, 0, len(tags))
-		for i, tag := range tags {
-			placeholders = append(placeholders, fmt.Sprintf("($%d)", i+1))
-			values = append(values, tag)
-		}
-
-		query := fmt.Sprintf("INSERT INTO tags (name) VALUES %s ON CONFLICT (name) DO NOTHING", strings.Join(placeholders, ", "))
-
-		_, err := querier.Exec(ctx, query, values...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "batch-сохранение тегов", Cause: err}
-		}
-	}
-
-	{
-		var values []any
-
-		placeholders := make([]string, 0, len(tags))
-		for i, tag := range tags {
-			placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
-			values = append(values, tag)
-		}
-
-		query := fmt.Sprintf("SELECT id, name FROM tags WHERE name IN (%s)", strings.Join(placeholders, ", "))
-
-		rows, err := querier.Query(ctx, query, values...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "получение id тегов", Cause: err}
-		}
-
-		defer rows.Close()
-
-		ids := make(map[string]int64, len(tags))
-
-		for rows.Next() {
-			var id int64
-
-			var name string
-			if err := rows.Scan(&id, &name); err != nil {
-				return &customerrors.ErrSQLExecution{Operation: "сканирование id тегов", Cause: err}
-			}
-
-			ids[name] = id
-		}
-
-		if err := rows.Err(); err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "чтение id тегов", Cause: err}
-		}
-
-		var linkTagValues []any
-
-		linkTagPlaceholders := make([]string, 0, len(tags))
-
-		for i, tag := range tags {
-			id, ok := ids[tag]
-			if !ok {
-				return &customerrors.ErrSQLExecution{Operation: "batch-связывание ссылки с тегом", Cause: fmt.Errorf("id не найден для тега %s", tag)}
-			}
-
-			linkTagPlaceholders = append(linkTagPlaceholders, fmt.Sprintf("($%d, $%d)", 2*i+1, 2*i+2))
-			linkTagValues = append(linkTagValues, linkID, id)
-		}
-
-		query = fmt.Sprintf("INSERT INTO link_tags (link_id, tag_id) VALUES %s ON CONFLICT DO NOTHING", strings.Join(linkTagPlaceholders, ", "))
-
-		_, err = querier.Exec(ctx, query, linkTagValues...)
-		if err != nil {
-			return &customerrors.ErrSQLExecution{Operation: "batch-связывание ссылки с тегами", Cause: err}
-		}
-	}
-
-	return nil
-}
-
-func (r *LinkRepository) saveFilters(ctx context.Context, querier txs.Querier, linkID int64, filters []string) error {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	values := make([]any, 0, len(filters)*2)
-
-	placeholders := make([]string, 0, len(filters))
-	for i, filter := range filters {
-		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d)", 2*i+1, 2*i+2))
-		values = append(values, filter, linkID)
-	}
-
-	query := fmt.Sprintf("INSERT INTO filters (value, link_id) VALUES %s", strings.Join(placeholders, ", "))
-
-	_, err := querier.Exec(ctx, query, values...)
-	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "batch-сохранение фильтров", Cause: err}
-	}
+	link.Active = true
 
 	return nil
 }
@@ -174,30 +58,25 @@ func (r *LinkRepository) FindByID(ctx context.Context, id int64) (*models.Link,
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	row := querier.QueryRow(ctx, `
-		SELECT l.id, l.url, l.type, l.last_checked, l.last_updated, l.created_at,
-			COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags,
-			COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters
-		FROM links l
-		LEFT JOIN link_tags lt ON l.id = lt.link_id
-		LEFT JOIN tags t ON lt.tag_id = t.id
-		LEFT JOIN filters f ON l.id = f.link_id
-		WHERE l.id = $1
-		GROUP BY l.id
+		SELECT id, url, type, site, etag, last_modified, active, gone_checks, last_checked, last_updated, created_at
+		FROM links
+		WHERE id = $1
 	`, id)
 
 	var link models.Link
 
-	var tagsArr, filtersArr []string
-
 	err := row.Scan(
 		&link.ID,
 		&link.URL,
 		&link.Type,
+		&link.Site,
+		&link.ETag,
+		&link.LastModified,
+		&link.Active,
+		&link.GoneChecks,
 		&link.LastChecked,
 		&link.LastUpdated,
 		&link.CreatedAt,
-		&tagsArr,
-		&filtersArr,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -207,15 +86,14 @@ func (r *LinkRepository) FindByID(ctx context.Context, id int64) (*models.Link,
 		return nil, &customerrors.ErrSQLExecution{Operation: "поиск ссылки по ID", Cause: err}
 	}
 
-	link.Tags = tagsArr
-	link.Filters = filtersArr
-
 	return &link, nil
 }
 
 func (r *LinkRepository) FindByURL(ctx context.Context, url string) (*models.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	url = common.CanonicalizeURL(url)
+
 	var id int64
 
 	err := querier.QueryRow(ctx, "SELECT id FROM links WHERE url = $1", url).Scan(&id)
@@ -234,16 +112,12 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	rows, err := querier.Query(ctx, `
-		SELECT l.id, l.url, l.type, l.last_checked, l.last_updated, l.created_at,
-			COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags,
-			COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters
+		SELECT l.id, l.url, l.type, l.site, l.etag, l.last_modified, l.active, l.gone_checks, l.last_checked, l.last_updated, l.created_at,
+			cl.tags, cl.filters, cl.keywords, cl.muted_until
 		FROM links l
 		JOIN chat_links cl ON l.id = cl.link_id
-		LEFT JOIN link_tags lt ON l.id = lt.link_id
-		LEFT JOIN tags t ON lt.tag_id = t.id
-		LEFT JOIN filters f ON l.id = f.link_id
 		WHERE cl.chat_id = $1
-		GROUP BY l.id
+		ORDER BY cl.created_at, l.id
 	`, chatID)
 	if err != nil {
 		return nil, &customerrors.ErrSQLExecution{Operation: "запрос ссылок по ID чата", Cause: err}
@@ -255,17 +129,24 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 	for rows.Next() {
 		var link models.Link
 
-		var tagsArr, filtersArr []string
+		var tagsArr, filtersArr, keywordsArr []string
 
 		err := rows.Scan(
 			&link.ID,
 			&link.URL,
 			&link.Type,
+			&link.Site,
+			&link.ETag,
+			&link.LastModified,
+			&link.Active,
+			&link.GoneChecks,
 			&link.LastChecked,
 			&link.LastUpdated,
 			&link.CreatedAt,
 			&tagsArr,
 			&filtersArr,
+			&keywordsArr,
+			&link.MutedUntil,
 		)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование ссылки", Cause: err}
@@ -273,6 +154,7 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 
 		link.Tags = tagsArr
 		link.Filters = filtersArr
+		link.Keywords = keywordsArr
 		links = append(links, &link)
 	}
 
@@ -286,6 +168,8 @@ func (r *LinkRepository) FindByChatID(ctx context.Context, chatID int64) ([]*mod
 func (r *LinkRepository) DeleteByURL(ctx context.Context, url string, chatID int64) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
+	url = common.CanonicalizeURL(url)
+
 	var linkID int64
 
 	err := querier.QueryRow(ctx, "SELECT id FROM links WHERE url = $1", url).Scan(&linkID)
@@ -328,9 +212,11 @@ func (r *LinkRepository) Update(ctx context.Context, link *models.Link) error {
 
 	result, err := querier.Exec(ctx, `
 		UPDATE links 
-		SET url = $1, type = $2, last_checked = $3, last_updated = $4, created_at = $5
-		WHERE id = $6
-	`, link.URL, link.Type, link.LastChecked, link.LastUpdated, link.CreatedAt, link.ID)
+		SET url = $1, type = $2, site = $3, etag = $4, last_modified = $5, active = $6, gone_checks = $7,
+			last_checked = $8, last_updated = $9, created_at = $10
+		WHERE id = $11
+	`, link.URL, link.Type, link.Site, link.ETag, link.LastModified, link.Active, link.GoneChecks,
+		link.LastChecked, link.LastUpdated, link.CreatedAt, link.ID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -344,40 +230,197 @@ func (r *LinkRepository) Update(ctx context.Context, link *models.Link) error {
 		return &customerrors.ErrLinkNotFound{URL: link.URL}
 	}
 
-	if len(link.Tags) > 0 {
-		if err := r.SaveTags(ctx, link.ID, link.Tags); err != nil {
-			return err
-		}
+	return nil
+}
+
+// AddSubscription подписывает чат на ссылку. Повторная подписка заменяет теги, фильтры, ключевые слова
+// и режим уведомлений.
+func (r *LinkRepository) AddSubscription(ctx context.Context, subscription *models.Subscription) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now()
 	}
 
-	if len(link.Filters) > 0 {
-		if err := r.SaveFilters(ctx, link.ID, link.Filters); err != nil {
-			return err
-		}
+	_, err := querier.Exec(ctx, `
+		INSERT INTO chat_links (chat_id, link_id, tags, filters, keywords, mode, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (chat_id, link_id) DO UPDATE
+		SET tags = EXCLUDED.tags, filters = EXCLUDED.filters, keywords = EXCLUDED.keywords, mode = EXCLUDED.mode`,
+		subscription.ChatID, subscription.LinkID, nonNilStrings(subscription.Tags), nonNilStrings(subscription.Filters),
+		nonNilStrings(subscription.Keywords), subscription.Mode, subscription.CreatedAt)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "добавление подписки чата на ссылку", Cause: err}
+	}
+
+	return nil
+}
+
+// MergeSubscription добавляет подписку чата на ссылку, а если чат уже подписан, объединяет подписки так,
+// чтобы чат получал всё, что получал по любой из них: теги и ключевые слова объединяются, из фильтров
+// остаются общие, режим уведомлений сохраняется прежний. Объединённая подписка заглушена, только если
+// заглушены обе, и до более ранней из заглушек; пропущенные обновления складываются.
+func (r *LinkRepository) MergeSubscription(ctx context.Context, subscription *models.Subscription) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now()
+	}
+
+	_, err := querier.Exec(ctx, `
+		INSERT INTO chat_links (chat_id, link_id, tags, filters, keywords, mode, muted_until, mute_summary,
+			muted_updates, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (chat_id, link_id) DO UPDATE
+		SET tags = ARRAY(SELECT DISTINCT unnest(chat_links.tags || EXCLUDED.tags) ORDER BY 1),
+			filters = ARRAY(SELECT unnest(chat_links.filters) INTERSECT SELECT unnest(EXCLUDED.filters) ORDER BY 1),
+			keywords = CASE WHEN chat_links.keywords = '{}' OR EXCLUDED.keywords = '{}' THEN '{}'::TEXT[]
+				ELSE ARRAY(SELECT DISTINCT unnest(chat_links.keywords || EXCLUDED.keywords) ORDER BY 1) END,
+			muted_until = CASE WHEN chat_links.muted_until IS NULL OR EXCLUDED.muted_until IS NULL THEN NULL
+				ELSE LEAST(chat_links.muted_until, EXCLUDED.muted_until) END,
+			mute_summary = chat_links.mute_summary OR EXCLUDED.mute_summary,
+			muted_updates = chat_links.muted_updates + EXCLUDED.muted_updates`,
+		subscription.ChatID, subscription.LinkID, nonNilStrings(subscription.Tags), nonNilStrings(subscription.Filters),
+		nonNilStrings(subscription.Keywords), subscription.Mode, subscription.MutedUntil, subscription.MuteSummary,
+		subscription.MutedUpdates, subscription.CreatedAt)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "объединение подписок чата на ссылку", Cause: err}
+	}
+
+	return nil
+}
+
+// FindSubscriptions возвращает подписки всех чатов на ссылку.
+func (r *LinkRepository) FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT chat_id, link_id, tags, filters, keywords, mode, muted_until, mute_summary, muted_updates, created_at
+		FROM chat_links
+		WHERE link_id = $1
+		ORDER BY chat_id`, linkID)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "запрос подписок на ссылку", Cause: err}
+	}
+
+	return scanSubscriptions(rows)
+}
+
+// MuteSubscription заглушает подписку чата на ссылку до until и обнуляет счётчик пропущенных обновлений.
+func (r *LinkRepository) MuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time, summary bool) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	result, err := querier.Exec(ctx, `
+		UPDATE chat_links SET muted_until = $1, mute_summary = $2, muted_updates = 0
+		WHERE chat_id = $3 AND link_id = $4`,
+		until, summary, chatID, linkID)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "заглушение подписки", Cause: err}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrLinkNotInChat{ChatID: chatID, LinkID: linkID}
 	}
 
 	return nil
 }
 
-func (r *LinkRepository) AddChatLink(ctx context.Context, chatID, linkID int64) error {
+// UnmuteSubscription снимает заглушку с подписки чата на ссылку, если подписка заглушена не дольше чем до until,
+// и возвращает число пропущенных обновлений. unmuted = false, если заглушку уже сняли или продлили: так сводку
+// пропущенных обновлений получит только тот, кто действительно снял заглушку.
+func (r *LinkRepository) UnmuteSubscription(ctx context.Context, chatID, linkID int64,
+	until time.Time) (missed int, unmuted bool, err error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-	_, err := querier.Exec(ctx,
-		"INSERT INTO chat_links (chat_id, link_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
-		chatID, linkID)
+	err = querier.QueryRow(ctx, `
+		UPDATE chat_links SET muted_until = NULL
+		WHERE chat_id = $1 AND link_id = $2 AND muted_until <= $3
+		RETURNING muted_updates`,
+		chatID, linkID, until).Scan(&missed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+
 	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "добавление связи чата с ссылкой", Cause: err}
+		return 0, false, &customerrors.ErrSQLExecution{Operation: "снятие заглушки с подписки", Cause: err}
+	}
+
+	return missed, true, nil
+}
+
+// AddMutedUpdates увеличивает счётчик обновлений, пропущенных заглушенной подпиской.
+// Если заглушку уже сняли, счётчик не меняется: его обнулит следующая заглушка.
+func (r *LinkRepository) AddMutedUpdates(ctx context.Context, chatID, linkID int64, count int) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE chat_links SET muted_updates = muted_updates + $1
+		WHERE chat_id = $2 AND link_id = $3 AND muted_until IS NOT NULL`,
+		count, chatID, linkID)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "подсчёт пропущенных обновлений", Cause: err}
 	}
 
 	return nil
 }
 
+// FindExpiredMutes возвращает подписки, заглушка которых закончилась к моменту now.
+func (r *LinkRepository) FindExpiredMutes(ctx context.Context, now time.Time) ([]*models.Subscription, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT chat_id, link_id, tags, filters, keywords, mode, muted_until, mute_summary, muted_updates, created_at
+		FROM chat_links
+		WHERE muted_until <= $1
+		ORDER BY muted_until, chat_id`, now)
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "поиск истёкших заглушек", Cause: err}
+	}
+
+	return scanSubscriptions(rows)
+}
+
+func scanSubscriptions(rows pgx.Rows) ([]*models.Subscription, error) {
+	defer rows.Close()
+
+	var subscriptions []*models.Subscription
+
+	for rows.Next() {
+		subscription := &models.Subscription{}
+
+		err := rows.Scan(
+			&subscription.ChatID,
+			&subscription.LinkID,
+			&subscription.Tags,
+			&subscription.Filters,
+			&subscription.Keywords,
+			&subscription.Mode,
+			&subscription.MutedUntil,
+			&subscription.MuteSummary,
+			&subscription.MutedUpdates,
+			&subscription.CreatedAt,
+		)
+		if err != nil {
+			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование подписки", Cause: err}
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "обработка результатов запроса подписок", Cause: err}
+	}
+
+	return subscriptions, nil
+}
+
 func (r *LinkRepository) FindDue(ctx context.Context, limit, offset int) ([]*models.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	query := `
-		SELECT id, url, type, last_checked, last_updated, created_at 
+		SELECT id, url, type, site, etag, last_modified, active, gone_checks, last_checked, last_updated, created_at 
 		FROM links 
+		WHERE active
 		ORDER BY last_checked NULLS FIRST, last_updated NULLS FIRST, created_at ASC, id ASC`
 
 	if limit > 0 {
@@ -403,6 +446,11 @@ func (r *LinkRepository) FindDue(ctx context.Context, limit, offset int) ([]*mod
 			&link.ID,
 			&link.URL,
 			&link.Type,
+			&link.Site,
+			&link.ETag,
+			&link.LastModified,
+			&link.Active,
+			&link.GoneChecks,
 			&link.LastChecked,
 			&link.LastUpdated,
 			&link.CreatedAt,
@@ -434,49 +482,49 @@ func (r *LinkRepository) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-func (r *LinkRepository) SaveTags(ctx context.Context, linkID int64, tags []string) error {
+// SaveTags заменяет теги подписки чата на ссылку.
+func (r *LinkRepository) SaveTags(ctx context.Context, chatID, linkID int64, tags []string) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-	_, err := querier.Exec(ctx, "DELETE FROM link_tags WHERE link_id = $1", linkID)
+	result, err := querier.Exec(ctx, "UPDATE chat_links SET tags = $1 WHERE chat_id = $2 AND link_id = $3",
+		nonNilStrings(tags), chatID, linkID)
 	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "удаление существующих тегов", Cause: err}
+		return &customerrors.ErrSQLExecution{Operation: "сохранение тегов подписки", Cause: err}
 	}
 
-	if len(tags) == 0 {
-		return nil
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrLinkNotInChat{ChatID: chatID, LinkID: linkID}
 	}
 
-	return r.saveTags(ctx, querier, linkID, tags)
+	return nil
 }
 
-func (r *LinkRepository) SaveFilters(ctx context.Context, linkID int64, filters []string) error {
+// SaveFilters заменяет фильтры подписки чата на ссылку.
+func (r *LinkRepository) SaveFilters(ctx context.Context, chatID, linkID int64, filters []string) error {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
-	_, err := querier.Exec(ctx, "DELETE FROM filters WHERE link_id = $1", linkID)
+	result, err := querier.Exec(ctx, "UPDATE chat_links SET filters = $1 WHERE chat_id = $2 AND link_id = $3",
+		nonNilStrings(filters), chatID, linkID)
 	if err != nil {
-		return &customerrors.ErrSQLExecution{Operation: "удаление существующих фильтров", Cause: err}
+		return &customerrors.ErrSQLExecution{Operation: "сохранение фильтров подписки", Cause: err}
 	}
 
-	if len(filters) == 0 {
-		return nil
+	if result.RowsAffected() == 0 {
+		return &customerrors.ErrLinkNotInChat{ChatID: chatID, LinkID: linkID}
 	}
 
-	return r.saveFilters(ctx, querier, linkID, filters)
+	return nil
 }
 
+// GetAll возвращает все активные ссылки: неактивные, как и в FindDue, больше не проверяются.
 func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db.Pool)
 
 	rows, err := querier.Query(ctx, `
-		SELECT l.id, l.url, l.type, l.last_checked, l.last_updated, l.created_at,
-			COALESCE(array_agg(DISTINCT t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags,
-			COALESCE(array_agg(DISTINCT f.value) FILTER (WHERE f.value IS NOT NULL), '{}') AS filters
-		FROM links l
-		LEFT JOIN link_tags lt ON l.id = lt.link_id
-		LEFT JOIN tags t ON lt.tag_id = t.id
-		LEFT JOIN filters f ON l.id = f.link_id
-		GROUP BY l.id
-		ORDER BY l.id
+		SELECT id, url, type, site, etag, last_modified, active, gone_checks, last_checked, last_updated, created_at
+		FROM links
+		WHERE active
+		ORDER BY id
 	`)
 	if err != nil {
 		return nil, &customerrors.ErrSQLExecution{Operation: "запрос всех ссылок", Cause: err}
@@ -488,24 +536,23 @@ func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	for rows.Next() {
 		var link models.Link
 
-		var tagsArr, filtersArr []string
-
 		err := rows.Scan(
 			&link.ID,
 			&link.URL,
 			&link.Type,
+			&link.Site,
+			&link.ETag,
+			&link.LastModified,
+			&link.Active,
+			&link.GoneChecks,
 			&link.LastChecked,
 			&link.LastUpdated,
 			&link.CreatedAt,
-			&tagsArr,
-			&filtersArr,
 		)
 		if err != nil {
 			return nil, &customerrors.ErrSQLExecution{Operation: "сканирование ссылки", Cause: err}
 		}
 
-		link.Tags = tagsArr
-		link.Filters = filtersArr
 		links = append(links, &link)
 	}
 
@@ -515,3 +562,12 @@ func (r *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 
 	return links, nil
 }
+
+// nonNilStrings заменяет nil на пустой срез: столбцы tags, filters и keywords не допускают NULL.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
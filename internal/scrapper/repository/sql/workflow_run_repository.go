@@ -0,0 +1,57 @@
+package sql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/database"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/pkg/txs"
+	"github.com/jackc/pgx/v5"
+)
+
+type WorkflowRunRepository struct {
+	db *database.PostgresDB
+}
+
+func NewWorkflowRunRepository(db *database.PostgresDB) *WorkflowRunRepository {
+	return &WorkflowRunRepository{db: db}
+}
+
+func (r *WorkflowRunRepository) GetLastRun(ctx context.Context, url string) (*models.WorkflowRun, error) {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	run := &models.WorkflowRun{}
+
+	err := querier.QueryRow(ctx, `
+		SELECT wr.run_id, wr.conclusion, wr.updated_at
+		FROM workflow_runs wr
+		JOIN links l ON l.id = wr.link_id
+		WHERE l.url = $1`, url).Scan(&run.ID, &run.Conclusion, &run.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, &customerrors.ErrSQLExecution{Operation: "получение последнего запуска workflow", Cause: err}
+	}
+
+	return run, nil
+}
+
+func (r *WorkflowRunRepository) SaveLastRun(ctx context.Context, url string, run *models.WorkflowRun) error {
+	querier := txs.GetQuerier(ctx, r.db.Pool)
+
+	_, err := querier.Exec(ctx, `
+		INSERT INTO workflow_runs (link_id, run_id, conclusion, updated_at)
+		SELECT id, $2, $3, $4 FROM links WHERE url = $1
+		ON CONFLICT (link_id) DO UPDATE
+		SET run_id = EXCLUDED.run_id, conclusion = EXCLUDED.conclusion, updated_at = EXCLUDED.updated_at`,
+		url, run.ID, run.Conclusion, run.UpdatedAt)
+	if err != nil {
+		return &customerrors.ErrSQLExecution{Operation: "сохранение последнего запуска workflow", Cause: err}
+	}
+
+	return nil
+}
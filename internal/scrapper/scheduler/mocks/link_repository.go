@@ -7,6 +7,8 @@ import (
 
 	models "github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // LinkRepository is an autogenerated mock type for the LinkRepository type
@@ -22,17 +24,17 @@ func (_m *LinkRepository) EXPECT() *LinkRepository_Expecter {
 	return &LinkRepository_Expecter{mock: &_m.Mock}
 }
 
-// AddChatLink provides a mock function with given fields: ctx, chatID, linkID
-func (_m *LinkRepository) AddChatLink(ctx context.Context, chatID int64, linkID int64) error {
-	ret := _m.Called(ctx, chatID, linkID)
+// AddMutedUpdates provides a mock function with given fields: ctx, chatID, linkID, count
+func (_m *LinkRepository) AddMutedUpdates(ctx context.Context, chatID int64, linkID int64, count int) error {
+	ret := _m.Called(ctx, chatID, linkID, count)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddChatLink")
+		panic("no return value specified for AddMutedUpdates")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
-		r0 = rf(ctx, chatID, linkID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, chatID, linkID, count)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,32 +42,80 @@ func (_m *LinkRepository) AddChatLink(ctx context.Context, chatID int64, linkID
 	return r0
 }
 
-// LinkRepository_AddChatLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChatLink'
-type LinkRepository_AddChatLink_Call struct {
+// LinkRepository_AddMutedUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMutedUpdates'
+type LinkRepository_AddMutedUpdates_Call struct {
 	*mock.Call
 }
 
-// AddChatLink is a helper method to define mock.On call
+// AddMutedUpdates is a helper method to define mock.On call
 //   - ctx context.Context
 //   - chatID int64
 //   - linkID int64
-func (_e *LinkRepository_Expecter) AddChatLink(ctx interface{}, chatID interface{}, linkID interface{}) *LinkRepository_AddChatLink_Call {
-	return &LinkRepository_AddChatLink_Call{Call: _e.mock.On("AddChatLink", ctx, chatID, linkID)}
+//   - count int
+func (_e *LinkRepository_Expecter) AddMutedUpdates(ctx interface{}, chatID interface{}, linkID interface{}, count interface{}) *LinkRepository_AddMutedUpdates_Call {
+	return &LinkRepository_AddMutedUpdates_Call{Call: _e.mock.On("AddMutedUpdates", ctx, chatID, linkID, count)}
+}
+
+func (_c *LinkRepository_AddMutedUpdates_Call) Run(run func(ctx context.Context, chatID int64, linkID int64, count int)) *LinkRepository_AddMutedUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_AddMutedUpdates_Call) Return(_a0 error) *LinkRepository_AddMutedUpdates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepository_AddMutedUpdates_Call) RunAndReturn(run func(context.Context, int64, int64, int) error) *LinkRepository_AddMutedUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSubscription provides a mock function with given fields: ctx, subscription
+func (_m *LinkRepository) AddSubscription(ctx context.Context, subscription *models.Subscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Subscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepository_AddSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSubscription'
+type LinkRepository_AddSubscription_Call struct {
+	*mock.Call
 }
 
-func (_c *LinkRepository_AddChatLink_Call) Run(run func(ctx context.Context, chatID int64, linkID int64)) *LinkRepository_AddChatLink_Call {
+// AddSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *models.Subscription
+func (_e *LinkRepository_Expecter) AddSubscription(ctx interface{}, subscription interface{}) *LinkRepository_AddSubscription_Call {
+	return &LinkRepository_AddSubscription_Call{Call: _e.mock.On("AddSubscription", ctx, subscription)}
+}
+
+func (_c *LinkRepository_AddSubscription_Call) Run(run func(ctx context.Context, subscription *models.Subscription)) *LinkRepository_AddSubscription_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+		run(args[0].(context.Context), args[1].(*models.Subscription))
 	})
 	return _c
 }
 
-func (_c *LinkRepository_AddChatLink_Call) Return(_a0 error) *LinkRepository_AddChatLink_Call {
+func (_c *LinkRepository_AddSubscription_Call) Return(_a0 error) *LinkRepository_AddSubscription_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *LinkRepository_AddChatLink_Call) RunAndReturn(run func(context.Context, int64, int64) error) *LinkRepository_AddChatLink_Call {
+func (_c *LinkRepository_AddSubscription_Call) RunAndReturn(run func(context.Context, *models.Subscription) error) *LinkRepository_AddSubscription_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -411,6 +461,124 @@ func (_c *LinkRepository_FindDue_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// FindExpiredMutes provides a mock function with given fields: ctx, now
+func (_m *LinkRepository) FindExpiredMutes(ctx context.Context, now time.Time) ([]*models.Subscription, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpiredMutes")
+	}
+
+	var r0 []*models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*models.Subscription, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*models.Subscription); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepository_FindExpiredMutes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpiredMutes'
+type LinkRepository_FindExpiredMutes_Call struct {
+	*mock.Call
+}
+
+// FindExpiredMutes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *LinkRepository_Expecter) FindExpiredMutes(ctx interface{}, now interface{}) *LinkRepository_FindExpiredMutes_Call {
+	return &LinkRepository_FindExpiredMutes_Call{Call: _e.mock.On("FindExpiredMutes", ctx, now)}
+}
+
+func (_c *LinkRepository_FindExpiredMutes_Call) Run(run func(ctx context.Context, now time.Time)) *LinkRepository_FindExpiredMutes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_FindExpiredMutes_Call) Return(_a0 []*models.Subscription, _a1 error) *LinkRepository_FindExpiredMutes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepository_FindExpiredMutes_Call) RunAndReturn(run func(context.Context, time.Time) ([]*models.Subscription, error)) *LinkRepository_FindExpiredMutes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSubscriptions provides a mock function with given fields: ctx, linkID
+func (_m *LinkRepository) FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error) {
+	ret := _m.Called(ctx, linkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSubscriptions")
+	}
+
+	var r0 []*models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Subscription, error)); ok {
+		return rf(ctx, linkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Subscription); ok {
+		r0 = rf(ctx, linkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, linkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepository_FindSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSubscriptions'
+type LinkRepository_FindSubscriptions_Call struct {
+	*mock.Call
+}
+
+// FindSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID int64
+func (_e *LinkRepository_Expecter) FindSubscriptions(ctx interface{}, linkID interface{}) *LinkRepository_FindSubscriptions_Call {
+	return &LinkRepository_FindSubscriptions_Call{Call: _e.mock.On("FindSubscriptions", ctx, linkID)}
+}
+
+func (_c *LinkRepository_FindSubscriptions_Call) Run(run func(ctx context.Context, linkID int64)) *LinkRepository_FindSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_FindSubscriptions_Call) Return(_a0 []*models.Subscription, _a1 error) *LinkRepository_FindSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepository_FindSubscriptions_Call) RunAndReturn(run func(context.Context, int64) ([]*models.Subscription, error)) *LinkRepository_FindSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *LinkRepository) GetAll(ctx context.Context) ([]*models.Link, error) {
 	ret := _m.Called(ctx)
@@ -469,6 +637,103 @@ func (_c *LinkRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]
 	return _c
 }
 
+// MergeSubscription provides a mock function with given fields: ctx, subscription
+func (_m *LinkRepository) MergeSubscription(ctx context.Context, subscription *models.Subscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Subscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepository_MergeSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeSubscription'
+type LinkRepository_MergeSubscription_Call struct {
+	*mock.Call
+}
+
+// MergeSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *models.Subscription
+func (_e *LinkRepository_Expecter) MergeSubscription(ctx interface{}, subscription interface{}) *LinkRepository_MergeSubscription_Call {
+	return &LinkRepository_MergeSubscription_Call{Call: _e.mock.On("MergeSubscription", ctx, subscription)}
+}
+
+func (_c *LinkRepository_MergeSubscription_Call) Run(run func(ctx context.Context, subscription *models.Subscription)) *LinkRepository_MergeSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Subscription))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_MergeSubscription_Call) Return(_a0 error) *LinkRepository_MergeSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepository_MergeSubscription_Call) RunAndReturn(run func(context.Context, *models.Subscription) error) *LinkRepository_MergeSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MuteSubscription provides a mock function with given fields: ctx, chatID, linkID, until, summary
+func (_m *LinkRepository) MuteSubscription(ctx context.Context, chatID int64, linkID int64, until time.Time, summary bool) error {
+	ret := _m.Called(ctx, chatID, linkID, until, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time, bool) error); ok {
+		r0 = rf(ctx, chatID, linkID, until, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepository_MuteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MuteSubscription'
+type LinkRepository_MuteSubscription_Call struct {
+	*mock.Call
+}
+
+// MuteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - linkID int64
+//   - until time.Time
+//   - summary bool
+func (_e *LinkRepository_Expecter) MuteSubscription(ctx interface{}, chatID interface{}, linkID interface{}, until interface{}, summary interface{}) *LinkRepository_MuteSubscription_Call {
+	return &LinkRepository_MuteSubscription_Call{Call: _e.mock.On("MuteSubscription", ctx, chatID, linkID, until, summary)}
+}
+
+func (_c *LinkRepository_MuteSubscription_Call) Run(run func(ctx context.Context, chatID int64, linkID int64, until time.Time, summary bool)) *LinkRepository_MuteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(time.Time), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_MuteSubscription_Call) Return(_a0 error) *LinkRepository_MuteSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepository_MuteSubscription_Call) RunAndReturn(run func(context.Context, int64, int64, time.Time, bool) error) *LinkRepository_MuteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, link
 func (_m *LinkRepository) Save(ctx context.Context, link *models.Link) error {
 	ret := _m.Called(ctx, link)
@@ -516,17 +781,17 @@ func (_c *LinkRepository_Save_Call) RunAndReturn(run func(context.Context, *mode
 	return _c
 }
 
-// SaveFilters provides a mock function with given fields: ctx, linkID, filters
-func (_m *LinkRepository) SaveFilters(ctx context.Context, linkID int64, filters []string) error {
-	ret := _m.Called(ctx, linkID, filters)
+// SaveFilters provides a mock function with given fields: ctx, chatID, linkID, filters
+func (_m *LinkRepository) SaveFilters(ctx context.Context, chatID int64, linkID int64, filters []string) error {
+	ret := _m.Called(ctx, chatID, linkID, filters)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveFilters")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
-		r0 = rf(ctx, linkID, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) error); ok {
+		r0 = rf(ctx, chatID, linkID, filters)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -541,15 +806,16 @@ type LinkRepository_SaveFilters_Call struct {
 
 // SaveFilters is a helper method to define mock.On call
 //   - ctx context.Context
+//   - chatID int64
 //   - linkID int64
 //   - filters []string
-func (_e *LinkRepository_Expecter) SaveFilters(ctx interface{}, linkID interface{}, filters interface{}) *LinkRepository_SaveFilters_Call {
-	return &LinkRepository_SaveFilters_Call{Call: _e.mock.On("SaveFilters", ctx, linkID, filters)}
+func (_e *LinkRepository_Expecter) SaveFilters(ctx interface{}, chatID interface{}, linkID interface{}, filters interface{}) *LinkRepository_SaveFilters_Call {
+	return &LinkRepository_SaveFilters_Call{Call: _e.mock.On("SaveFilters", ctx, chatID, linkID, filters)}
 }
 
-func (_c *LinkRepository_SaveFilters_Call) Run(run func(ctx context.Context, linkID int64, filters []string)) *LinkRepository_SaveFilters_Call {
+func (_c *LinkRepository_SaveFilters_Call) Run(run func(ctx context.Context, chatID int64, linkID int64, filters []string)) *LinkRepository_SaveFilters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].([]string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]string))
 	})
 	return _c
 }
@@ -559,22 +825,22 @@ func (_c *LinkRepository_SaveFilters_Call) Return(_a0 error) *LinkRepository_Sav
 	return _c
 }
 
-func (_c *LinkRepository_SaveFilters_Call) RunAndReturn(run func(context.Context, int64, []string) error) *LinkRepository_SaveFilters_Call {
+func (_c *LinkRepository_SaveFilters_Call) RunAndReturn(run func(context.Context, int64, int64, []string) error) *LinkRepository_SaveFilters_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveTags provides a mock function with given fields: ctx, linkID, tags
-func (_m *LinkRepository) SaveTags(ctx context.Context, linkID int64, tags []string) error {
-	ret := _m.Called(ctx, linkID, tags)
+// SaveTags provides a mock function with given fields: ctx, chatID, linkID, tags
+func (_m *LinkRepository) SaveTags(ctx context.Context, chatID int64, linkID int64, tags []string) error {
+	ret := _m.Called(ctx, chatID, linkID, tags)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveTags")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
-		r0 = rf(ctx, linkID, tags)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) error); ok {
+		r0 = rf(ctx, chatID, linkID, tags)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -589,15 +855,16 @@ type LinkRepository_SaveTags_Call struct {
 
 // SaveTags is a helper method to define mock.On call
 //   - ctx context.Context
+//   - chatID int64
 //   - linkID int64
 //   - tags []string
-func (_e *LinkRepository_Expecter) SaveTags(ctx interface{}, linkID interface{}, tags interface{}) *LinkRepository_SaveTags_Call {
-	return &LinkRepository_SaveTags_Call{Call: _e.mock.On("SaveTags", ctx, linkID, tags)}
+func (_e *LinkRepository_Expecter) SaveTags(ctx interface{}, chatID interface{}, linkID interface{}, tags interface{}) *LinkRepository_SaveTags_Call {
+	return &LinkRepository_SaveTags_Call{Call: _e.mock.On("SaveTags", ctx, chatID, linkID, tags)}
 }
 
-func (_c *LinkRepository_SaveTags_Call) Run(run func(ctx context.Context, linkID int64, tags []string)) *LinkRepository_SaveTags_Call {
+func (_c *LinkRepository_SaveTags_Call) Run(run func(ctx context.Context, chatID int64, linkID int64, tags []string)) *LinkRepository_SaveTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].([]string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]string))
 	})
 	return _c
 }
@@ -607,7 +874,73 @@ func (_c *LinkRepository_SaveTags_Call) Return(_a0 error) *LinkRepository_SaveTa
 	return _c
 }
 
-func (_c *LinkRepository_SaveTags_Call) RunAndReturn(run func(context.Context, int64, []string) error) *LinkRepository_SaveTags_Call {
+func (_c *LinkRepository_SaveTags_Call) RunAndReturn(run func(context.Context, int64, int64, []string) error) *LinkRepository_SaveTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmuteSubscription provides a mock function with given fields: ctx, chatID, linkID, until
+func (_m *LinkRepository) UnmuteSubscription(ctx context.Context, chatID int64, linkID int64, until time.Time) (int, bool, error) {
+	ret := _m.Called(ctx, chatID, linkID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmuteSubscription")
+	}
+
+	var r0 int
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) (int, bool, error)); ok {
+		return rf(ctx, chatID, linkID, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) int); ok {
+		r0 = rf(ctx, chatID, linkID, until)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time) bool); ok {
+		r1 = rf(ctx, chatID, linkID, until)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, time.Time) error); ok {
+		r2 = rf(ctx, chatID, linkID, until)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LinkRepository_UnmuteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmuteSubscription'
+type LinkRepository_UnmuteSubscription_Call struct {
+	*mock.Call
+}
+
+// UnmuteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - linkID int64
+//   - until time.Time
+func (_e *LinkRepository_Expecter) UnmuteSubscription(ctx interface{}, chatID interface{}, linkID interface{}, until interface{}) *LinkRepository_UnmuteSubscription_Call {
+	return &LinkRepository_UnmuteSubscription_Call{Call: _e.mock.On("UnmuteSubscription", ctx, chatID, linkID, until)}
+}
+
+func (_c *LinkRepository_UnmuteSubscription_Call) Run(run func(ctx context.Context, chatID int64, linkID int64, until time.Time)) *LinkRepository_UnmuteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkRepository_UnmuteSubscription_Call) Return(missed int, unmuted bool, err error) *LinkRepository_UnmuteSubscription_Call {
+	_c.Call.Return(missed, unmuted, err)
+	return _c
+}
+
+func (_c *LinkRepository_UnmuteSubscription_Call) RunAndReturn(run func(context.Context, int64, int64, time.Time) (int, bool, error)) *LinkRepository_UnmuteSubscription_Call {
 	_c.Call.Return(run)
 	return _c
 }
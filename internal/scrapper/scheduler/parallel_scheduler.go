@@ -2,10 +2,14 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository"
 	"github.com/go-co-op/gocron"
@@ -15,14 +19,71 @@ type LinkProcessor interface {
 	ProcessLink(ctx context.Context, link *models.Link) (bool, error)
 }
 
+// BatchLinkProcessor умеет проверять несколько ссылок одним запросом к API.
+// Если обработчик ссылок реализует этот интерфейс, планировщик сначала проверяет батч целиком,
+// а воркерам передаёт уже проверенные ссылки.
+type BatchLinkProcessor interface {
+	LinkProcessor
+	CheckLinks(ctx context.Context, links []*models.Link) map[int64]time.Time
+	ProcessCheckedLink(ctx context.Context, link *models.Link, lastUpdate time.Time) (bool, error)
+}
+
+// QuotaReporter сообщает остаток квоты внешнего API.
+type QuotaReporter interface {
+	RateLimit() httputil.RateLimitStatus
+}
+
+// RateLimitedSource связывает типы ссылок с квотой API, через который они проверяются.
+// Когда остаток квоты опускается до Reserve, проверка этих ссылок откладывается до сброса лимита.
+type RateLimitedSource struct {
+	LinkTypes []models.LinkType
+	Quota     QuotaReporter
+	Reserve   int
+}
+
 type ParallelScheduler struct {
-	scheduler     *gocron.Scheduler
-	linkProcessor LinkProcessor
-	linkRepo      repository.LinkRepository
-	logger        *slog.Logger
-	interval      time.Duration
-	batchSize     int
-	workers       int
+	scheduler      *gocron.Scheduler
+	linkProcessor  LinkProcessor
+	batchProcessor BatchLinkProcessor
+	linkRepo       repository.LinkRepository
+	logger         *slog.Logger
+	interval       time.Duration
+	batchSize      int
+	workers        int
+	rateLimits     []RateLimitedSource
+}
+
+// linkJob — ссылка, переданная воркеру. Если checked, время последнего обновления уже получено пакетной проверкой.
+type linkJob struct {
+	link       *models.Link
+	lastUpdate time.Time
+	checked    bool
+}
+
+// runStats собирает итоги одного запуска планировщика со всех воркеров.
+type runStats struct {
+	mu          sync.Mutex
+	notModified int
+	deferred    int
+	resumeAt    time.Time
+}
+
+func (r *runStats) addNotModified() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notModified++
+}
+
+func (r *runStats) addDeferred(resumeAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deferred++
+
+	if resumeAt.After(r.resumeAt) {
+		r.resumeAt = resumeAt
+	}
 }
 
 func NewParallelScheduler(
@@ -32,6 +93,7 @@ func NewParallelScheduler(
 	batchSize int,
 	workers int,
 	logger *slog.Logger,
+	rateLimits ...RateLimitedSource,
 ) *ParallelScheduler {
 	scheduler := gocron.NewScheduler(time.UTC)
 
@@ -43,14 +105,18 @@ func NewParallelScheduler(
 		batchSize = 100
 	}
 
+	batchProcessor, _ := linkProcessor.(BatchLinkProcessor)
+
 	return &ParallelScheduler{
-		scheduler:     scheduler,
-		linkProcessor: linkProcessor,
-		linkRepo:      linkRepo,
-		logger:        logger,
-		interval:      interval,
-		batchSize:     batchSize,
-		workers:       workers,
+		scheduler:      scheduler,
+		linkProcessor:  linkProcessor,
+		batchProcessor: batchProcessor,
+		linkRepo:       linkRepo,
+		logger:         logger,
+		interval:       interval,
+		batchSize:      batchSize,
+		workers:        workers,
+		rateLimits:     rateLimits,
 	}
 }
 
@@ -90,6 +156,7 @@ func (s *ParallelScheduler) ProcessBatches(ctx context.Context) {
 	offset := 0
 	batchNum := 1
 	processedCount := 0
+	stats := &runStats{}
 
 	for {
 		s.logger.Debug("Запрос очередной порции ссылок", "batchSize", s.batchSize, "offset", offset)
@@ -116,7 +183,7 @@ func (s *ParallelScheduler) ProcessBatches(ctx context.Context) {
 			"offset", offset,
 		)
 
-		s.processOneBatch(ctx, links, batchNum)
+		s.processOneBatch(ctx, links, batchNum, stats)
 
 		processedCount += batchSize
 		offset += batchSize
@@ -125,11 +192,22 @@ func (s *ParallelScheduler) ProcessBatches(ctx context.Context) {
 
 	s.logger.Info("Обработка ссылок завершена",
 		"processed", processedCount,
+		"notModified", stats.notModified,
+		"deferred", stats.deferred,
 	)
+
+	if stats.deferred > 0 {
+		s.logger.Warn("Проверка части ссылок отложена до сброса лимита API",
+			"deferred", stats.deferred,
+			"resumeAt", stats.resumeAt,
+		)
+	}
 }
 
-func (s *ParallelScheduler) processOneBatch(ctx context.Context, batch []*models.Link, batchNum int) {
-	linkCh := make(chan *models.Link)
+func (s *ParallelScheduler) processOneBatch(ctx context.Context, batch []*models.Link, batchNum int, stats *runStats) {
+	checked := s.checkBatch(ctx, batch)
+
+	linkCh := make(chan linkJob)
 	wg := sync.WaitGroup{}
 
 	for i := 0; i < s.workers; i++ {
@@ -139,13 +217,14 @@ func (s *ParallelScheduler) processOneBatch(ctx context.Context, batch []*models
 
 		go func(workerID int) {
 			defer wg.Done()
-			s.worker(ctx, linkCh, workerID, batchNum)
+			s.worker(ctx, linkCh, workerID, batchNum, stats)
 		}(workerID)
 	}
 
 	go func() {
 		for _, link := range batch {
-			linkCh <- link
+			lastUpdate, ok := checked[link.ID]
+			linkCh <- linkJob{link: link, lastUpdate: lastUpdate, checked: ok}
 		}
 
 		close(linkCh)
@@ -154,8 +233,54 @@ func (s *ParallelScheduler) processOneBatch(ctx context.Context, batch []*models
 	wg.Wait()
 }
 
-func (s *ParallelScheduler) worker(ctx context.Context, linkCh <-chan *models.Link, workerID, batchNum int) {
-	for link := range linkCh {
+// checkBatch проверяет ссылки батча одним запросом там, где это поддерживает обработчик ссылок.
+// Ссылки, проверка которых отложена из-за квоты API, в пакетный запрос не попадают.
+func (s *ParallelScheduler) checkBatch(ctx context.Context, batch []*models.Link) map[int64]time.Time {
+	if s.batchProcessor == nil {
+		return nil
+	}
+
+	now := time.Now()
+	candidates := make([]*models.Link, 0, len(batch))
+
+	for _, link := range batch {
+		if _, limited := s.rateLimitedUntil(link, now); !limited {
+			candidates = append(candidates, link)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return s.batchProcessor.CheckLinks(ctx, candidates)
+}
+
+// rateLimitedUntil сообщает, что квота API, через который проверяется ссылка, почти исчерпана
+// или API попросил приостановить запросы, и возвращает момент, когда проверку можно возобновить.
+func (s *ParallelScheduler) rateLimitedUntil(link *models.Link, now time.Time) (time.Time, bool) {
+	for _, limit := range s.rateLimits {
+		if !slices.Contains(limit.LinkTypes, link.Type) {
+			continue
+		}
+
+		status := limit.Quota.RateLimit()
+		if status.Known && status.Remaining <= limit.Reserve && now.Before(status.Reset) {
+			return status.Reset, true
+		}
+
+		if status.Exhausted(now) {
+			return status.ResumeAt(now), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (s *ParallelScheduler) worker(ctx context.Context, linkCh <-chan linkJob, workerID, batchNum int, stats *runStats) {
+	for job := range linkCh {
+		link := job.link
+
 		s.logger.Debug("Воркер обрабатывает ссылку",
 			"worker", workerID,
 			"batch", batchNum,
@@ -163,7 +288,33 @@ func (s *ParallelScheduler) worker(ctx context.Context, linkCh <-chan *models.Li
 			"url", link.URL,
 		)
 
-		updated, err := s.linkProcessor.ProcessLink(ctx, link)
+		if resumeAt, limited := s.rateLimitedUntil(link, time.Now()); limited {
+			stats.addDeferred(resumeAt)
+			continue
+		}
+
+		var (
+			updated bool
+			err     error
+		)
+
+		if job.checked {
+			updated, err = s.batchProcessor.ProcessCheckedLink(ctx, link, job.lastUpdate)
+		} else {
+			updated, err = s.linkProcessor.ProcessLink(ctx, link)
+		}
+
+		if errors.Is(err, &customerrors.ErrNotModified{}) {
+			stats.addNotModified()
+			continue
+		}
+
+		var rateLimited *customerrors.ErrRateLimited
+		if errors.As(err, &rateLimited) {
+			stats.addDeferred(rateLimited.ResetAt)
+			continue
+		}
+
 		if err != nil {
 			s.logger.Error("Ошибка при обработке ссылки",
 				"worker", workerID,
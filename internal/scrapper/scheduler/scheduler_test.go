@@ -1,15 +1,19 @@
 package scheduler_test
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
+	customerrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/scheduler"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/scheduler/mocks"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParallelScheduler_ProcessBatches(t *testing.T) {
@@ -58,3 +62,116 @@ func TestParallelScheduler_ProcessBatches(t *testing.T) {
 	mockLinkRepo.AssertExpectations(t)
 	mockLinkProcessor.AssertExpectations(t)
 }
+
+func TestParallelScheduler_ProcessBatches_CountsNotModified(t *testing.T) {
+	mockLinkProcessor := mocks.NewLinkProcessor(t)
+	mockLinkRepo := mocks.NewLinkRepository(t)
+
+	var logs bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	ctx := context.Background()
+
+	link1 := &models.Link{ID: 1, URL: "url1"}
+	link2 := &models.Link{ID: 2, URL: "url2"}
+	link3 := &models.Link{ID: 3, URL: "url3"}
+
+	mockLinkRepo.EXPECT().
+		FindDue(ctx, 10, 0).
+		Return([]*models.Link{link1, link2, link3}, nil).
+		Once()
+	mockLinkRepo.EXPECT().
+		FindDue(ctx, 10, 3).
+		Return([]*models.Link{}, nil).
+		Once()
+
+	mockLinkProcessor.EXPECT().ProcessLink(ctx, link1).Return(false, &customerrors.ErrNotModified{URL: "url1"}).Once()
+	mockLinkProcessor.EXPECT().ProcessLink(ctx, link2).Return(true, nil).Once()
+	mockLinkProcessor.EXPECT().ProcessLink(ctx, link3).Return(false, &customerrors.ErrNotModified{URL: "url3"}).Once()
+
+	parallelScheduler := scheduler.NewParallelScheduler(mockLinkProcessor, mockLinkRepo, 1*time.Hour, 10, 2, logger)
+
+	parallelScheduler.ProcessBatches(ctx)
+
+	assert.Contains(t, logs.String(), "processed=3 notModified=2")
+	assert.NotContains(t, logs.String(), "level=ERROR")
+}
+
+type staticQuota httputil.RateLimitStatus
+
+func (q staticQuota) RateLimit() httputil.RateLimitStatus {
+	return httputil.RateLimitStatus(q)
+}
+
+func TestParallelScheduler_ProcessBatches_DefersRateLimitedLinks(t *testing.T) {
+	mockLinkProcessor := mocks.NewLinkProcessor(t)
+	mockLinkRepo := mocks.NewLinkRepository(t)
+
+	var logs bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	ctx := context.Background()
+
+	githubLink := &models.Link{ID: 1, URL: "https://github.com/owner/repo", Type: models.GitHub}
+	issueLink := &models.Link{ID: 2, URL: "https://github.com/owner/repo/issues/1", Type: models.GitHubIssue}
+	soLink := &models.Link{ID: 3, URL: "https://stackoverflow.com/questions/1", Type: models.StackOverflow}
+	gitlabLink := &models.Link{ID: 4, URL: "https://gitlab.com/group/project", Type: models.GitLab}
+
+	mockLinkRepo.EXPECT().
+		FindDue(ctx, 10, 0).
+		Return([]*models.Link{githubLink, issueLink, soLink, gitlabLink}, nil).
+		Once()
+	mockLinkRepo.EXPECT().
+		FindDue(ctx, 10, 4).
+		Return([]*models.Link{}, nil).
+		Once()
+
+	resetAt := time.Now().Add(time.Hour)
+
+	// GitHub-ссылки не проверяются, пока квота ниже резерва; остальные источники обрабатываются как обычно.
+	mockLinkProcessor.EXPECT().ProcessLink(ctx, soLink).Return(true, nil).Once()
+	mockLinkProcessor.EXPECT().ProcessLink(ctx, gitlabLink).
+		Return(false, &customerrors.ErrRateLimited{Service: "gitlab", ResetAt: resetAt}).Once()
+
+	parallelScheduler := scheduler.NewParallelScheduler(mockLinkProcessor, mockLinkRepo, 1*time.Hour, 10, 2, logger,
+		scheduler.RateLimitedSource{
+			LinkTypes: []models.LinkType{models.GitHub, models.GitHubIssue},
+			Quota:     staticQuota{Limit: 5000, Remaining: 10, Reset: resetAt, Known: true},
+			Reserve:   50,
+		},
+	)
+
+	parallelScheduler.ProcessBatches(ctx)
+
+	assert.Contains(t, logs.String(), "processed=4 notModified=0 deferred=3")
+	assert.Contains(t, logs.String(), "Проверка части ссылок отложена до сброса лимита API")
+	assert.NotContains(t, logs.String(), "level=ERROR")
+}
+
+func TestParallelScheduler_ProcessBatches_ChecksBatchAtOnce(t *testing.T) {
+	mockBatchProcessor := mocks.NewBatchLinkProcessor(t)
+	mockLinkRepo := mocks.NewLinkRepository(t)
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	ctx := context.Background()
+
+	repo1 := &models.Link{ID: 1, URL: "https://github.com/owner/one", Type: models.GitHub}
+	repo2 := &models.Link{ID: 2, URL: "https://github.com/owner/two", Type: models.GitHub}
+	question := &models.Link{ID: 3, URL: "https://stackoverflow.com/questions/1", Type: models.StackOverflow}
+	batch := []*models.Link{repo1, repo2, question}
+
+	mockLinkRepo.EXPECT().FindDue(ctx, 10, 0).Return(batch, nil).Once()
+	mockLinkRepo.EXPECT().FindDue(ctx, 10, 3).Return([]*models.Link{}, nil).Once()
+
+	lastUpdate := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// Обработчик проверил пакетом только репозитории, вопрос проверяется отдельным запросом.
+	mockBatchProcessor.EXPECT().CheckLinks(ctx, batch).
+		Return(map[int64]time.Time{repo1.ID: lastUpdate, repo2.ID: lastUpdate}).Once()
+	mockBatchProcessor.EXPECT().ProcessCheckedLink(ctx, repo1, lastUpdate).Return(true, nil).Once()
+	mockBatchProcessor.EXPECT().ProcessCheckedLink(ctx, repo2, lastUpdate).Return(false, nil).Once()
+	mockBatchProcessor.EXPECT().ProcessLink(ctx, question).Return(false, nil).Once()
+
+	parallelScheduler := scheduler.NewParallelScheduler(mockBatchProcessor, mockLinkRepo, 1*time.Hour, 10, 2, logger)
+
+	parallelScheduler.ProcessBatches(ctx)
+}
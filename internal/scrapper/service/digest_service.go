@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/config"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/notify"
@@ -28,6 +29,7 @@ type DigestService struct {
 	notifier      notify.BotNotifier
 	digestCache   DigestCache
 	chatRepo      repository.ChatRepository
+	linkRepo      repository.LinkRepository
 	logger        *slog.Logger
 	scheduler     *gocron.Scheduler
 	schedulerDone chan struct{}
@@ -38,6 +40,7 @@ func NewDigestService(
 	notifier notify.BotNotifier,
 	digestCache DigestCache,
 	chatRepo repository.ChatRepository,
+	linkRepo repository.LinkRepository,
 	logger *slog.Logger,
 ) *DigestService {
 	return &DigestService{
@@ -45,6 +48,7 @@ func NewDigestService(
 		notifier:      notifier,
 		digestCache:   digestCache,
 		chatRepo:      chatRepo,
+		linkRepo:      linkRepo,
 		logger:        logger,
 		scheduler:     gocron.NewScheduler(time.UTC),
 		schedulerDone: make(chan struct{}),
@@ -83,50 +87,81 @@ func (s *DigestService) Stop() {
 	close(s.schedulerDone)
 }
 
+// AddUpdate откладывает обновление в дайджест каждого чата из TgChatIDs, кроме чатов, заглушивших ссылку.
+// Режим уведомлений чатов проверяет вызывающий.
 func (s *DigestService) AddUpdate(ctx context.Context, update *models.LinkUpdate) error {
 	s.logger.Debug("Обработка обновления для дайджеста",
 		"totalChats", len(update.TgChatIDs),
 	)
 
+	muted := s.mutedChats(ctx, update.ID)
+
 	for _, chatID := range update.TgChatIDs {
-		chat, err := s.chatRepo.FindByID(ctx, chatID)
-		if err != nil {
-			s.logger.Error("Ошибка при получении чата",
-				"error", err,
+		if muted[chatID] {
+			s.logger.Info("Ссылка заглушена, обновление не добавлено в дайджест",
 				"chatID", chatID,
+				"url", update.URL,
 			)
 
 			continue
 		}
 
-		if chat.NotificationMode == models.NotificationModeDigest {
-			chatUpdate := &models.LinkUpdate{
-				ID:          update.ID,
-				URL:         update.URL,
-				Description: update.Description,
-				TgChatIDs:   []int64{chatID},
-				UpdateInfo:  update.UpdateInfo,
-			}
-
-			if err := s.digestCache.AddUpdate(ctx, chatID, chatUpdate); err != nil {
-				s.logger.Error("Ошибка при добавлении обновления в дайджест",
-					"error", err,
-					"chatID", chatID,
-				)
-
-				continue
-			}
+		chatUpdate := &models.LinkUpdate{
+			ID:          update.ID,
+			URL:         update.URL,
+			Description: update.Description,
+			TgChatIDs:   []int64{chatID},
+			UpdateInfo:  update.UpdateInfo,
+			Keywords:    update.Keywords,
+		}
 
-			s.logger.Info("Обновление добавлено в дайджест",
+		if err := s.digestCache.AddUpdate(ctx, chatID, chatUpdate); err != nil {
+			s.logger.Error("Ошибка при добавлении обновления в дайджест",
+				"error", err,
 				"chatID", chatID,
-				"url", update.URL,
 			)
+
+			continue
 		}
+
+		s.logger.Info("Обновление добавлено в дайджест",
+			"chatID", chatID,
+			"url", update.URL,
+		)
 	}
 
 	return nil
 }
 
+// mutedChats возвращает чаты, заглушившие ссылку. Если подписки не удалось получить, заглушенных чатов нет:
+// лишнее обновление в дайджесте лучше потерянного.
+func (s *DigestService) mutedChats(ctx context.Context, linkID int64) map[int64]bool {
+	if s.linkRepo == nil || linkID == 0 {
+		return nil
+	}
+
+	subscriptions, err := s.linkRepo.FindSubscriptions(ctx, linkID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке заглушенных подписок",
+			"error", err,
+			"linkID", linkID,
+		)
+
+		return nil
+	}
+
+	now := time.Now()
+	muted := make(map[int64]bool)
+
+	for _, subscription := range subscriptions {
+		if subscription.Muted(now) {
+			muted[subscription.ChatID] = true
+		}
+	}
+
+	return muted
+}
+
 func (s *DigestService) sendDigests(ctx context.Context, hour, minute int) error {
 	chats, err := s.chatRepo.FindByDigestTime(ctx, hour, minute)
 	if err != nil {
@@ -196,9 +231,14 @@ func (s *DigestService) sendDigests(ctx context.Context, hour, minute int) error
 func (s *DigestService) createDigestMessage(updates []*models.LinkUpdate) string {
 	dateFormat := "02.01.2006"
 
+	return formatUpdateList(fmt.Sprintf("📋 *Дайджест обновлений за %s*", time.Now().Format(dateFormat)), updates)
+}
+
+// formatUpdateList собирает сообщение из заголовка и списка обновлений: первые десять подробно, остальные — числом.
+func formatUpdateList(header string, updates []*models.LinkUpdate) string {
 	var message strings.Builder
 
-	message.WriteString(fmt.Sprintf("📋 *Дайджест обновлений за %s*\n\n", time.Now().Format(dateFormat)))
+	message.WriteString(header + "\n\n")
 
 	count := 0
 	maxUpdates := 10
@@ -224,6 +264,11 @@ func (s *DigestService) createDigestMessage(updates []*models.LinkUpdate) string
 				preview = preview[:100] + "..."
 			}
 
+			// Найденные ключевые слова подписки выделяются так же, как в мгновенном уведомлении.
+			if len(update.Keywords) > 0 {
+				preview = common.HighlightKeywords(preview, update.Keywords)
+			}
+
 			message.WriteString(fmt.Sprintf("📝 %s\n", preview))
 		} else if update.Description != "" {
 			message.WriteString(fmt.Sprintf("%s\n", update.Description))
@@ -71,11 +71,17 @@ func (f *ServiceFactory) CreateScrapperService(ctx context.Context) (*ScrapperSe
 		}
 	}
 
+	quietHoursService, err := f.CreateQuietHoursService()
+	if err != nil {
+		return nil, err
+	}
+
 	return NewScrapperService(
 		linkRepo,
 		chatRepo,
 		botClient,
 		digestService,
+		quietHoursService,
 		detailsRepo,
 		f.updaterFactory,
 		f.linkAnalyzer,
@@ -101,6 +107,11 @@ func (f *ServiceFactory) CreateDigestService(ctx context.Context) (*DigestServic
 		return nil, err
 	}
 
+	linkRepo, err := f.repoFactory.CreateLinkRepository()
+	if err != nil {
+		return nil, err
+	}
+
 	redisCache, err := cache.NewRedisDigestCache(
 		ctx,
 		f.config.RedisURL,
@@ -118,6 +129,44 @@ func (f *ServiceFactory) CreateDigestService(ctx context.Context) (*DigestServic
 		botClient,
 		redisCache,
 		chatRepo,
+		linkRepo,
 		f.logger,
 	), nil
 }
+
+func (f *ServiceFactory) CreateQuietHoursService() (*QuietHoursService, error) {
+	notifierFactory := notify.NewNotifierFactory(f.config, f.logger)
+
+	botClient, err := notifierFactory.CreateNotifier()
+	if err != nil {
+		return nil, err
+	}
+
+	chatRepo, err := f.repoFactory.CreateChatRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	heldRepo, err := f.repoFactory.CreateHeldUpdateRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQuietHoursService(botClient, heldRepo, chatRepo, f.logger), nil
+}
+
+func (f *ServiceFactory) CreateMuteService() (*MuteService, error) {
+	notifierFactory := notify.NewNotifierFactory(f.config, f.logger)
+
+	botClient, err := notifierFactory.CreateNotifier()
+	if err != nil {
+		return nil, err
+	}
+
+	linkRepo, err := f.repoFactory.CreateLinkRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMuteService(botClient, linkRepo, f.logger), nil
+}
@@ -139,6 +139,66 @@ func (_c *LinkUpdater_GetUpdateDetails_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetUpdatesSince provides a mock function with given fields: ctx, url, since
+func (_m *LinkUpdater) GetUpdatesSince(ctx context.Context, url string, since time.Time) ([]*models.UpdateInfo, error) {
+	ret := _m.Called(ctx, url, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpdatesSince")
+	}
+
+	var r0 []*models.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*models.UpdateInfo, error)); ok {
+		return rf(ctx, url, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*models.UpdateInfo); ok {
+		r0 = rf(ctx, url, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, url, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkUpdater_GetUpdatesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpdatesSince'
+type LinkUpdater_GetUpdatesSince_Call struct {
+	*mock.Call
+}
+
+// GetUpdatesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+//   - since time.Time
+func (_e *LinkUpdater_Expecter) GetUpdatesSince(ctx interface{}, url interface{}, since interface{}) *LinkUpdater_GetUpdatesSince_Call {
+	return &LinkUpdater_GetUpdatesSince_Call{Call: _e.mock.On("GetUpdatesSince", ctx, url, since)}
+}
+
+func (_c *LinkUpdater_GetUpdatesSince_Call) Run(run func(ctx context.Context, url string, since time.Time)) *LinkUpdater_GetUpdatesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkUpdater_GetUpdatesSince_Call) Return(_a0 []*models.UpdateInfo, _a1 error) *LinkUpdater_GetUpdatesSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkUpdater_GetUpdatesSince_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]*models.UpdateInfo, error)) *LinkUpdater_GetUpdatesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLinkUpdater creates a new instance of LinkUpdater. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLinkUpdater(t interface {
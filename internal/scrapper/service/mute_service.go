@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/notify"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository"
+	"github.com/go-co-op/gocron"
+)
+
+// MuteService раз в минуту снимает истёкшие заглушки подписок и отправляет чатам сводку пропущенных обновлений.
+type MuteService struct {
+	notifier      notify.BotNotifier
+	linkRepo      repository.LinkRepository
+	logger        *slog.Logger
+	scheduler     *gocron.Scheduler
+	schedulerDone chan struct{}
+}
+
+func NewMuteService(
+	notifier notify.BotNotifier,
+	linkRepo repository.LinkRepository,
+	logger *slog.Logger,
+) *MuteService {
+	return &MuteService{
+		notifier:      notifier,
+		linkRepo:      linkRepo,
+		logger:        logger,
+		scheduler:     gocron.NewScheduler(time.UTC),
+		schedulerDone: make(chan struct{}),
+	}
+}
+
+func (s *MuteService) Start(ctx context.Context) {
+	s.logger.Info("Запуск планировщика заглушек")
+
+	_, err := s.scheduler.Every(1).Minute().Do(func() {
+		if err := s.ReleaseExpired(ctx, time.Now()); err != nil {
+			s.logger.Error("Ошибка при снятии истёкших заглушек",
+				"error", err,
+			)
+		}
+	})
+
+	if err != nil {
+		s.logger.Error("Ошибка при настройке планировщика заглушек",
+			"error", err,
+		)
+
+		return
+	}
+
+	s.scheduler.StartAsync()
+}
+
+func (s *MuteService) Stop() {
+	s.logger.Info("Остановка планировщика заглушек")
+	s.scheduler.Stop()
+	close(s.schedulerDone)
+}
+
+// ReleaseExpired снимает заглушки, закончившиеся к моменту now. Сводка отправляется после снятия заглушки,
+// чтобы сбой отправки не повторял её каждую минуту, и только если заглушку снял этот вызов: заглушку,
+// которую тем временем сняли вручную или продлили, планировщик не трогает.
+func (s *MuteService) ReleaseExpired(ctx context.Context, now time.Time) error {
+	subscriptions, err := s.linkRepo.FindExpiredMutes(ctx, now)
+	if err != nil {
+		return fmt.Errorf("ошибка при поиске истёкших заглушек: %w", err)
+	}
+
+	for _, subscription := range subscriptions {
+		missed, unmuted, err := s.linkRepo.UnmuteSubscription(ctx, subscription.ChatID, subscription.LinkID, now)
+		if err != nil {
+			s.logger.Error("Ошибка при снятии заглушки",
+				"error", err,
+				"chatID", subscription.ChatID,
+				"linkID", subscription.LinkID,
+			)
+
+			continue
+		}
+
+		if !unmuted {
+			continue
+		}
+
+		s.logger.Info("Заглушка подписки закончилась",
+			"chatID", subscription.ChatID,
+			"linkID", subscription.LinkID,
+			"missedUpdates", missed,
+		)
+
+		if !subscription.MuteSummary || missed == 0 {
+			continue
+		}
+
+		if err := s.sendSummary(ctx, subscription.ChatID, subscription.LinkID, missed); err != nil {
+			s.logger.Error("Ошибка при отправке сводки пропущенных обновлений",
+				"error", err,
+				"chatID", subscription.ChatID,
+				"linkID", subscription.LinkID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *MuteService) sendSummary(ctx context.Context, chatID, linkID int64, missed int) error {
+	link, err := s.linkRepo.FindByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	return s.notifier.SendUpdate(ctx, newMuteSummary(chatID, link, missed))
+}
+
+// newMuteSummary собирает сообщение «пока вас не было» о ссылке, с которой снята заглушка.
+func newMuteSummary(chatID int64, link *models.Link, missed int) *models.LinkUpdate {
+	return &models.LinkUpdate{
+		ID:          link.ID,
+		URL:         link.URL,
+		Description: fmt.Sprintf("*Пока вас не было*: пропущено обновлений — %d. Уведомления снова включены.", missed),
+		TgChatIDs:   []int64{chatID},
+	}
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	repomocks "github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository/mocks"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/service"
+	servicemocks "github.com/central-university-dev/go-Matthew11K/internal/scrapper/service/mocks"
+)
+
+func TestMuteService_ReleaseExpired(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockNotifier := new(servicemocks.BotNotifier)
+	mockLinkRepo := new(repomocks.LinkRepository)
+
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+	link := &models.Link{ID: 9, URL: "https://github.com/owner/repo"}
+
+	summaryChatID := int64(10)
+	quietChatID := int64(20)
+	idleChatID := int64(30)
+	failingChatID := int64(40)
+	releasedChatID := int64(50)
+
+	mockLinkRepo.On("FindExpiredMutes", ctx, now).Return([]*models.Subscription{
+		{ChatID: summaryChatID, LinkID: link.ID, MutedUntil: &expired, MuteSummary: true, MutedUpdates: 4},
+		{ChatID: quietChatID, LinkID: link.ID, MutedUntil: &expired, MuteSummary: false, MutedUpdates: 2},
+		{ChatID: idleChatID, LinkID: link.ID, MutedUntil: &expired, MuteSummary: true},
+		{ChatID: failingChatID, LinkID: link.ID, MutedUntil: &expired, MuteSummary: true, MutedUpdates: 1},
+		{ChatID: releasedChatID, LinkID: link.ID, MutedUntil: &expired, MuteSummary: true, MutedUpdates: 5},
+	}, nil).Once()
+	mockLinkRepo.On("UnmuteSubscription", ctx, summaryChatID, link.ID, now).Return(4, true, nil).Once()
+	mockLinkRepo.On("UnmuteSubscription", ctx, quietChatID, link.ID, now).Return(2, true, nil).Once()
+	mockLinkRepo.On("UnmuteSubscription", ctx, idleChatID, link.ID, now).Return(0, true, nil).Once()
+	mockLinkRepo.On("UnmuteSubscription", ctx, failingChatID, link.ID, now).Return(0, false, errors.New("db down")).Once()
+	// Заглушку этого чата уже сняли вручную, поэтому сводка не отправляется второй раз.
+	mockLinkRepo.On("UnmuteSubscription", ctx, releasedChatID, link.ID, now).Return(0, false, nil).Once()
+	mockLinkRepo.On("FindByID", ctx, link.ID).Return(link, nil).Once()
+
+	// Сводку получает только чат, который просил её и пропустил обновления.
+	mockNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return len(update.TgChatIDs) == 1 && update.TgChatIDs[0] == summaryChatID &&
+			update.URL == link.URL &&
+			strings.HasPrefix(update.Description, "*Пока вас не было*: пропущено обновлений — 4")
+	})).Return(nil).Once()
+
+	svc := service.NewMuteService(mockNotifier, mockLinkRepo, logger)
+
+	require.NoError(t, svc.ReleaseExpired(ctx, now))
+
+	mockNotifier.AssertExpectations(t)
+	mockLinkRepo.AssertExpectations(t)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/notify"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository"
+	"github.com/go-co-op/gocron"
+)
+
+// QuietHoursService хранит мгновенные уведомления, пришедшие в часы тишины чата, и раз в минуту отправляет
+// накопленное одним сообщением чатам, у которых часы тишины закончились.
+type QuietHoursService struct {
+	notifier      notify.BotNotifier
+	heldRepo      repository.HeldUpdateRepository
+	chatRepo      repository.ChatRepository
+	logger        *slog.Logger
+	scheduler     *gocron.Scheduler
+	schedulerDone chan struct{}
+}
+
+func NewQuietHoursService(
+	notifier notify.BotNotifier,
+	heldRepo repository.HeldUpdateRepository,
+	chatRepo repository.ChatRepository,
+	logger *slog.Logger,
+) *QuietHoursService {
+	return &QuietHoursService{
+		notifier:      notifier,
+		heldRepo:      heldRepo,
+		chatRepo:      chatRepo,
+		logger:        logger,
+		scheduler:     gocron.NewScheduler(time.UTC),
+		schedulerDone: make(chan struct{}),
+	}
+}
+
+func (s *QuietHoursService) Start(ctx context.Context) {
+	s.logger.Info("Запуск планировщика часов тишины")
+
+	_, err := s.scheduler.Every(1).Minute().Do(func() {
+		if err := s.ReleaseHeld(ctx, time.Now()); err != nil {
+			s.logger.Error("Ошибка при отправке отложенных уведомлений",
+				"error", err,
+			)
+		}
+	})
+
+	if err != nil {
+		s.logger.Error("Ошибка при настройке планировщика часов тишины",
+			"error", err,
+		)
+
+		return
+	}
+
+	s.scheduler.StartAsync()
+}
+
+func (s *QuietHoursService) Stop() {
+	s.logger.Info("Остановка планировщика часов тишины")
+	s.scheduler.Stop()
+	close(s.schedulerDone)
+}
+
+// HoldUpdate сохраняет уведомление чата до конца его часов тишины. Часы тишины чата проверяет вызывающий.
+func (s *QuietHoursService) HoldUpdate(ctx context.Context, chatID int64, update *models.LinkUpdate) error {
+	chatUpdate := &models.LinkUpdate{
+		ID:          update.ID,
+		URL:         update.URL,
+		Description: update.Description,
+		TgChatIDs:   []int64{chatID},
+		UpdateInfo:  update.UpdateInfo,
+		Keywords:    update.Keywords,
+	}
+
+	return s.heldRepo.Add(ctx, chatID, chatUpdate)
+}
+
+// ReleaseHeld отправляет отложенные уведомления одним сообщением каждому чату, у которого в момент now
+// нет часов тишины, и удаляет отправленные из очереди.
+func (s *QuietHoursService) ReleaseHeld(ctx context.Context, now time.Time) error {
+	chatIDs, err := s.heldRepo.FindChatIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("ошибка при поиске чатов с отложенными уведомлениями: %w", err)
+	}
+
+	for _, chatID := range chatIDs {
+		chat, err := s.chatRepo.FindByID(ctx, chatID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении часов тишины чата",
+				"error", err,
+				"chatID", chatID,
+			)
+
+			continue
+		}
+
+		if chat.QuietHours != nil && chat.QuietHours.Active(now) {
+			continue
+		}
+
+		if err := s.release(ctx, chatID); err != nil {
+			s.logger.Error("Ошибка при отправке отложенных уведомлений чату",
+				"error", err,
+				"chatID", chatID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *QuietHoursService) release(ctx context.Context, chatID int64) error {
+	held, err := s.heldRepo.FindByChatID(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	if len(held) == 0 {
+		return nil
+	}
+
+	updates := make([]*models.LinkUpdate, 0, len(held))
+	ids := make([]int64, 0, len(held))
+
+	for _, item := range held {
+		updates = append(updates, item.Update)
+		ids = append(ids, item.ID)
+	}
+
+	batch := &models.LinkUpdate{
+		Description: formatUpdateList("🌙 *Обновления за часы тишины*", updates),
+		TgChatIDs:   []int64{chatID},
+	}
+
+	if err := s.notifier.SendUpdate(ctx, batch); err != nil {
+		return err
+	}
+
+	if err := s.heldRepo.Delete(ctx, ids); err != nil {
+		return err
+	}
+
+	s.logger.Info("Отложенные уведомления отправлены",
+		"chatID", chatID,
+		"updates", len(updates),
+	)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
+	repomocks "github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository/mocks"
+	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/service"
+	servicemocks "github.com/central-university-dev/go-Matthew11K/internal/scrapper/service/mocks"
+)
+
+func TestQuietHoursService_ReleaseHeld(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockNotifier := new(servicemocks.BotNotifier)
+	mockHeldRepo := new(repomocks.HeldUpdateRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+
+	// 02:30 UTC — это 05:30 в Москве и 11:30 в Токио.
+	now := time.Date(2026, 1, 10, 2, 30, 0, 0, time.UTC)
+	night := func(timezone string) *models.QuietHours {
+		return &models.QuietHours{
+			Start:    time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC),
+			End:      time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+			Timezone: timezone,
+		}
+	}
+
+	moscowChatID := int64(10)
+	tokyoChatID := int64(20)
+
+	mockHeldRepo.On("FindChatIDs", ctx).Return([]int64{moscowChatID, tokyoChatID}, nil).Once()
+	mockChatRepo.On("FindByID", ctx, moscowChatID).Return(&models.Chat{ID: moscowChatID, QuietHours: night("Europe/Moscow")}, nil).Once()
+	mockChatRepo.On("FindByID", ctx, tokyoChatID).Return(&models.Chat{ID: tokyoChatID, QuietHours: night("Asia/Tokyo")}, nil).Once()
+	mockHeldRepo.On("FindByChatID", ctx, tokyoChatID).Return([]*models.HeldUpdate{
+		{ID: 1, ChatID: tokyoChatID, Update: &models.LinkUpdate{URL: "https://github.com/owner/first", Description: "first"}},
+		{ID: 2, ChatID: tokyoChatID, Update: &models.LinkUpdate{
+			URL:        "https://github.com/owner/second",
+			UpdateInfo: &models.UpdateInfo{Title: "Hang", Author: "octocat", TextPreview: "Workers hit a deadlock"},
+			Keywords:   []string{"deadlock"},
+		}},
+	}, nil).Once()
+
+	mockNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return len(update.TgChatIDs) == 1 && update.TgChatIDs[0] == tokyoChatID &&
+			strings.HasPrefix(update.Description, "🌙 *Обновления за часы тишины*") &&
+			strings.Contains(update.Description, "https://github.com/owner/first") &&
+			strings.Contains(update.Description, "https://github.com/owner/second") &&
+			strings.Contains(update.Description, "Workers hit a *deadlock*")
+	})).Return(nil).Once()
+	mockHeldRepo.On("Delete", ctx, []int64{1, 2}).Return(nil).Once()
+
+	svc := service.NewQuietHoursService(mockNotifier, mockHeldRepo, mockChatRepo, logger)
+
+	require.NoError(t, svc.ReleaseHeld(ctx, now))
+
+	mockNotifier.AssertExpectations(t)
+	mockHeldRepo.AssertExpectations(t)
+	mockHeldRepo.AssertNotCalled(t, "FindByChatID", ctx, moscowChatID)
+}
@@ -2,20 +2,31 @@ package service
 
 import (
 	"context"
+	stderrors "errors"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 )
 
+// goneChecksToDeactivate — после стольких проверок подряд с ответом 404 или 410 ссылка отключается.
+const goneChecksToDeactivate = 3
+
 type DigestUpdater interface {
 	AddUpdate(ctx context.Context, update *models.LinkUpdate) error
 }
 
+// QuietHoursUpdater откладывает мгновенное уведомление чата до конца его часов тишины.
+type QuietHoursUpdater interface {
+	HoldUpdate(ctx context.Context, chatID int64, update *models.LinkUpdate) error
+}
+
 type BotNotifier interface {
 	SendUpdate(ctx context.Context, update *models.LinkUpdate) error
 }
@@ -40,6 +51,8 @@ type ChatRepository interface {
 	UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode, digestTime time.Time) error
 
 	FindByDigestTime(ctx context.Context, hour, minute int) ([]*models.Chat, error)
+
+	UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error
 }
 
 type LinkRepository interface {
@@ -55,7 +68,17 @@ type LinkRepository interface {
 
 	Update(ctx context.Context, link *models.Link) error
 
-	AddChatLink(ctx context.Context, chatID int64, linkID int64) error
+	AddSubscription(ctx context.Context, subscription *models.Subscription) error
+
+	MergeSubscription(ctx context.Context, subscription *models.Subscription) error
+
+	FindSubscriptions(ctx context.Context, linkID int64) ([]*models.Subscription, error)
+
+	MuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time, summary bool) error
+
+	UnmuteSubscription(ctx context.Context, chatID, linkID int64, until time.Time) (missed int, unmuted bool, err error)
+
+	AddMutedUpdates(ctx context.Context, chatID, linkID int64, count int) error
 
 	GetAll(ctx context.Context) ([]*models.Link, error)
 }
@@ -75,6 +98,7 @@ type ScrapperService struct {
 	chatRepo       ChatRepository
 	botClient      BotNotifier
 	digestUpdater  DigestUpdater
+	quietHours     QuietHoursUpdater
 	detailsRepo    ContentDetailsRepository
 	linkAnalyzer   *common.LinkAnalyzer
 	updaterFactory *common.LinkUpdaterFactory
@@ -87,6 +111,7 @@ func NewScrapperService(
 	chatRepo ChatRepository,
 	botClient BotNotifier,
 	digestUpdater DigestUpdater,
+	quietHours QuietHoursUpdater,
 	detailsRepo ContentDetailsRepository,
 	updaterFactory *common.LinkUpdaterFactory,
 	linkAnalyzer *common.LinkAnalyzer,
@@ -98,6 +123,7 @@ func NewScrapperService(
 		chatRepo:       chatRepo,
 		botClient:      botClient,
 		digestUpdater:  digestUpdater,
+		quietHours:     quietHours,
 		detailsRepo:    detailsRepo,
 		linkAnalyzer:   linkAnalyzer,
 		updaterFactory: updaterFactory,
@@ -139,78 +165,168 @@ func (s *ScrapperService) DeleteChat(ctx context.Context, chatID int64) error {
 	})
 }
 
-func (s *ScrapperService) AddLink(ctx context.Context, chatID int64, url string, tags, filters []string) (*models.Link, error) {
+func (s *ScrapperService) AddLink(ctx context.Context, chatID int64, url string,
+	tags, filters, keywords []string, mode models.NotificationMode) (*models.Link, error) {
 	var result *models.Link
 
+	url = common.CanonicalizeURL(url)
+	keywords = common.NormalizeKeywords(keywords)
+
+	if _, err := common.ParseFilters(filters); err != nil {
+		return nil, err
+	}
+
+	// Начальный снимок веб-страницы снимается до транзакции, чтобы не держать её открытой во время запроса.
+	var pageSnapshot *models.UpdateInfo
+
+	if s.linkAnalyzer.AnalyzeLink(url) == models.WebPage {
+		snapshot, err := s.takePageSnapshot(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		pageSnapshot = snapshot
+	}
+
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		chat, err := s.chatRepo.FindByID(ctx, chatID)
 		if err != nil {
 			return err
 		}
 
-		linkType := s.linkAnalyzer.AnalyzeLink(url)
-		if linkType == models.Unknown {
-			return &errors.ErrUnsupportedLinkType{URL: url}
+		linkType, err := s.linkAnalyzer.ParseLink(url)
+		if err != nil {
+			return err
 		}
 
 		existingLink, err := s.linkRepo.FindByURL(ctx, url)
 		if err == nil {
-			for _, linkID := range chat.Links {
-				if linkID == existingLink.ID {
-					return &errors.ErrLinkAlreadyExists{URL: url}
-				}
-			}
+			result = existingLink
 
-			if err := s.chatRepo.AddLink(ctx, chatID, existingLink.ID); err != nil {
-				return err
-			}
+			return s.subscribeExisting(ctx, chat, existingLink, tags, filters, keywords, mode)
+		}
 
-			if err := s.linkRepo.AddChatLink(ctx, chatID, existingLink.ID); err != nil {
-				return err
-			}
+		result, err = s.createLink(ctx, chatID, url, linkType, tags, filters, keywords, mode, pageSnapshot)
 
-			result = existingLink
+		return err
+	})
 
-			return nil
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		link := &models.Link{
-			URL:         url,
-			Type:        linkType,
-			Tags:        tags,
-			Filters:     filters,
-			LastChecked: time.Now(),
-			LastUpdated: time.Now(),
-			CreatedAt:   time.Now(),
-		}
+	return result, nil
+}
 
-		if err := s.linkRepo.Save(ctx, link); err != nil {
-			return err
+// createLink начинает отслеживать новую ссылку и подписывает на неё чат.
+func (s *ScrapperService) createLink(ctx context.Context, chatID int64, url string, linkType models.LinkType,
+	tags, filters, keywords []string, mode models.NotificationMode, pageSnapshot *models.UpdateInfo) (*models.Link, error) {
+	link := &models.Link{
+		URL:         url,
+		Type:        linkType,
+		Site:        linkSite(url, linkType),
+		Tags:        tags,
+		Filters:     filters,
+		LastChecked: time.Now(),
+		LastUpdated: time.Now(),
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.linkRepo.Save(ctx, link); err != nil {
+		return nil, err
+	}
+
+	if err := s.subscribe(ctx, chatID, link, tags, filters, keywords, mode); err != nil {
+		return nil, err
+	}
+
+	if pageSnapshot != nil {
+		details := &models.ContentDetails{
+			LinkID:      link.ID,
+			Title:       pageSnapshot.Title,
+			UpdatedAt:   pageSnapshot.UpdatedAt,
+			ContentText: pageSnapshot.FullText,
+			LinkType:    link.Type,
 		}
+		details.TruncateFields()
 
-		if err := s.chatRepo.AddLink(ctx, chatID, link.ID); err != nil {
-			return err
+		if err := s.detailsRepo.Save(ctx, details); err != nil {
+			return nil, err
 		}
+	}
+
+	return link, nil
+}
+
+// subscribeExisting подписывает чат на ссылку, которую уже отслеживают другие чаты.
+func (s *ScrapperService) subscribeExisting(ctx context.Context, chat *models.Chat, link *models.Link,
+	tags, filters, keywords []string, mode models.NotificationMode) error {
+	if slices.Contains(chat.Links, link.ID) {
+		return &errors.ErrLinkAlreadyExists{URL: link.URL}
+	}
+
+	// Повторное добавление ссылки на удалённый ранее ресурс снова включает её проверку.
+	if !link.Active {
+		link.Active = true
 
-		if err := s.linkRepo.AddChatLink(ctx, chatID, link.ID); err != nil {
+		if err := s.linkRepo.Update(ctx, link); err != nil {
 			return err
 		}
+	}
 
-		result = link
+	return s.subscribe(ctx, chat.ID, link, tags, filters, keywords, mode)
+}
 
-		return nil
-	})
+// subscribe подписывает чат на ссылку с его собственными тегами, фильтрами, ключевыми словами и режимом уведомлений.
+func (s *ScrapperService) subscribe(ctx context.Context, chatID int64, link *models.Link,
+	tags, filters, keywords []string, mode models.NotificationMode) error {
+	if err := s.chatRepo.AddLink(ctx, chatID, link.ID); err != nil {
+		return err
+	}
+
+	if err := s.linkRepo.AddSubscription(ctx, &models.Subscription{
+		ChatID:   chatID,
+		LinkID:   link.ID,
+		Tags:     tags,
+		Filters:  filters,
+		Keywords: keywords,
+		Mode:     mode,
+	}); err != nil {
+		return err
+	}
+
+	link.Tags = tags
+	link.Filters = filters
+	link.Keywords = keywords
+
+	return nil
+}
 
+// takePageSnapshot загружает текущее содержимое страницы, с которым будут сравниваться последующие проверки.
+func (s *ScrapperService) takePageSnapshot(ctx context.Context, url string) (*models.UpdateInfo, error) {
+	updater, err := s.updaterFactory.CreateUpdater(models.WebPage)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	snapshot, err := updater.GetUpdateDetails(ctx, url)
+	if err != nil {
+		s.logger.Error("Ошибка при получении снимка страницы",
+			"error", err,
+			"url", url,
+		)
+
+		return nil, err
+	}
+
+	return snapshot, nil
 }
 
 func (s *ScrapperService) RemoveLink(ctx context.Context, chatID int64, url string) (*models.Link, error) {
 	var result *models.Link
 
+	url = common.CanonicalizeURL(url)
+
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		_, err := s.chatRepo.FindByID(ctx, chatID)
 		if err != nil {
@@ -282,6 +398,8 @@ func (s *ScrapperService) CheckUpdates(ctx context.Context) error {
 				"url", link.URL,
 			)
 
+			since := link.LastUpdated
+
 			isUpdated, err := s.checkLinkUpdate(ctx, link)
 			if err != nil {
 				s.logger.Error("Ошибка при проверке обновлений",
@@ -305,9 +423,9 @@ func (s *ScrapperService) CheckUpdates(ctx context.Context) error {
 				"url", link.URL,
 			)
 
-			chats, err := s.chatRepo.FindByLinkID(ctx, link.ID)
+			subscriptions, err := s.linkRepo.FindSubscriptions(ctx, link.ID)
 			if err != nil {
-				s.logger.Error("Ошибка при получении чатов для ссылки",
+				s.logger.Error("Ошибка при получении подписок на ссылку",
 					"error", err,
 					"linkId", link.ID,
 				)
@@ -315,7 +433,7 @@ func (s *ScrapperService) CheckUpdates(ctx context.Context) error {
 				return
 			}
 
-			if len(chats) == 0 {
+			if len(subscriptions) == 0 {
 				s.logger.Info("Нет чатов для уведомления",
 					"linkId", link.ID,
 				)
@@ -323,13 +441,7 @@ func (s *ScrapperService) CheckUpdates(ctx context.Context) error {
 				return
 			}
 
-			var chatIDs []int64
-
-			for _, chat := range chats {
-				chatIDs = append(chatIDs, chat.ID)
-			}
-
-			_, err = s.notifyChatsAboutUpdate(ctx, link, chatIDs)
+			_, err = s.notifyChatsAboutUpdate(ctx, link, since, subscriptions)
 			if err != nil {
 				s.logger.Error("Ошибка при отправке уведомлений",
 					"error", err,
@@ -342,11 +454,13 @@ func (s *ScrapperService) CheckUpdates(ctx context.Context) error {
 	return nil
 }
 
-//nolint:funlen // Функция целостная, несмотря на длину
-func (s *ScrapperService) notifyChatsAboutUpdate(ctx context.Context, link *models.Link, chatIDs []int64) (bool, error) {
+// notifyChatsAboutUpdate рассылает события ссылки подписчикам. Фильтры и ключевые слова каждой подписки
+// применяются только к её чату, поэтому разные чаты могут получить разный набор событий.
+func (s *ScrapperService) notifyChatsAboutUpdate(ctx context.Context, link *models.Link, since time.Time,
+	subscriptions []*models.Subscription) (bool, error) {
 	s.logger.Info("Обработка уведомления об обновлении",
 		"linkId", link.ID,
-		"chatsCount", len(chatIDs),
+		"chatsCount", len(subscriptions),
 	)
 
 	updater, err := s.updaterFactory.CreateUpdater(link.Type)
@@ -359,298 +473,882 @@ func (s *ScrapperService) notifyChatsAboutUpdate(ctx context.Context, link *mode
 		return true, err
 	}
 
-	var updateInfo *models.UpdateInfo
+	now := time.Now()
+
+	// Пропущенные обновления засчитываются, только когда рассылка удалась: после сбоя их разошлют повторно
+	// и посчитают снова.
+	missed := make(map[int64]int)
 
-	updateInfo, err = updater.GetUpdateDetails(ctx, link.URL)
+	updates, err := s.collectUpdates(ctx, updater, link, since)
 	if err != nil {
-		s.logger.Error("Ошибка при получении деталей обновления",
-			"error", err,
-			"url", link.URL,
-		)
+		return false, s.restoreLastUpdated(ctx, link, since, err)
 	}
 
-	if updateInfo != nil {
-		err = s.saveDetailsToRepository(ctx, link, updateInfo)
-		if err != nil {
-			return true, err
+	chats := s.subscribedChats(ctx, link)
+
+	if len(updates) == 0 {
+		recipients := unmutedSubscriptions(subscriptions, nil, now, missed)
+		if len(recipients) > 0 {
+			if err := s.dispatchMatching(ctx, link, recipients, nil, chats); err != nil {
+				return true, s.restoreLastUpdated(ctx, link, since, err)
+			}
 		}
 
-		if s.shouldFilter(updateInfo, link.Filters) {
-			s.logger.Info("Обновление отфильтровано согласно настройкам",
-				"linkId", link.ID,
-				"url", link.URL,
-				"filters", link.Filters,
-				"author", updateInfo.Author,
-			)
+		s.recordMutedUpdates(ctx, link.ID, missed)
 
-			return true, nil
-		}
+		return true, s.acknowledgeUpdates(ctx, updater, link)
 	}
 
-	var description string
-	//nolint:exhaustive // Unknown обрабатывается в блоке default
-	switch link.Type {
-	case models.GitHub:
-		description = "Обнаружено обновление GitHub репозитория"
-	case models.StackOverflow:
-		description = "Обнаружено обновление вопроса StackOverflow"
-	default:
-		description = "Обнаружено обновление ссылки"
+	// Детали хранят снимок страницы: без них то же изменение находилось бы на каждой проверке,
+	// поэтому при ошибке сохранения проверка повторяется.
+	if err := s.saveDetailsToRepository(ctx, link, updates[len(updates)-1]); err != nil {
+		return true, s.restoreLastUpdated(ctx, link, since, err)
 	}
 
-	update := &models.LinkUpdate{
-		ID:          link.ID,
-		URL:         link.URL,
-		Description: description,
-		TgChatIDs:   chatIDs,
-		UpdateInfo:  updateInfo,
+	if err := s.advanceLastUpdated(ctx, link, updates); err != nil {
+		return true, err
 	}
 
-	var instantChats []int64
-
-	if s.digestUpdater != nil {
-		if err := s.digestUpdater.AddUpdate(ctx, update); err != nil {
-			s.logger.Error("Ошибка при добавлении обновления в дайджест",
-				"error", err,
-				"linkId", link.ID,
-			)
-		}
+	for _, updateInfo := range updates {
+		recipients := make([]*models.Subscription, 0, len(subscriptions))
 
-		for _, chatID := range chatIDs {
-			chat, err := s.chatRepo.FindByID(ctx, chatID)
-			if err != nil {
-				s.logger.Error("Ошибка при получении информации о чате",
-					"error", err,
-					"chatId", chatID,
+		for _, subscription := range subscriptions {
+			if s.shouldFilter(updateInfo, subscription.Filters) {
+				s.logger.Info("Обновление отфильтровано согласно настройкам",
+					"linkId", link.ID,
+					"chatId", subscription.ChatID,
+					"filters", subscription.Filters,
+					"author", updateInfo.Author,
 				)
 
 				continue
 			}
 
-			if chat.NotificationMode == models.NotificationModeInstant {
-				instantChats = append(instantChats, chatID)
-			}
+			recipients = append(recipients, subscription)
 		}
-	} else {
-		instantChats = chatIDs
-	}
 
-	if len(instantChats) > 0 {
-		instantUpdate := &models.LinkUpdate{
-			ID:          update.ID,
-			URL:         update.URL,
-			Description: update.Description,
-			TgChatIDs:   instantChats,
-			UpdateInfo:  update.UpdateInfo,
+		recipients = unmutedSubscriptions(recipients, updateInfo, now, missed)
+		if len(recipients) == 0 {
+			continue
 		}
 
-		err = s.botClient.SendUpdate(ctx, instantUpdate)
-		if err != nil {
-			s.logger.Error("Ошибка при отправке мгновенного уведомления об обновлении",
-				"error", err,
-			)
-
-			return true, err
+		if err := s.dispatchMatching(ctx, link, recipients, updateInfo, chats); err != nil {
+			return true, s.restoreLastUpdated(ctx, link, since, err)
 		}
-
-		s.logger.Info("Мгновенные уведомления успешно отправлены",
-			"linkID", link.ID,
-			"chatsCount", len(instantChats),
-		)
-	} else {
-		s.logger.Info("Нет чатов для мгновенных уведомлений",
-			"linkID", link.ID,
-		)
 	}
 
-	return true, nil
+	s.recordMutedUpdates(ctx, link.ID, missed)
+
+	return true, s.acknowledgeUpdates(ctx, updater, link)
 }
 
-func (s *ScrapperService) saveDetailsToRepository(ctx context.Context, link *models.Link, info *models.UpdateInfo) error {
-	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-		details := &models.ContentDetails{
-			LinkID:      link.ID,
-			Title:       info.Title,
-			Author:      info.Author,
-			UpdatedAt:   info.UpdatedAt,
-			ContentText: info.FullText,
-			LinkType:    link.Type,
-		}
+// acknowledgeUpdates сообщает обновителю, что полученные события разосланы.
+func (s *ScrapperService) acknowledgeUpdates(ctx context.Context, updater common.LinkUpdater, link *models.Link) error {
+	acknowledging, ok := updater.(common.AcknowledgingUpdater)
+	if !ok {
+		return nil
+	}
 
-		if err := s.detailsRepo.Save(ctx, details); err != nil {
-			s.logger.Error("Ошибка при сохранении деталей контента",
-				"error", err,
-				"linkID", link.ID,
-				"type", link.Type,
-			)
+	return acknowledging.AcknowledgeUpdates(ctx, link.URL)
+}
 
-			return err
+// advanceLastUpdated сдвигает сохранённое время последнего обновления ссылки к самому позднему из полученных событий.
+// Время изменения самого ресурса может быть раньше событий, и следующая проверка запросила бы их повторно.
+func (s *ScrapperService) advanceLastUpdated(ctx context.Context, link *models.Link, updates []*models.UpdateInfo) error {
+	latest := link.LastUpdated
+
+	for _, updateInfo := range updates {
+		if updateInfo.UpdatedAt.After(latest) {
+			latest = updateInfo.UpdatedAt
 		}
+	}
 
+	if !latest.After(link.LastUpdated) {
 		return nil
+	}
+
+	link.LastUpdated = latest
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.linkRepo.Update(ctx, link)
 	})
 }
 
-//nolint:funlen // Функция целостная
-func (s *ScrapperService) checkLinkUpdate(ctx context.Context, link *models.Link) (bool, error) {
-	updater, err := s.updaterFactory.CreateUpdater(link.Type)
-	if err != nil {
-		return false, err
-	}
-
-	s.logger.Info("Проверка обновлений для ссылки",
+// restoreLastUpdated возвращает ссылке время последнего обновления since, если события не удалось получить
+// из-за временного сбоя или разослать: иначе следующая проверка не запросит пропущенные события. Возвращает cause.
+func (s *ScrapperService) restoreLastUpdated(ctx context.Context, link *models.Link, since time.Time, cause error) error {
+	s.logger.Warn("События ссылки не получены или не разосланы, проверка будет повторена",
 		"url", link.URL,
-		"type", link.Type,
+		"error", cause,
 	)
 
-	lastUpdate, err := updater.GetLastUpdate(ctx, link.URL)
-	if err != nil {
-		s.logger.Error("Ошибка при запросе обновлений",
-			"url", link.URL,
-			"error", err,
-		)
+	link.LastUpdated = since
 
-		return false, err
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.linkRepo.Update(ctx, link)
+	}); err != nil {
+		return err
 	}
 
-	s.logger.Info("Время последнего обновления ресурса",
-		"url", link.URL,
-		"lastUpdate", lastUpdate,
-		"savedLastUpdate", link.LastUpdated,
-	)
+	return cause
+}
 
-	link.LastChecked = time.Now()
+// unmutedSubscriptions возвращает подписки, не заглушенные в момент now, и засчитывает обновление
+// в missed заглушенным, если без заглушки они получили бы его: фильтры уже применены вызывающим,
+// а ключевые слова подписки должны встречаться в тексте обновления.
+func unmutedSubscriptions(subscriptions []*models.Subscription, updateInfo *models.UpdateInfo, now time.Time,
+	missed map[int64]int) []*models.Subscription {
+	unmuted := make([]*models.Subscription, 0, len(subscriptions))
+
+	for _, subscription := range subscriptions {
+		if subscription.Muted(now) {
+			if len(subscription.Keywords) == 0 || len(common.MatchKeywords(updateInfo, subscription.Keywords)) > 0 {
+				missed[subscription.ChatID]++
+			}
 
-	if link.LastUpdated.IsZero() {
-		s.logger.Info("Первая проверка ссылки",
-			"url", link.URL,
-		)
+			continue
+		}
 
-		link.LastUpdated = lastUpdate
+		unmuted = append(unmuted, subscription)
+	}
 
-		err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-			return s.linkRepo.Update(ctx, link)
-		})
-		if err != nil {
-			return false, err
+	return unmuted
+}
+
+// recordMutedUpdates сохраняет, сколько обновлений ссылки пропустили заглушенные подписки чатов.
+func (s *ScrapperService) recordMutedUpdates(ctx context.Context, linkID int64, missed map[int64]int) {
+	for chatID, count := range missed {
+		if err := s.linkRepo.AddMutedUpdates(ctx, chatID, linkID, count); err != nil {
+			s.logger.Error("Ошибка при подсчёте обновлений, пропущенных заглушенной подпиской",
+				"error", err,
+				"chatID", chatID,
+				"linkID", linkID,
+			)
+
+			continue
 		}
 
-		return true, nil
+		s.logger.Info("Уведомления заглушенной подписки пропущены",
+			"chatID", chatID,
+			"linkID", linkID,
+			"updates", count,
+		)
 	}
+}
 
-	if lastUpdate.After(link.LastUpdated) {
-		s.logger.Info("Обнаружено обновление",
-			"url", link.URL,
-			"newTime", lastUpdate,
-			"oldTime", link.LastUpdated,
-		)
+// keywordRecipients — подписки, в тексте обновления для которых найдены одни и те же ключевые слова.
+type keywordRecipients struct {
+	keywords      []string
+	subscriptions []*models.Subscription
+}
 
-		link.LastUpdated = lastUpdate
+// dispatchMatching отправляет обновление подпискам без ключевых слов и подпискам, ключевые слова которых
+// встречаются в его тексте. Получатели группируются по найденным словам, чтобы каждый чат видел выделенными свои.
+func (s *ScrapperService) dispatchMatching(ctx context.Context, link *models.Link, subscriptions []*models.Subscription,
+	updateInfo *models.UpdateInfo, chats map[int64]*models.Chat) error {
+	var groups []*keywordRecipients
 
-		err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-			return s.linkRepo.Update(ctx, link)
-		})
-		if err != nil {
-			return false, err
+	byKeywords := make(map[string]*keywordRecipients)
+
+	for _, subscription := range subscriptions {
+		var matched []string
+
+		if len(subscription.Keywords) > 0 {
+			matched = common.MatchKeywords(updateInfo, subscription.Keywords)
+			if len(matched) == 0 {
+				s.logger.Info("Обновление не содержит ключевых слов подписки",
+					"linkId", link.ID,
+					"chatId", subscription.ChatID,
+					"keywords", subscription.Keywords,
+				)
+
+				continue
+			}
 		}
 
-		return true, nil
-	}
+		key := strings.ToLower(strings.Join(matched, "\n"))
 
-	s.logger.Info("Обновлений не обнаружено",
-		"url", link.URL,
-	)
+		group, ok := byKeywords[key]
+		if !ok {
+			group = &keywordRecipients{keywords: matched}
+			byKeywords[key] = group
+			groups = append(groups, group)
+		}
 
-	err = s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-		return s.linkRepo.Update(ctx, link)
-	})
-	if err != nil {
-		return false, err
+		group.subscriptions = append(group.subscriptions, subscription)
 	}
 
-	return false, nil
+	for _, group := range groups {
+		if err := s.dispatchUpdate(ctx, link, group.subscriptions, updateInfo, group.keywords, chats); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (s *ScrapperService) ProcessLink(ctx context.Context, link *models.Link) (bool, error) {
-	updated, err := s.checkLinkUpdate(ctx, link)
-	if err != nil {
-		return false, err
+// subscriberIDs возвращает идентификаторы чатов подписок.
+func subscriberIDs(subscriptions []*models.Subscription) []int64 {
+	chatIDs := make([]int64, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		chatIDs = append(chatIDs, subscription.ChatID)
 	}
 
-	if !updated {
-		return false, nil
-	}
+	return chatIDs
+}
 
-	s.logger.Info("Обнаружено обновление ссылки, отправка уведомлений",
-		"url", link.URL,
-		"updatedAt", link.LastUpdated,
-	)
+// collectUpdates получает отдельные события ресурса с момента since.
+// Если событий нет, используется общее описание ресурса, как и раньше.
+// Временный сбой (квота, ошибка сервера или сети) возвращается как ошибка, чтобы события запросили повторно.
+func (s *ScrapperService) collectUpdates(ctx context.Context, updater common.LinkUpdater, link *models.Link,
+	since time.Time) ([]*models.UpdateInfo, error) {
+	updates, err := updater.GetUpdatesSince(ctx, link.URL, since)
+	if httputil.IsTransient(err) {
+		return nil, err
+	}
 
-	freshLink, err := s.linkRepo.FindByID(ctx, link.ID)
 	if err != nil {
-		s.logger.Error("Ошибка при получении свежих данных о ссылке",
+		s.logger.Error("Ошибка при получении событий обновления",
 			"error", err,
-			"linkID", link.ID,
+			"url", link.URL,
 		)
-
-		return true, err
 	}
 
-	link = freshLink
+	if len(updates) > 0 {
+		return updates, nil
+	}
 
-	chats, err := s.chatRepo.FindByLinkID(ctx, link.ID)
+	updateInfo, err := updater.GetUpdateDetails(ctx, link.URL)
 	if err != nil {
-		s.logger.Error("Ошибка при получении списка чатов для ссылки",
+		s.logger.Error("Ошибка при получении деталей обновления",
+			"error", err,
+			"url", link.URL,
+		)
+
+		return nil, nil
+	}
+
+	return []*models.UpdateInfo{updateInfo}, nil
+}
+
+// linkSite возвращает сайт StackExchange, к которому относится ссылка, или пустую строку для прочих источников.
+func linkSite(url string, linkType models.LinkType) string {
+	if linkType != models.StackOverflow {
+		return ""
+	}
+
+	site, _, err := common.ParseStackOverflowURL(url)
+	if err != nil {
+		return ""
+	}
+
+	return site
+}
+
+func (s *ScrapperService) dispatchUpdate(ctx context.Context, link *models.Link, subscriptions []*models.Subscription,
+	updateInfo *models.UpdateInfo, keywords []string, chats map[int64]*models.Chat) error {
+	update := &models.LinkUpdate{
+		ID:          link.ID,
+		URL:         link.URL,
+		Description: s.linkAnalyzer.FormatLinkUpdate(link, updateInfo),
+		TgChatIDs:   subscriberIDs(subscriptions),
+		UpdateInfo:  updateInfo,
+		Keywords:    keywords,
+	}
+
+	instantChats := update.TgChatIDs
+
+	if s.linkAnalyzer.IsUrgent(link.Type) {
+		s.logger.Info("Срочное обновление отправляется всем чатам без дайджеста и часов тишины",
+			"linkId", link.ID,
+			"type", link.Type,
+		)
+	} else {
+		if s.digestUpdater != nil {
+			instantChats = s.addToDigest(ctx, update, subscriptions, chats)
+		}
+
+		if s.quietHours != nil && len(chats) > 0 && len(instantChats) > 0 {
+			instantChats = s.holdForQuietHours(ctx, update, instantChats, chats)
+		}
+	}
+
+	if len(instantChats) == 0 {
+		s.logger.Info("Нет чатов для мгновенных уведомлений",
+			"linkID", link.ID,
+		)
+
+		return nil
+	}
+
+	instantUpdate := &models.LinkUpdate{
+		ID:          update.ID,
+		URL:         update.URL,
+		Description: update.Description,
+		TgChatIDs:   instantChats,
+		UpdateInfo:  update.UpdateInfo,
+		Keywords:    update.Keywords,
+	}
+
+	if err := s.botClient.SendUpdate(ctx, instantUpdate); err != nil {
+		s.logger.Error("Ошибка при отправке мгновенного уведомления об обновлении",
+			"error", err,
+		)
+
+		return err
+	}
+
+	s.logger.Info("Мгновенные уведомления успешно отправлены",
+		"linkID", link.ID,
+		"chatsCount", len(instantChats),
+	)
+
+	return nil
+}
+
+// subscribedChats загружает режимы уведомлений и часы тишины чатов, подписанных на ссылку,
+// одним запросом на всю проверку ссылки. Если настройки чатов не нужны или их не удалось загрузить,
+// возвращается nil и уведомления отправляются сразу.
+func (s *ScrapperService) subscribedChats(ctx context.Context, link *models.Link) map[int64]*models.Chat {
+	if (s.quietHours == nil && s.digestUpdater == nil) || s.linkAnalyzer.IsUrgent(link.Type) {
+		return nil
+	}
+
+	chats, err := s.chatRepo.FindByLinkID(ctx, link.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении настроек чатов, уведомления отправляются сразу",
 			"error", err,
 			"linkID", link.ID,
 		)
 
-		return true, err
+		return nil
 	}
 
-	chatIDs := make([]int64, 0, len(chats))
+	byID := make(map[int64]*models.Chat, len(chats))
+
 	for _, chat := range chats {
-		chatIDs = append(chatIDs, chat.ID)
+		byID[chat.ID] = chat
+	}
+
+	return byID
+}
+
+// holdForQuietHours откладывает уведомление чатов, у которых сейчас часы тишины, и возвращает остальные чаты.
+// Если уведомление не удалось отложить, чат получает его сразу.
+func (s *ScrapperService) holdForQuietHours(ctx context.Context, update *models.LinkUpdate, chatIDs []int64,
+	chats map[int64]*models.Chat) []int64 {
+	now := time.Now()
+
+	var instantChats []int64
+
+	for _, chatID := range chatIDs {
+		if chat := chats[chatID]; chat == nil || chat.QuietHours == nil || !chat.QuietHours.Active(now) {
+			instantChats = append(instantChats, chatID)
+			continue
+		}
+
+		if err := s.quietHours.HoldUpdate(ctx, chatID, update); err != nil {
+			s.logger.Error("Ошибка при откладывании уведомления до конца часов тишины",
+				"error", err,
+				"chatID", chatID,
+			)
+
+			instantChats = append(instantChats, chatID)
+
+			continue
+		}
+
+		s.logger.Info("Уведомление отложено до конца часов тишины",
+			"chatID", chatID,
+			"linkID", update.ID,
+		)
+	}
+
+	return instantChats
+}
+
+// addToDigest откладывает обновление в дайджест чатов, выбравших этот режим, и возвращает чаты, которым оно нужно сразу.
+// Режим подписки важнее режима чата; пустой режим подписки означает режим чата.
+// Чат, настройки которого не удалось загрузить, получает обновление сразу.
+func (s *ScrapperService) addToDigest(ctx context.Context, update *models.LinkUpdate,
+	subscriptions []*models.Subscription, chats map[int64]*models.Chat) []int64 {
+	var instantChats, digestChats []int64
+
+	for _, subscription := range subscriptions {
+		mode := subscription.Mode
+
+		if chat := chats[subscription.ChatID]; mode == "" && chat != nil {
+			mode = chat.NotificationMode
+		}
+
+		if mode == models.NotificationModeDigest {
+			digestChats = append(digestChats, subscription.ChatID)
+		} else {
+			instantChats = append(instantChats, subscription.ChatID)
+		}
+	}
+
+	if len(digestChats) == 0 {
+		return instantChats
+	}
+
+	if err := s.digestUpdater.AddUpdate(ctx, &models.LinkUpdate{
+		ID:          update.ID,
+		URL:         update.URL,
+		Description: update.Description,
+		TgChatIDs:   digestChats,
+		UpdateInfo:  update.UpdateInfo,
+		Keywords:    update.Keywords,
+	}); err != nil {
+		s.logger.Error("Ошибка при добавлении обновления в дайджест",
+			"error", err,
+			"linkId", update.ID,
+		)
+	}
+
+	return instantChats
+}
+
+// saveDetailsToRepository сохраняет последнее событие ссылки как её детали.
+func (s *ScrapperService) saveDetailsToRepository(ctx context.Context, link *models.Link, info *models.UpdateInfo) error {
+	details := &models.ContentDetails{
+		LinkID:      link.ID,
+		Title:       info.Title,
+		Author:      info.Author,
+		UpdatedAt:   info.UpdatedAt,
+		ContentText: info.FullText,
+		LinkType:    link.Type,
+	}
+	details.TruncateFields()
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.detailsRepo.Save(ctx, details)
+	})
+}
+
+func (s *ScrapperService) checkLinkUpdate(ctx context.Context, link *models.Link) (bool, error) {
+	updater, err := s.updaterFactory.CreateUpdater(link.Type)
+	if err != nil {
+		return false, err
+	}
+
+	s.logger.Info("Проверка обновлений для ссылки",
+		"url", link.URL,
+		"type", link.Type,
+	)
+
+	validators := &httputil.Validators{ETag: link.ETag, LastModified: link.LastModified}
+
+	lastUpdate, err := updater.GetLastUpdate(httputil.WithValidators(ctx, validators), link.URL)
+	if err != nil {
+		return s.handleCheckError(ctx, link, err)
+	}
+
+	link.ETag = validators.ETag
+	link.LastModified = validators.LastModified
+	link.GoneChecks = 0
+
+	return s.applyLastUpdate(ctx, link, lastUpdate)
+}
+
+// handleCheckError обрабатывает ошибку запроса состояния ссылки. Переезд и удаление ресурса
+// обрабатываются здесь же и не считаются ошибкой проверки.
+func (s *ScrapperService) handleCheckError(ctx context.Context, link *models.Link, err error) (bool, error) {
+	var moved *errors.ErrResourceMoved
+
+	switch {
+	case stderrors.Is(err, &errors.ErrNotModified{}):
+		s.logger.Info("Ресурс не изменился с прошлой проверки",
+			"url", link.URL,
+		)
+
+		link.LastChecked = time.Now()
+		link.GoneChecks = 0
+
+		if txErr := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			return s.linkRepo.Update(ctx, link)
+		}); txErr != nil {
+			return false, txErr
+		}
+
+		return false, err
+	case stderrors.Is(err, &errors.ErrRateLimited{}):
+		s.logger.Warn("Квота API исчерпана, проверка ссылки отложена",
+			"url", link.URL,
+			"error", err,
+		)
+
+		return false, err
+	case stderrors.As(err, &moved):
+		return false, s.moveLink(ctx, link, moved)
+	case stderrors.Is(err, &errors.ErrResourceGone{}):
+		return false, s.handleGoneResource(ctx, link, err)
+	default:
+		s.logger.Error("Ошибка при запросе обновлений",
+			"url", link.URL,
+			"error", err,
+		)
+
+		return false, err
+	}
+}
+
+// moveLink переносит ссылку на новый адрес ресурса и сообщает об этом подписчикам.
+// Если новый адрес уже отслеживается, подписки переходят на существующую ссылку, а прежняя удаляется.
+func (s *ScrapperService) moveLink(ctx context.Context, link *models.Link, moved *errors.ErrResourceMoved) error {
+	oldURL := link.URL
+	newURL := common.CanonicalizeURL(movedLinkURL(oldURL, moved))
+
+	s.logger.Info("Ресурс ссылки переехал, адрес ссылки будет обновлён",
+		"url", oldURL,
+		"newUrl", newURL,
+	)
+
+	target := link
+
+	var subscriptions []*models.Subscription
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		subscriptions, err = s.linkRepo.FindSubscriptions(ctx, link.ID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := s.linkRepo.FindByURL(ctx, newURL)
+		if err == nil {
+			target = existing
+
+			for _, subscription := range subscriptions {
+				// Подписка переезжает вместе со своими тегами, фильтрами, ключевыми словами, режимом уведомлений
+				// и заглушкой, а если чат уже отслеживает новый адрес, объединяется с его подпиской.
+				if err := s.linkRepo.MergeSubscription(ctx, &models.Subscription{
+					ChatID:       subscription.ChatID,
+					LinkID:       existing.ID,
+					Tags:         subscription.Tags,
+					Filters:      subscription.Filters,
+					Keywords:     subscription.Keywords,
+					Mode:         subscription.Mode,
+					MutedUntil:   subscription.MutedUntil,
+					MuteSummary:  subscription.MuteSummary,
+					MutedUpdates: subscription.MutedUpdates,
+				}); err != nil {
+					return err
+				}
+
+				if err := s.linkRepo.DeleteByURL(ctx, oldURL, subscription.ChatID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		if !stderrors.Is(err, &errors.ErrLinkNotFound{}) {
+			return err
+		}
+
+		link.URL = newURL
+		link.ETag = ""
+		link.LastModified = ""
+		link.LastChecked = time.Now()
+
+		return s.linkRepo.Update(ctx, link)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notifyLinkStatus(ctx, target, subscriptions, "Ресурс переехал с адреса "+oldURL+", ссылка обновлена")
+}
+
+// movedLinkURL сохраняет путь ссылки внутри ресурса (например, /issues/1), заменяя прежний адрес ресурса новым.
+func movedLinkURL(linkURL string, moved *errors.ErrResourceMoved) string {
+	if len(linkURL) >= len(moved.URL) && strings.EqualFold(linkURL[:len(moved.URL)], moved.URL) {
+		return moved.NewURL + linkURL[len(moved.URL):]
+	}
+
+	return moved.NewURL
+}
+
+// handleGoneResource отключает ссылку, только если API сообщает об удалении ресурса goneChecksToDeactivate
+// проверок подряд: одиночный 404 бывает и у существующего ресурса, например при сбое API.
+func (s *ScrapperService) handleGoneResource(ctx context.Context, link *models.Link, cause error) error {
+	link.GoneChecks++
+	if link.GoneChecks >= goneChecksToDeactivate {
+		return s.deactivateLink(ctx, link, cause)
+	}
+
+	s.logger.Warn("API сообщил, что ресурс ссылки удалён, проверка будет повторена",
+		"url", link.URL,
+		"goneChecks", link.GoneChecks,
+		"error", cause,
+	)
+
+	link.LastChecked = time.Now()
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.linkRepo.Update(ctx, link)
+	})
+}
+
+// deactivateLink помечает ссылку на удалённый ресурс неактивной и уведомляет подписчиков.
+// Неактивные ссылки планировщик больше не проверяет, поэтому уведомление приходит один раз.
+func (s *ScrapperService) deactivateLink(ctx context.Context, link *models.Link, cause error) error {
+	s.logger.Warn("Ресурс ссылки удалён, ссылка больше не будет проверяться",
+		"url", link.URL,
+		"error", cause,
+	)
+
+	var subscriptions []*models.Subscription
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		link.Active = false
+		link.LastChecked = time.Now()
+
+		if err := s.linkRepo.Update(ctx, link); err != nil {
+			return err
+		}
+
+		var err error
+
+		subscriptions, err = s.linkRepo.FindSubscriptions(ctx, link.ID)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notifyLinkStatus(ctx, link, subscriptions,
+		"Ресурс удалён или стал недоступен, ссылка больше не проверяется. Удалите её командой /untrack")
+}
+
+// notifyLinkStatus сразу, минуя дайджест, сообщает подписчикам об изменении состояния ссылки.
+func (s *ScrapperService) notifyLinkStatus(ctx context.Context, link *models.Link, subscriptions []*models.Subscription,
+	description string) error {
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	if err := s.botClient.SendUpdate(ctx, &models.LinkUpdate{
+		ID:          link.ID,
+		URL:         link.URL,
+		Description: description,
+		TgChatIDs:   subscriberIDs(subscriptions),
+	}); err != nil {
+		s.logger.Error("Ошибка при отправке уведомления о состоянии ссылки",
+			"error", err,
+			"linkId", link.ID,
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// applyLastUpdate сохраняет результат проверки ссылки и сообщает, обновился ли ресурс с прошлой проверки.
+func (s *ScrapperService) applyLastUpdate(ctx context.Context, link *models.Link, lastUpdate time.Time) (bool, error) {
+	s.logger.Info("Время последнего обновления ресурса",
+		"url", link.URL,
+		"lastUpdate", lastUpdate,
+		"savedLastUpdate", link.LastUpdated,
+	)
+
+	link.LastChecked = time.Now()
+
+	if link.LastUpdated.IsZero() {
+		s.logger.Info("Первая проверка ссылки",
+			"url", link.URL,
+		)
+
+		link.LastUpdated = lastUpdate
+
+		err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			return s.linkRepo.Update(ctx, link)
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	if lastUpdate.After(link.LastUpdated) {
+		s.logger.Info("Обнаружено обновление",
+			"url", link.URL,
+			"newTime", lastUpdate,
+			"oldTime", link.LastUpdated,
+		)
+
+		link.LastUpdated = lastUpdate
+
+		err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			return s.linkRepo.Update(ctx, link)
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	s.logger.Info("Обновлений не обнаружено",
+		"url", link.URL,
+	)
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.linkRepo.Update(ctx, link)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// ProcessLink проверяет ссылку и рассылает уведомления об обнаруженных обновлениях.
+// Если API ответил 304 Not Modified, возвращается ErrNotModified, чтобы планировщик учёл сэкономленный запрос.
+func (s *ScrapperService) ProcessLink(ctx context.Context, link *models.Link) (bool, error) {
+	since := link.LastUpdated
+
+	updated, err := s.checkLinkUpdate(ctx, link)
+	if err != nil {
+		return false, err
+	}
+
+	if !updated {
+		return false, nil
+	}
+
+	return s.notifySubscribers(ctx, link, since)
+}
+
+// CheckLinks проверяет ссылки источников, поддерживающих пакетные запросы, и возвращает время
+// последнего обновления по ID ссылки. Ссылки, отсутствующие в результате, проверяются через ProcessLink.
+func (s *ScrapperService) CheckLinks(ctx context.Context, links []*models.Link) map[int64]time.Time {
+	urlsByType := make(map[models.LinkType][]string)
+	linksByURL := make(map[string][]*models.Link, len(links))
+
+	for _, link := range links {
+		if _, ok := s.updaterFactory.CreateBatchUpdater(link.Type); !ok {
+			continue
+		}
+
+		if _, ok := linksByURL[link.URL]; !ok {
+			urlsByType[link.Type] = append(urlsByType[link.Type], link.URL)
+		}
+
+		linksByURL[link.URL] = append(linksByURL[link.URL], link)
+	}
+
+	checked := make(map[int64]time.Time, len(linksByURL))
+
+	for linkType, urls := range urlsByType {
+		updater, _ := s.updaterFactory.CreateBatchUpdater(linkType)
+
+		lastUpdates, err := updater.GetLastUpdates(ctx, urls)
+		if err != nil {
+			s.logger.Error("Ошибка при пакетной проверке ссылок, ссылки будут проверены по одной",
+				"error", err,
+				"type", linkType,
+				"count", len(urls),
+			)
+
+			continue
+		}
+
+		for url, lastUpdate := range lastUpdates {
+			for _, link := range linksByURL[url] {
+				checked[link.ID] = lastUpdate
+			}
+		}
+
+		s.logger.Info("Пакетная проверка ссылок",
+			"type", linkType,
+			"requested", len(urls),
+			"checked", len(lastUpdates),
+		)
+	}
+
+	return checked
+}
+
+// ProcessCheckedLink обрабатывает ссылку, время последнего обновления которой уже получено пакетной проверкой.
+func (s *ScrapperService) ProcessCheckedLink(ctx context.Context, link *models.Link, lastUpdate time.Time) (bool, error) {
+	since := link.LastUpdated
+
+	updated, err := s.applyLastUpdate(ctx, link, lastUpdate)
+	if err != nil {
+		return false, err
+	}
+
+	if !updated {
+		return false, nil
+	}
+
+	return s.notifySubscribers(ctx, link, since)
+}
+
+// notifySubscribers рассылает уведомления об обновлении ссылки всем подписанным на неё чатам.
+func (s *ScrapperService) notifySubscribers(ctx context.Context, link *models.Link, since time.Time) (bool, error) {
+	s.logger.Info("Обнаружено обновление ссылки, отправка уведомлений",
+		"url", link.URL,
+		"updatedAt", link.LastUpdated,
+	)
+
+	freshLink, err := s.linkRepo.FindByID(ctx, link.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении свежих данных о ссылке",
+			"error", err,
+			"linkID", link.ID,
+		)
+
+		return true, err
+	}
+
+	link = freshLink
+
+	subscriptions, err := s.linkRepo.FindSubscriptions(ctx, link.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении подписок на ссылку",
+			"error", err,
+			"linkID", link.ID,
+		)
+
+		return true, err
 	}
 
-	if len(chatIDs) == 0 {
+	if len(subscriptions) == 0 {
 		s.logger.Info("Нет подписчиков для уведомления об обновлении ссылки", "linkID", link.ID)
 		return true, nil
 	}
 
-	return s.notifyChatsAboutUpdate(ctx, link, chatIDs)
+	return s.notifyChatsAboutUpdate(ctx, link, since, subscriptions)
 }
 
+// shouldFilter применяет к обновлению фильтры подписки. Некорректные фильтры, сохранённые до появления
+// проверки при добавлении ссылки, пропускаются с предупреждением.
 func (s *ScrapperService) shouldFilter(updateInfo *models.UpdateInfo, filters []string) bool {
 	if updateInfo == nil || len(filters) == 0 {
 		return false
 	}
 
+	parsed := make([]*common.UpdateFilter, 0, len(filters))
+
 	for _, filter := range filters {
-		parts := strings.SplitN(filter, "=", 2)
-		if len(parts) != 2 {
-			s.logger.Warn("Некорректный формат фильтра", "filter", filter)
+		updateFilter, err := common.ParseFilter(filter)
+		if err != nil {
+			s.logger.Warn("Некорректный фильтр пропущен", "filter", filter, "error", err)
 			continue
 		}
 
-		key, value := parts[0], parts[1]
-
-		switch key {
-		case "user":
-			if strings.EqualFold(updateInfo.Author, value) {
-				s.logger.Info("Применен фильтр по пользователю",
-					"filter", filter,
-					"author", updateInfo.Author,
-				)
-
-				return true
-			}
-		default:
-			s.logger.Warn("Неизвестный тип фильтра", "filterType", key)
-		}
+		parsed = append(parsed, updateFilter)
 	}
 
-	return false
+	return common.Suppressed(updateInfo, parsed)
 }
 
 func (s *ScrapperService) UpdateNotificationSettings(ctx context.Context, chatID int64, mode models.NotificationMode,
@@ -664,3 +1362,125 @@ func (s *ScrapperService) UpdateNotificationSettings(ctx context.Context, chatID
 		return s.chatRepo.UpdateNotificationSettings(ctx, chatID, mode, digestTime)
 	})
 }
+
+// UpdateQuietHours задаёт часы тишины чата. Значение nil отключает их.
+func (s *ScrapperService) UpdateQuietHours(ctx context.Context, chatID int64, quietHours *models.QuietHours) error {
+	if quietHours != nil {
+		if _, err := time.LoadLocation(quietHours.Timezone); err != nil {
+			return &errors.ErrInvalidValue{FieldName: "Timezone", Value: quietHours.Timezone}
+		}
+	}
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.chatRepo.FindByID(ctx, chatID)
+		if err != nil {
+			return err
+		}
+
+		return s.chatRepo.UpdateQuietHours(ctx, chatID, quietHours)
+	})
+}
+
+// MuteLink заглушает ссылку чата до until: она проверяется как обычно, но уведомления о ней не приходят.
+// Если summary, по окончании заглушки чат получит сводку пропущенных обновлений.
+func (s *ScrapperService) MuteLink(ctx context.Context, chatID int64, url string, until time.Time,
+	summary bool) (*models.Link, error) {
+	if !until.After(time.Now()) {
+		return nil, &errors.ErrInvalidValue{FieldName: "MutedUntil", Value: until.Format(time.RFC3339)}
+	}
+
+	var result *models.Link
+
+	url = common.CanonicalizeURL(url)
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.chatRepo.FindByID(ctx, chatID); err != nil {
+			return err
+		}
+
+		link, err := s.linkRepo.FindByURL(ctx, url)
+		if err != nil {
+			return err
+		}
+
+		if err := s.linkRepo.MuteSubscription(ctx, chatID, link.ID, until, summary); err != nil {
+			return err
+		}
+
+		link.MutedUntil = &until
+		result = link
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UnmuteLink снимает заглушку со ссылки чата и, если чат просил, отправляет сводку пропущенных обновлений.
+// Сводка отправляется, только если заглушку снял этот вызов, а не планировщик или параллельный запрос.
+func (s *ScrapperService) UnmuteLink(ctx context.Context, chatID int64, url string) (*models.Link, error) {
+	var (
+		result       *models.Link
+		subscription *models.Subscription
+		missed       int
+		unmuted      bool
+	)
+
+	url = common.CanonicalizeURL(url)
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.chatRepo.FindByID(ctx, chatID); err != nil {
+			return err
+		}
+
+		link, err := s.linkRepo.FindByURL(ctx, url)
+		if err != nil {
+			return err
+		}
+
+		subscriptions, err := s.linkRepo.FindSubscriptions(ctx, link.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, candidate := range subscriptions {
+			if candidate.ChatID == chatID {
+				subscription = candidate
+			}
+		}
+
+		if subscription == nil {
+			return &errors.ErrLinkNotInChat{ChatID: chatID, LinkID: link.ID}
+		}
+
+		result = link
+
+		if subscription.MutedUntil == nil {
+			return nil
+		}
+
+		missed, unmuted, err = s.linkRepo.UnmuteSubscription(ctx, chatID, link.ID, *subscription.MutedUntil)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if unmuted && subscription.MuteSummary && missed > 0 {
+		if err := s.botClient.SendUpdate(ctx, newMuteSummary(chatID, result, missed)); err != nil {
+			s.logger.Error("Ошибка при отправке сводки пропущенных обновлений",
+				"error", err,
+				"chatID", chatID,
+				"linkID", result.ID,
+			)
+		}
+	}
+
+	return result, nil
+}
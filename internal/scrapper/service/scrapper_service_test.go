@@ -9,12 +9,14 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/central-university-dev/go-Matthew11K/internal/common"
+	"github.com/central-university-dev/go-Matthew11K/internal/common/httputil"
 	commonmocks "github.com/central-university-dev/go-Matthew11K/internal/common/mocks"
 	domainErrors "github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
@@ -41,8 +43,11 @@ func TestScrapperService_AddLink(t *testing.T) {
 	mockGithubClient := new(commonmocks.GitHubClient)
 	mockStackOverflowClient := new(commonmocks.StackOverflowClient)
 
-	linkAnalyzer := common.NewLinkAnalyzer()
-	updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:        mockGithubClient,
+		StackOverflow: mockStackOverflowClient,
+	})
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
@@ -50,7 +55,8 @@ func TestScrapperService_AddLink(t *testing.T) {
 	chatID := int64(123)
 	url := testRepoURL
 	tags := []string{"tag1", "tag2"}
-	filters := []string{"filter1", "filter2"}
+	filters := []string{"author=dependabot", "include type=release or type=pr"}
+	keywords := []string{" deadlock ", "CVE-", "Deadlock"}
 
 	mockChat := &models.Chat{
 		ID:        chatID,
@@ -73,7 +79,13 @@ func TestScrapperService_AddLink(t *testing.T) {
 	})
 
 	mockChatRepo.On("AddLink", ctx, chatID, int64(1)).Return(nil)
-	mockLinkRepo.On("AddChatLink", ctx, chatID, int64(1)).Return(nil)
+	mockLinkRepo.On("AddSubscription", ctx, mock.MatchedBy(func(subscription *models.Subscription) bool {
+		return subscription.ChatID == chatID && subscription.LinkID == 1 &&
+			assert.ElementsMatch(t, tags, subscription.Tags) &&
+			assert.ElementsMatch(t, filters, subscription.Filters) &&
+			assert.Equal(t, []string{"deadlock", "CVE-"}, subscription.Keywords) &&
+			subscription.Mode == models.NotificationModeDigest
+	})).Return(nil)
 	mockLinkRepo.On("GetAll", ctx).Return([]*models.Link{}, nil).Maybe()
 
 	mockTxManager.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
@@ -88,6 +100,7 @@ func TestScrapperService_AddLink(t *testing.T) {
 		mockChatRepo,
 		mockBotNotifier,
 		mockDigestService,
+		nil,
 		mockDetailsRepo,
 		updaterFactory,
 		linkAnalyzer,
@@ -95,13 +108,14 @@ func TestScrapperService_AddLink(t *testing.T) {
 		mockTxManager,
 	)
 
-	link, err := scrapperService.AddLink(ctx, chatID, url, tags, filters)
+	link, err := scrapperService.AddLink(ctx, chatID, url, tags, filters, keywords, models.NotificationModeDigest)
 
 	require.NoError(t, err)
 	assert.NotNil(t, link)
 	assert.Equal(t, url, link.URL)
 	assert.ElementsMatch(t, tags, link.Tags)
 	assert.ElementsMatch(t, filters, link.Filters)
+	assert.Equal(t, []string{"deadlock", "CVE-"}, link.Keywords)
 	assert.Equal(t, models.GitHub, link.Type)
 
 	mockLinkRepo.AssertExpectations(t)
@@ -109,6 +123,40 @@ func TestScrapperService_AddLink(t *testing.T) {
 	mockTxManager.AssertExpectations(t)
 }
 
+func TestScrapperService_AddLink_InvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	scrapperService := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		new(servicemocks.BotNotifier),
+		nil,
+		nil,
+		new(repomocks.ContentDetailsRepository),
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	link, err := scrapperService.AddLink(context.Background(), 123, testRepoURL, nil, []string{"type=bug"}, nil, "")
+
+	require.Error(t, err)
+	assert.Nil(t, link)
+	assert.ErrorIs(t, err, &domainErrors.ErrInvalidFilter{})
+	assert.Contains(t, err.Error(), "неизвестный тип обновления 'bug'")
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything, mock.Anything)
+	mockLinkRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
 func TestScrapperService_AddDuplicateLink(t *testing.T) {
 	t.Parallel()
 
@@ -122,8 +170,11 @@ func TestScrapperService_AddDuplicateLink(t *testing.T) {
 	mockGithubClient := new(commonmocks.GitHubClient)
 	mockStackOverflowClient := new(commonmocks.StackOverflowClient)
 
-	linkAnalyzer := common.NewLinkAnalyzer()
-	updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:        mockGithubClient,
+		StackOverflow: mockStackOverflowClient,
+	})
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
@@ -132,7 +183,7 @@ func TestScrapperService_AddDuplicateLink(t *testing.T) {
 	chatID := int64(123)
 	url := testRepoURL
 	tags := []string{"tag1", "tag2"}
-	filters := []string{"filter1", "filter2"}
+	filters := []string{"author=dependabot"}
 	existingLinkID := int64(1)
 
 	existingLink := &models.Link{
@@ -165,6 +216,7 @@ func TestScrapperService_AddDuplicateLink(t *testing.T) {
 		mockChatRepo,
 		mockBotNotifier,
 		mockDigestService,
+		nil,
 		mockDetailsRepo,
 		updaterFactory,
 		linkAnalyzer,
@@ -172,7 +224,7 @@ func TestScrapperService_AddDuplicateLink(t *testing.T) {
 		mockTxManager,
 	)
 
-	link, err := scrapperService.AddLink(ctx, chatID, url, tags, filters)
+	link, err := scrapperService.AddLink(ctx, chatID, url, tags, filters, nil, "")
 
 	require.Error(t, err)
 
@@ -201,7 +253,7 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 	updateTime := now.Add(time.Minute)
 	lastCheckTime := now.Add(-time.Hour)
 
-	linkAnalyzer := common.NewLinkAnalyzer()
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
 
 	t.Run("GitHub Preview Truncation", func(t *testing.T) {
 		mockLinkRepo := new(repomocks.LinkRepository)
@@ -213,7 +265,10 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
 		mockTxManager := new(txsmocks.TxManager)
 
-		updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub:        mockGithubClient,
+			StackOverflow: mockStackOverflowClient,
+		})
 
 		contentDetails := &models.ContentDetails{
 			LinkID:      linkID,
@@ -232,15 +287,21 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 			LastUpdated: time.Time{},
 		}
 
-		mockGithubClient.On("GetRepositoryLastUpdate", ctx, "owner", "repo").Return(updateTime, nil).Once()
+		mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(updateTime, nil).Once()
+		mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
 		mockLinkRepo.On("Update", ctx, mock.Anything).Return(nil).Once()
 		mockLinkRepo.On("FindByID", ctx, linkID).Return(githubLink, nil).Once()
-		mockChatRepo.On("FindByLinkID", ctx, linkID).Return([]*models.Chat{{ID: chatIDs[0]}, {ID: chatIDs[1]}, {ID: chatIDs[2]}}, nil).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, linkID).Return([]*models.Subscription{
+			{ChatID: chatIDs[0]}, {ChatID: chatIDs[1]}, {ChatID: chatIDs[2]},
+		}, nil).Once()
 
+		chats := make([]*models.Chat, 0, len(chatIDs))
 		for _, chatID := range chatIDs {
-			mockChatRepo.On("FindByID", ctx, chatID).Return(&models.Chat{ID: chatID, NotificationMode: models.NotificationModeInstant}, nil).Once()
+			chats = append(chats, &models.Chat{ID: chatID, NotificationMode: models.NotificationModeInstant})
 		}
 
+		mockChatRepo.On("FindByLinkID", ctx, linkID).Return(chats, nil).Once()
+
 		mockGithubClient.On("GetRepositoryDetails", ctx, "owner", "repo").Return(contentDetails, nil).Once()
 		mockDetailsRepo.On("Save", ctx, mock.MatchedBy(func(details *models.ContentDetails) bool {
 			return details.LinkID == linkID && details.ContentText == longText
@@ -263,6 +324,7 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 			mockChatRepo,
 			mockBotNotifier,
 			mockDigestService,
+			nil,
 			mockDetailsRepo,
 			updaterFactory,
 			linkAnalyzer,
@@ -292,7 +354,10 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
 		mockTxManager := new(txsmocks.TxManager)
 
-		updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub:        mockGithubClient,
+			StackOverflow: mockStackOverflowClient,
+		})
 
 		contentDetails := &models.ContentDetails{
 			LinkID:      linkID,
@@ -311,16 +376,21 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 			LastUpdated: time.Time{},
 		}
 
-		mockStackOverflowClient.On("GetQuestionLastUpdate", ctx, int64(12345)).Return(updateTime, nil).Once()
+		mockStackOverflowClient.On("GetQuestionLastUpdate", mock.Anything, "stackoverflow", int64(12345)).Return(updateTime, nil).Once()
 		mockLinkRepo.On("Update", ctx, mock.Anything).Return(nil).Once()
 		mockLinkRepo.On("FindByID", ctx, linkID).Return(soLink, nil).Once()
-		mockChatRepo.On("FindByLinkID", ctx, linkID).Return([]*models.Chat{{ID: chatIDs[0]}, {ID: chatIDs[1]}, {ID: chatIDs[2]}}, nil).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, linkID).Return([]*models.Subscription{
+			{ChatID: chatIDs[0]}, {ChatID: chatIDs[1]}, {ChatID: chatIDs[2]},
+		}, nil).Once()
 
+		chats := make([]*models.Chat, 0, len(chatIDs))
 		for _, chatID := range chatIDs {
-			mockChatRepo.On("FindByID", ctx, chatID).Return(&models.Chat{ID: chatID, NotificationMode: models.NotificationModeInstant}, nil).Once()
+			chats = append(chats, &models.Chat{ID: chatID, NotificationMode: models.NotificationModeInstant})
 		}
 
-		mockStackOverflowClient.On("GetQuestionDetails", ctx, int64(12345)).Return(contentDetails, nil).Once()
+		mockChatRepo.On("FindByLinkID", ctx, linkID).Return(chats, nil).Once()
+
+		mockStackOverflowClient.On("GetQuestionDetails", ctx, "stackoverflow", int64(12345)).Return(contentDetails, nil).Once()
 		mockDetailsRepo.On("Save", ctx, mock.MatchedBy(func(details *models.ContentDetails) bool {
 			return details.LinkID == linkID && details.ContentText == shortText
 		})).Return(nil).Once()
@@ -342,6 +412,7 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 			mockChatRepo,
 			mockBotNotifier,
 			mockDigestService,
+			nil,
 			mockDetailsRepo,
 			updaterFactory,
 			linkAnalyzer,
@@ -362,173 +433,1519 @@ func TestScrapperService_ProcessLink_Preview(t *testing.T) {
 	})
 }
 
-func TestScrapperService_ProcessLink_Scenarios(t *testing.T) {
+func TestScrapperService_ProcessLink_GitHubEvents(t *testing.T) {
 	t.Parallel()
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	ctx := context.Background()
 
-	t.Run("Обработка ссылки на GitHub без обновлений", func(t *testing.T) {
-		mockLinkRepo := new(repomocks.LinkRepository)
-		mockChatRepo := new(repomocks.ChatRepository)
-		mockBotNotifier := new(servicemocks.BotNotifier)
-		mockDigestService := new(servicemocks.DigestUpdater)
-		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
-		mockGithubClient := new(commonmocks.GitHubClient)
-		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
-		mockTxManager := new(txsmocks.TxManager)
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+	mockTxManager := new(txsmocks.TxManager)
 
-		linkAnalyzer := common.NewLinkAnalyzer()
-		updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:        mockGithubClient,
+		StackOverflow: mockStackOverflowClient,
+	})
 
-		now := time.Now()
-		lastUpdate := now.Add(-time.Hour)
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	chatID := int64(10)
+	otherChatID := int64(20)
+
+	githubLink := &models.Link{
+		ID:          7,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
 
-		githubLink := &models.Link{
-			ID:          1,
-			URL:         "https://github.com/owner/repo",
-			Type:        models.GitHub,
-			LastChecked: now.Add(-time.Hour * 24),
-			LastUpdated: lastUpdate,
-		}
+	events := []*models.UpdateInfo{
+		{Title: "#1 Bug", Author: "alice", ContentType: "issue", UpdatedAt: now.Add(-30 * time.Minute)},
+		{Title: "#2 Chore", Author: "bot", ContentType: "pull_request", UpdatedAt: now.Add(-20 * time.Minute)},
+		{Title: "#3 Fix", Author: "bob", ContentType: "pull_request", UpdatedAt: now.Add(-10 * time.Minute)},
+	}
 
-		mockGithubClient.On("GetRepositoryLastUpdate", ctx, "owner", "repo").Return(lastUpdate, nil).Once()
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return(events, nil).Once()
 
-		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
-			Run(func(args mock.Arguments) {
-				fn := args.Get(1).(func(context.Context) error)
-				err := fn(ctx)
-				require.NoError(t, err)
-			})
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	// Фильтр первого чата не должен влиять на второй чат, подписанный на ту же ссылку.
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: chatID, Filters: []string{"user=bot"}},
+		{ChatID: otherChatID},
+	}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.MatchedBy(func(details *models.ContentDetails) bool {
+		return details.Title == "#3 Fix"
+	})).Return(nil).Once()
+
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo != nil && update.UpdateInfo.Title == "#1 Bug" &&
+			assert.ObjectsAreEqual([]int64{chatID, otherChatID}, update.TgChatIDs)
+	})).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo != nil && update.UpdateInfo.Title == "#2 Chore" &&
+			assert.ObjectsAreEqual([]int64{otherChatID}, update.TgChatIDs)
+	})).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo != nil && update.UpdateInfo.Title == "#3 Fix" &&
+			assert.ObjectsAreEqual([]int64{chatID, otherChatID}, update.TgChatIDs)
+	})).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
 
-		mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
 
-		svc := service.NewScrapperService(
-			mockLinkRepo,
-			mockChatRepo,
-			mockBotNotifier,
-			mockDigestService,
-			mockDetailsRepo,
-			updaterFactory,
-			linkAnalyzer,
-			logger,
-			mockTxManager,
-		)
+	mockGithubClient.AssertExpectations(t)
+	mockBotNotifier.AssertExpectations(t)
+	mockDetailsRepo.AssertExpectations(t)
+	mockBotNotifier.AssertNumberOfCalls(t, "SendUpdate", 3)
+}
 
-		updated, err := svc.ProcessLink(ctx, githubLink)
-		require.NoError(t, err)
-		assert.False(t, updated)
+func TestScrapperService_ProcessLink_RetriesWhenDetailsNotSaved(t *testing.T) {
+	t.Parallel()
 
-		mockGithubClient.AssertExpectations(t)
-		mockLinkRepo.AssertExpectations(t)
-		mockTxManager.AssertExpectations(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
 	})
 
-	t.Run("Обработка ссылки на StackOverflow с обновлениями, но без подписчиков", func(t *testing.T) {
-		mockLinkRepo := new(repomocks.LinkRepository)
-		mockChatRepo := new(repomocks.ChatRepository)
-		mockBotNotifier := new(servicemocks.BotNotifier)
-		mockDigestService := new(servicemocks.DigestUpdater)
-		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
-		mockGithubClient := new(commonmocks.GitHubClient)
-		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
-		mockTxManager := new(txsmocks.TxManager)
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	chatID := int64(10)
+
+	githubLink := &models.Link{
+		ID:          7,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
 
-		linkAnalyzer := common.NewLinkAnalyzer()
-		updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+	// Заголовок и автор длиннее колонок content_details.
+	longTitle := "#1 " + strings.Repeat("Очень длинный заголовок ", 30)
+	longAuthor := strings.Repeat("a", 150)
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return([]*models.UpdateInfo{
+		{Title: longTitle, Author: longAuthor, ContentType: "issue", UpdatedAt: now.Add(-time.Minute)},
+	}, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil)
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{{ChatID: chatID}}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.MatchedBy(func(details *models.ContentDetails) bool {
+		return utf8.RuneCountInString(details.Title) == models.MaxDetailsTitleLength &&
+			len(details.Author) == models.MaxDetailsAuthorLength
+	})).Return(errors.New("database is unavailable")).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
 
-		now := time.Now()
-		lastUpdate := now.Add(-time.Hour)
-		newUpdate := now.Add(-time.Minute)
+	// Без сохранённых деталей то же изменение нашлось бы снова, поэтому рассылка откладывается до повторной проверки.
+	_, err := svc.ProcessLink(ctx, githubLink)
+	require.Error(t, err)
+	assert.Equal(t, lastUpdate, githubLink.LastUpdated)
 
-		soLink := &models.Link{
-			ID:          2,
-			URL:         "https://stackoverflow.com/questions/12345",
-			Type:        models.StackOverflow,
-			LastChecked: now.Add(-time.Hour * 24),
-			LastUpdated: lastUpdate,
-		}
+	mockDetailsRepo.AssertExpectations(t)
+	mockBotNotifier.AssertNotCalled(t, "SendUpdate", mock.Anything, mock.Anything)
+}
 
-		mockStackOverflowClient.On("GetQuestionLastUpdate", ctx, int64(12345)).Return(newUpdate, nil).Once()
+func TestScrapperService_ProcessLink_AdvancesLastUpdatedToLatestEvent(t *testing.T) {
+	t.Parallel()
 
-		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
-			Run(func(args mock.Arguments) {
-				fn := args.Get(1).(func(context.Context) error)
-				err := fn(ctx)
-				require.NoError(t, err)
-			})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
 
-		mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
-		mockLinkRepo.On("FindByID", ctx, int64(2)).Return(soLink, nil).Once()
-		mockChatRepo.On("FindByLinkID", ctx, soLink.ID).Return([]*models.Chat{}, nil).Once()
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
 
-		mockDigestService.On("AddUpdate", mock.Anything, mock.AnythingOfType("*models.LinkUpdate")).Return(nil).Maybe()
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
 
-		svc := service.NewScrapperService(
-			mockLinkRepo,
-			mockChatRepo,
-			mockBotNotifier,
-			mockDigestService,
-			mockDetailsRepo,
-			updaterFactory,
-			linkAnalyzer,
-			logger,
-			mockTxManager,
-		)
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	repoUpdatedAt := now.Add(-30 * time.Minute)
+	issueUpdatedAt := now.Add(-10 * time.Minute)
 
-		updated, err := svc.ProcessLink(ctx, soLink)
-		require.NoError(t, err)
-		assert.True(t, updated)
+	githubLink := &models.Link{ID: 7, URL: testRepoURL, Type: models.GitHub, LastUpdated: lastUpdate}
 
-		mockStackOverflowClient.AssertExpectations(t)
-		mockLinkRepo.AssertExpectations(t)
-		mockChatRepo.AssertExpectations(t)
-		mockTxManager.AssertExpectations(t)
+	// updated_at репозитория не меняется от активности в issues, поэтому следующая проверка
+	// должна запрашивать issues с момента последнего полученного события.
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(repoUpdatedAt, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return([]*models.UpdateInfo{
+		{Title: "#1 Bug", Author: "alice", ContentType: "issue", UpdatedAt: issueUpdatedAt},
+	}, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			require.NoError(t, fn(ctx))
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(repoUpdatedAt)
+	})).Return(nil).Once()
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(issueUpdatedAt)
+	})).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{{ChatID: 10}}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.AnythingOfType("*models.LinkUpdate")).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		new(repomocks.ChatRepository),
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		common.NewLinkAnalyzer(common.NewDefaultSourceRegistry()),
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, issueUpdatedAt, githubLink.LastUpdated)
+
+	mockLinkRepo.AssertExpectations(t)
+	mockBotNotifier.AssertExpectations(t)
+}
+
+func TestScrapperService_ProcessLink_KeepsLastUpdatedWhenEventsRateLimited(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
 	})
 
-	t.Run("Ошибка при получении обновлений", func(t *testing.T) {
-		mockLinkRepo := new(repomocks.LinkRepository)
-		mockChatRepo := new(repomocks.ChatRepository)
-		mockBotNotifier := new(servicemocks.BotNotifier)
-		mockDigestService := new(servicemocks.DigestUpdater)
-		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
-		mockGithubClient := new(commonmocks.GitHubClient)
-		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
-		mockTxManager := new(txsmocks.TxManager)
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	repoUpdatedAt := now.Add(-30 * time.Minute)
+	resetAt := now.Add(time.Hour)
 
-		linkAnalyzer := common.NewLinkAnalyzer()
-		updaterFactory := common.NewLinkUpdaterFactory(mockGithubClient, mockStackOverflowClient)
+	githubLink := &models.Link{ID: 7, URL: testRepoURL, Type: models.GitHub, LastUpdated: lastUpdate}
 
-		now := time.Now()
-		expectedErr := errors.New("API error")
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(repoUpdatedAt, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).
+		Return(nil, &domainErrors.ErrRateLimited{Service: "github", ResetAt: resetAt}).Once()
 
-		githubLink := &models.Link{
-			ID:          3,
-			URL:         "https://github.com/owner/repo",
-			Type:        models.GitHub,
-			LastChecked: now.Add(-time.Hour * 24),
-			LastUpdated: now.Add(-time.Hour),
-		}
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			require.NoError(t, fn(ctx))
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(repoUpdatedAt)
+	})).Return(nil).Once()
+	// События не получены, поэтому следующая проверка должна снова запросить их с прежнего момента.
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(lastUpdate)
+	})).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{{ChatID: 10}}, nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		new(repomocks.ChatRepository),
+		mockBotNotifier,
+		nil,
+		nil,
+		new(repomocks.ContentDetailsRepository),
+		updaterFactory,
+		common.NewLinkAnalyzer(common.NewDefaultSourceRegistry()),
+		logger,
+		mockTxManager,
+	)
 
-		mockGithubClient.On("GetRepositoryLastUpdate", ctx, "owner", "repo").Return(time.Time{}, expectedErr).Once()
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.ErrorIs(t, err, &domainErrors.ErrRateLimited{})
+	assert.False(t, updated)
+	assert.Equal(t, lastUpdate, githubLink.LastUpdated)
 
-		svc := service.NewScrapperService(
-			mockLinkRepo,
-			mockChatRepo,
-			mockBotNotifier,
-			mockDigestService,
-			mockDetailsRepo,
-			updaterFactory,
-			linkAnalyzer,
-			logger,
-			mockTxManager,
-		)
+	mockLinkRepo.AssertExpectations(t)
+	mockBotNotifier.AssertNotCalled(t, "SendUpdate", mock.Anything, mock.Anything)
+}
 
-		updated, err := svc.ProcessLink(ctx, githubLink)
-		require.Error(t, err)
-		assert.Equal(t, expectedErr, err)
-		assert.False(t, updated)
+func TestScrapperService_ProcessLink_RetriesWorkflowUpdateWhenDispatchFails(t *testing.T) {
+	t.Parallel()
 
-		mockGithubClient.AssertExpectations(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockRunStore := commonmocks.NewWorkflowRunStore(t)
+	mockTxManager := new(txsmocks.TxManager)
+
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:       mockGithubClient,
+		WorkflowRuns: mockRunStore,
 	})
+
+	now := time.Now().Truncate(time.Second)
+	lastUpdate := now.Add(-time.Hour)
+	url := "https://github.com/owner/repo/actions/workflows/ci.yml"
+
+	passed := &models.WorkflowRun{ID: 1, Number: 1, Name: "CI", Conclusion: "success", UpdatedAt: lastUpdate}
+	failed := &models.WorkflowRun{ID: 2, Number: 2, Name: "CI", Conclusion: "failure", UpdatedAt: now.Add(-time.Minute)}
+
+	link := &models.Link{ID: 9, URL: url, Type: models.GitHubWorkflow, LastUpdated: lastUpdate}
+
+	mockGithubClient.On("GetWorkflowRuns", mock.Anything, "owner", "repo", "ci.yml").
+		Return([]*models.WorkflowRun{passed, failed}, nil).Twice()
+	mockRunStore.On("GetLastRun", mock.Anything, url).Return(passed, nil).Twice()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			require.NoError(t, fn(ctx))
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(failed.UpdatedAt)
+	})).Return(nil).Once()
+	// Уведомление не доставлено, поэтому следующая проверка должна снова обнаружить смену итога.
+	mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+		return link.LastUpdated.Equal(lastUpdate)
+	})).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, link.ID).Return(link, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, link.ID).Return([]*models.Subscription{{ChatID: 10}}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.AnythingOfType("*models.LinkUpdate")).
+		Return(errors.New("bot unavailable")).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		new(repomocks.ChatRepository),
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		common.NewLinkAnalyzer(common.NewDefaultSourceRegistry()),
+		logger,
+		mockTxManager,
+	)
+
+	_, err := svc.ProcessLink(ctx, link)
+	require.Error(t, err)
+	assert.Equal(t, lastUpdate, link.LastUpdated)
+
+	mockLinkRepo.AssertExpectations(t)
+	mockRunStore.AssertNotCalled(t, "SaveLastRun", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScrapperService_ProcessLink_Keywords(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDigestService := new(servicemocks.DigestUpdater)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
+
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	deadlockChatID := int64(10)
+	cveChatID := int64(20)
+	allChatID := int64(30)
+
+	githubLink := &models.Link{
+		ID:          7,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
+
+	events := []*models.UpdateInfo{
+		{Title: "#1 Hang on shutdown", ContentType: "issue", FullText: "Workers hit a Deadlock", UpdatedAt: now.Add(-20 * time.Minute)},
+		{Title: "#2 Docs", ContentType: "pull_request", FullText: "Typo fixes", UpdatedAt: now.Add(-10 * time.Minute)},
+	}
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return(events, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: deadlockChatID, Keywords: []string{"deadlock", "race"}, Mode: models.NotificationModeDigest},
+		{ChatID: cveChatID, Keywords: []string{"CVE-"}},
+		{ChatID: allChatID},
+	}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+
+	mockChatRepo.On("FindByLinkID", ctx, githubLink.ID).Return([]*models.Chat{
+		{ID: deadlockChatID, NotificationMode: models.NotificationModeInstant},
+		{ID: cveChatID, NotificationMode: models.NotificationModeInstant},
+		{ID: allChatID, NotificationMode: models.NotificationModeInstant},
+	}, nil).Once()
+
+	// Чаты с разными найденными ключевыми словами получают отдельные уведомления со своим выделением,
+	// в том числе в дайджесте.
+	mockDigestService.On("AddUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo.Title == "#1 Hang on shutdown" &&
+			assert.ObjectsAreEqual([]int64{deadlockChatID}, update.TgChatIDs) &&
+			assert.ObjectsAreEqual([]string{"deadlock"}, update.Keywords)
+	})).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo.Title == "#1 Hang on shutdown" &&
+			assert.ObjectsAreEqual([]int64{allChatID}, update.TgChatIDs) && len(update.Keywords) == 0
+	})).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo.Title == "#2 Docs" &&
+			assert.ObjectsAreEqual([]int64{allChatID}, update.TgChatIDs) && len(update.Keywords) == 0
+	})).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		mockDigestService,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockBotNotifier.AssertExpectations(t)
+	mockBotNotifier.AssertNumberOfCalls(t, "SendUpdate", 2)
+	mockDigestService.AssertExpectations(t)
+}
+
+func TestScrapperService_ProcessLink_ChatSettingsUnavailable(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDigestService := new(servicemocks.DigestUpdater)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
+
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	chatID := int64(10)
+
+	githubLink := &models.Link{
+		ID:          8,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
+
+	events := []*models.UpdateInfo{
+		{Title: "#1 Bug", ContentType: "issue", UpdatedAt: now.Add(-20 * time.Minute)},
+		{Title: "#2 Fix", ContentType: "pull_request", UpdatedAt: now.Add(-10 * time.Minute)},
+	}
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return(events, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: chatID},
+	}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+
+	// Настройки чатов читаются один раз на проверку ссылки, а без них обновления приходят сразу, а не теряются.
+	mockChatRepo.On("FindByLinkID", ctx, githubLink.ID).Return(nil, errors.New("db unavailable")).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{chatID}, update.TgChatIDs)
+	})).Return(nil).Twice()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		mockDigestService,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockChatRepo.AssertExpectations(t)
+	mockBotNotifier.AssertExpectations(t)
+	mockDigestService.AssertNotCalled(t, "AddUpdate", mock.Anything, mock.Anything)
+}
+
+func TestScrapperService_ProcessLink_QuietHours(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockQuietHours := new(servicemocks.QuietHoursUpdater)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
+
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	quietChatID := int64(10)
+	loudChatID := int64(20)
+	failingChatID := int64(30)
+
+	githubLink := &models.Link{
+		ID:          9,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
+
+	// Окно на два часа вокруг текущего момента активно при любом времени запуска теста.
+	activeWindow := &models.QuietHours{Start: now.Add(-time.Hour).UTC(), End: now.Add(time.Hour).UTC(), Timezone: "UTC"}
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return([]*models.UpdateInfo{
+		{Title: "#1 Hang on shutdown", ContentType: "issue", UpdatedAt: now.Add(-20 * time.Minute)},
+		{Title: "#2 Leak in pool", ContentType: "issue", UpdatedAt: now.Add(-10 * time.Minute)},
+	}, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: quietChatID},
+		{ChatID: loudChatID},
+		{ChatID: failingChatID},
+	}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+
+	// Часы тишины загружаются один раз на всю проверку ссылки, а не для каждого обновления.
+	mockChatRepo.On("FindByLinkID", ctx, githubLink.ID).Return([]*models.Chat{
+		{ID: quietChatID, QuietHours: activeWindow},
+		{ID: loudChatID},
+		{ID: failingChatID, QuietHours: activeWindow},
+	}, nil).Once()
+
+	mockQuietHours.On("HoldUpdate", ctx, quietChatID, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo.Title == "#1 Hang on shutdown"
+	})).Return(nil).Once()
+	mockQuietHours.On("HoldUpdate", ctx, quietChatID, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo.Title == "#2 Leak in pool"
+	})).Return(nil).Once()
+	mockQuietHours.On("HoldUpdate", ctx, failingChatID, mock.Anything).Return(errors.New("db down")).Twice()
+
+	// Чат, уведомление которого не удалось отложить, получает его сразу.
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{loudChatID, failingChatID}, update.TgChatIDs)
+	})).Return(nil).Twice()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		nil,
+		mockQuietHours,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockQuietHours.AssertExpectations(t)
+	mockBotNotifier.AssertExpectations(t)
+	mockChatRepo.AssertExpectations(t)
+}
+
+func TestScrapperService_ProcessLink_MutedSubscription(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
+
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	mutedUntil := now.Add(72 * time.Hour)
+	expiredMute := now.Add(-time.Minute)
+	mutedChatID := int64(10)
+	loudChatID := int64(20)
+	expiredChatID := int64(30)
+	leakChatID := int64(40)
+
+	githubLink := &models.Link{
+		ID:          9,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Once()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return([]*models.UpdateInfo{
+		{Title: "#1 Hang on shutdown", ContentType: "issue", UpdatedAt: now.Add(-20 * time.Minute)},
+		{Title: "#2 Leak in pool", ContentType: "issue", UpdatedAt: now.Add(-10 * time.Minute)},
+	}, nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: mutedChatID, MutedUntil: &mutedUntil, MuteSummary: true},
+		{ChatID: loudChatID},
+		{ChatID: expiredChatID, MutedUntil: &expiredMute, MuteSummary: true},
+		{ChatID: leakChatID, Keywords: []string{"leak"}, MutedUntil: &mutedUntil, MuteSummary: true},
+	}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+	mockChatRepo.On("FindByLinkID", ctx, githubLink.ID).Return([]*models.Chat{
+		{ID: mutedChatID},
+		{ID: loudChatID},
+		{ID: expiredChatID},
+		{ID: leakChatID},
+	}, nil)
+
+	// Истёкшая заглушка ещё не снята планировщиком, но уже не мешает уведомлениям.
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{loudChatID, expiredChatID}, update.TgChatIDs)
+	})).Return(nil).Twice()
+	mockLinkRepo.On("AddMutedUpdates", ctx, mutedChatID, githubLink.ID, 2).Return(nil).Once()
+	// Заглушенная подписка с ключевыми словами пропустила только обновление, в котором они встречаются.
+	mockLinkRepo.On("AddMutedUpdates", ctx, leakChatID, githubLink.ID, 1).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockBotNotifier.AssertExpectations(t)
+	mockLinkRepo.AssertExpectations(t)
+}
+
+func TestScrapperService_ProcessLink_MutedUpdatesCountedOnceAfterRetry(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub: mockGithubClient,
+	})
+
+	now := time.Now()
+	lastUpdate := now.Add(-time.Hour)
+	mutedUntil := now.Add(72 * time.Hour)
+	mutedChatID := int64(10)
+	loudChatID := int64(20)
+
+	githubLink := &models.Link{
+		ID:          9,
+		URL:         testRepoURL,
+		Type:        models.GitHub,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: lastUpdate,
+	}
+
+	mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(now, nil).Twice()
+	mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Twice()
+	mockGithubClient.On("GetIssuesSince", ctx, "owner", "repo", lastUpdate).Return([]*models.UpdateInfo{
+		{Title: "#1 Hang on shutdown", ContentType: "issue", UpdatedAt: now.Add(-20 * time.Minute)},
+	}, nil).Twice()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil)
+	mockLinkRepo.On("FindByID", ctx, githubLink.ID).Return(githubLink, nil)
+	mockLinkRepo.On("FindSubscriptions", ctx, githubLink.ID).Return([]*models.Subscription{
+		{ChatID: mutedChatID, MutedUntil: &mutedUntil, MuteSummary: true},
+		{ChatID: loudChatID},
+	}, nil)
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil)
+
+	mockBotNotifier.On("SendUpdate", ctx, mock.AnythingOfType("*models.LinkUpdate")).
+		Return(errors.New("bot unavailable")).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.AnythingOfType("*models.LinkUpdate")).Return(nil).Once()
+	mockLinkRepo.On("AddMutedUpdates", ctx, mutedChatID, githubLink.ID, 1).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		nil,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	// Неудачная рассылка будет повторена, поэтому пропущенное обновление пока не засчитывается.
+	_, err := svc.ProcessLink(ctx, githubLink)
+	require.Error(t, err)
+	assert.Equal(t, lastUpdate, githubLink.LastUpdated)
+	mockLinkRepo.AssertNotCalled(t, "AddMutedUpdates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	updated, err := svc.ProcessLink(ctx, githubLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockBotNotifier.AssertExpectations(t)
+	mockLinkRepo.AssertExpectations(t)
+	mockLinkRepo.AssertNumberOfCalls(t, "AddMutedUpdates", 1)
+}
+
+func TestScrapperService_MuteLink_PastDeadline(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mockTxManager := new(txsmocks.TxManager)
+
+	svc := service.NewScrapperService(nil, nil, nil, nil, nil, nil, nil, nil, logger, mockTxManager)
+
+	_, err := svc.MuteLink(context.Background(), 1, testRepoURL, time.Now().Add(-time.Hour), true)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, &domainErrors.ErrInvalidValue{}))
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything, mock.Anything)
+}
+
+func TestScrapperService_UnmuteLink_SendsSummary(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockTxManager := new(txsmocks.TxManager)
+
+	chatID := int64(10)
+	mutedUntil := time.Now().Add(time.Hour)
+	link := &models.Link{ID: 9, URL: testRepoURL, Type: models.GitHub}
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockChatRepo.On("FindByID", ctx, chatID).Return(&models.Chat{ID: chatID}, nil).Once()
+	mockLinkRepo.On("FindByURL", ctx, testRepoURL).Return(link, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, link.ID).Return([]*models.Subscription{
+		{ChatID: 20},
+		{ChatID: chatID, LinkID: link.ID, MutedUntil: &mutedUntil, MuteSummary: true, MutedUpdates: 2},
+	}, nil).Once()
+	// Счётчик пропущенных обновлений берётся из снятой заглушки, а не из прочитанной раньше подписки.
+	mockLinkRepo.On("UnmuteSubscription", ctx, chatID, link.ID, mutedUntil).Return(3, true, nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{chatID}, update.TgChatIDs) && update.URL == testRepoURL &&
+			strings.Contains(update.Description, "пропущено обновлений — 3")
+	})).Return(nil).Once()
+
+	svc := service.NewScrapperService(mockLinkRepo, mockChatRepo, mockBotNotifier, nil, nil, nil, nil, nil, logger,
+		mockTxManager)
+
+	result, err := svc.UnmuteLink(ctx, chatID, testRepoURL)
+	require.NoError(t, err)
+	assert.Nil(t, result.MutedUntil)
+
+	mockLinkRepo.AssertExpectations(t)
+	mockBotNotifier.AssertExpectations(t)
+}
+
+func TestScrapperService_UpdateQuietHours_InvalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockTxManager := new(txsmocks.TxManager)
+
+	svc := service.NewScrapperService(nil, mockChatRepo, nil, nil, nil, nil, nil, nil, logger, mockTxManager)
+
+	err := svc.UpdateQuietHours(context.Background(), 1, &models.QuietHours{Timezone: "Mars/Olympus"})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, &domainErrors.ErrInvalidValue{}))
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything, mock.Anything)
+	mockChatRepo.AssertNotCalled(t, "UpdateQuietHours", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScrapperService_ProcessLink_SecurityAdvisory(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockLinkRepo := new(repomocks.LinkRepository)
+	mockChatRepo := new(repomocks.ChatRepository)
+	mockBotNotifier := new(servicemocks.BotNotifier)
+	mockDigestService := new(servicemocks.DigestUpdater)
+	mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockAdvisoryStore := new(commonmocks.AdvisoryStore)
+	mockTxManager := new(txsmocks.TxManager)
+
+	linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:     mockGithubClient,
+		Advisories: mockAdvisoryStore,
+	})
+
+	now := time.Now()
+	advisoryURL := "https://github.com/owner/repo/security/advisories"
+	chatID := int64(10)
+
+	advisoryLink := &models.Link{
+		ID:          8,
+		URL:         advisoryURL,
+		Type:        models.GitHubAdvisory,
+		LastChecked: now.Add(-time.Hour),
+		LastUpdated: now.Add(-time.Hour),
+	}
+
+	advisories := []*models.SecurityAdvisory{
+		{
+			GHSAID:    "GHSA-2222",
+			Summary:   "Path traversal",
+			Severity:  "critical",
+			Publisher: "alice",
+			Vulnerabilities: []models.AdvisoryVulnerability{
+				{Ecosystem: "go", Package: "example.com/lib", VulnerableRange: "< 1.2.0", PatchedVersions: "1.2.0"},
+			},
+			PublishedAt: now.Add(-10 * time.Minute),
+			UpdatedAt:   now.Add(-10 * time.Minute),
+		},
+		{
+			GHSAID:      "GHSA-1111",
+			CVEID:       "CVE-2024-0001",
+			Summary:     "Open redirect",
+			Severity:    "moderate",
+			PublishedAt: now.Add(-48 * time.Hour),
+			UpdatedAt:   now.Add(-20 * time.Minute),
+		},
+	}
+
+	mockGithubClient.On("GetSecurityAdvisories", mock.Anything, "owner", "repo").Return(advisories, nil)
+	mockAdvisoryStore.On("GetSeenAdvisories", mock.Anything, advisoryURL).Return([]string{"GHSA-1111"}, true, nil)
+	mockAdvisoryStore.On("ReplaceSeenAdvisories", ctx, advisoryURL, []string{"GHSA-2222", "GHSA-1111/CVE-2024-0001"}).
+		Return(nil).Once()
+
+	mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			err := fn(ctx)
+			require.NoError(t, err)
+		})
+
+	mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+	mockLinkRepo.On("FindByID", ctx, advisoryLink.ID).Return(advisoryLink, nil).Once()
+	mockLinkRepo.On("FindSubscriptions", ctx, advisoryLink.ID).Return([]*models.Subscription{{ChatID: chatID}}, nil).Once()
+	mockDetailsRepo.On("Save", ctx, mock.AnythingOfType("*models.ContentDetails")).Return(nil).Once()
+
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo != nil && update.UpdateInfo.Title == "GHSA-1111: Open redirect" &&
+			strings.Contains(update.Description, "Бюллетеню назначен CVE-2024-0001")
+	})).Return(nil).Once()
+	mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+		return update.UpdateInfo != nil && update.UpdateInfo.Title == "GHSA-2222: Path traversal" &&
+			strings.Contains(update.Description, "Критичность: critical") &&
+			strings.Contains(update.Description, "Затронуты: example.com/lib < 1.2.0, исправлено в 1.2.0")
+	})).Return(nil).Once()
+
+	svc := service.NewScrapperService(
+		mockLinkRepo,
+		mockChatRepo,
+		mockBotNotifier,
+		mockDigestService,
+		nil,
+		mockDetailsRepo,
+		updaterFactory,
+		linkAnalyzer,
+		logger,
+		mockTxManager,
+	)
+
+	updated, err := svc.ProcessLink(ctx, advisoryLink)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	mockBotNotifier.AssertExpectations(t)
+	mockAdvisoryStore.AssertExpectations(t)
+	mockDigestService.AssertNotCalled(t, "AddUpdate", mock.Anything, mock.Anything)
+	mockChatRepo.AssertNotCalled(t, "FindByLinkID", mock.Anything, mock.Anything)
+}
+
+func TestScrapperService_ProcessLink_Scenarios(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	t.Run("Обработка ссылки на GitHub без обновлений", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockDigestService := new(servicemocks.DigestUpdater)
+		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub:        mockGithubClient,
+			StackOverflow: mockStackOverflowClient,
+		})
+
+		now := time.Now()
+		lastUpdate := now.Add(-time.Hour)
+
+		githubLink := &models.Link{
+			ID:          1,
+			URL:         "https://github.com/owner/repo",
+			Type:        models.GitHub,
+			LastChecked: now.Add(-time.Hour * 24),
+			LastUpdated: lastUpdate,
+		}
+
+		mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(lastUpdate, nil).Once()
+		mockGithubClient.On("GetIssuesLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, nil).Once()
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			})
+
+		mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			mockDigestService,
+			nil,
+			mockDetailsRepo,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		updated, err := svc.ProcessLink(ctx, githubLink)
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		mockGithubClient.AssertExpectations(t)
+		mockLinkRepo.AssertExpectations(t)
+		mockTxManager.AssertExpectations(t)
+	})
+
+	t.Run("Обработка ссылки на StackOverflow с обновлениями, но без подписчиков", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockDigestService := new(servicemocks.DigestUpdater)
+		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub:        mockGithubClient,
+			StackOverflow: mockStackOverflowClient,
+		})
+
+		now := time.Now()
+		lastUpdate := now.Add(-time.Hour)
+		newUpdate := now.Add(-time.Minute)
+
+		soLink := &models.Link{
+			ID:          2,
+			URL:         "https://stackoverflow.com/questions/12345",
+			Type:        models.StackOverflow,
+			LastChecked: now.Add(-time.Hour * 24),
+			LastUpdated: lastUpdate,
+		}
+
+		mockStackOverflowClient.On("GetQuestionLastUpdate", mock.Anything, "stackoverflow", int64(12345)).Return(newUpdate, nil).Once()
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			})
+
+		mockLinkRepo.On("Update", ctx, mock.AnythingOfType("*models.Link")).Return(nil).Once()
+		mockLinkRepo.On("FindByID", ctx, int64(2)).Return(soLink, nil).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, soLink.ID).Return([]*models.Subscription{}, nil).Once()
+
+		mockDigestService.On("AddUpdate", mock.Anything, mock.AnythingOfType("*models.LinkUpdate")).Return(nil).Maybe()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			mockDigestService,
+			nil,
+			mockDetailsRepo,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		updated, err := svc.ProcessLink(ctx, soLink)
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		mockStackOverflowClient.AssertExpectations(t)
+		mockLinkRepo.AssertExpectations(t)
+		mockChatRepo.AssertExpectations(t)
+		mockTxManager.AssertExpectations(t)
+	})
+
+	t.Run("Ошибка при получении обновлений", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockDigestService := new(servicemocks.DigestUpdater)
+		mockDetailsRepo := new(repomocks.ContentDetailsRepository)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub:        mockGithubClient,
+			StackOverflow: mockStackOverflowClient,
+		})
+
+		now := time.Now()
+		expectedErr := errors.New("API error")
+
+		githubLink := &models.Link{
+			ID:          3,
+			URL:         "https://github.com/owner/repo",
+			Type:        models.GitHub,
+			LastChecked: now.Add(-time.Hour * 24),
+			LastUpdated: now.Add(-time.Hour),
+		}
+
+		mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").Return(time.Time{}, expectedErr).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			mockDigestService,
+			nil,
+			mockDetailsRepo,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		updated, err := svc.ProcessLink(ctx, githubLink)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, updated)
+
+		mockGithubClient.AssertExpectations(t)
+	})
+	t.Run("Ресурс не изменился с прошлой проверки", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub: mockGithubClient,
+		})
+
+		now := time.Now()
+
+		githubLink := &models.Link{
+			ID:          4,
+			URL:         "https://github.com/owner/repo",
+			Type:        models.GitHub,
+			ETag:        `"abc123"`,
+			LastChecked: now.Add(-time.Hour),
+			LastUpdated: now.Add(-time.Hour * 24),
+		}
+
+		withETag := mock.MatchedBy(func(ctx context.Context) bool {
+			validators := httputil.ValidatorsFromContext(ctx)
+			return validators != nil && validators.ETag == `"abc123"`
+		})
+		mockGithubClient.On("GetRepositoryLastUpdate", withETag, "owner", "repo").
+			Return(time.Time{}, &domainErrors.ErrNotModified{URL: githubLink.URL}).Once()
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			}).Once()
+		mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+			return link.ETag == `"abc123"` && link.LastChecked.After(now.Add(-time.Minute))
+		})).Return(nil).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			nil,
+			nil,
+			nil,
+			nil,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		updated, err := svc.ProcessLink(ctx, githubLink)
+		require.ErrorIs(t, err, &domainErrors.ErrNotModified{})
+		assert.False(t, updated)
+
+		mockGithubClient.AssertExpectations(t)
+		mockGithubClient.AssertNotCalled(t, "GetIssuesLastUpdate", mock.Anything, mock.Anything, mock.Anything)
+		mockLinkRepo.AssertExpectations(t)
+		mockLinkRepo.AssertNotCalled(t, "FindSubscriptions", mock.Anything, mock.Anything)
+	})
+	t.Run("Репозиторий переименован", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub: mockGithubClient,
+		})
+
+		link := &models.Link{
+			ID:     5,
+			URL:    "https://github.com/owner/repo",
+			Type:   models.GitHub,
+			ETag:   `"abc123"`,
+			Active: true,
+		}
+
+		mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").
+			Return(time.Time{}, &domainErrors.ErrResourceMoved{
+				URL:    "https://github.com/owner/repo",
+				NewURL: "https://github.com/new-owner/new-repo",
+			}).Once()
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			}).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, int64(5)).Return([]*models.Subscription{{ChatID: 10}, {ChatID: 20}}, nil).Once()
+		mockLinkRepo.On("FindByURL", ctx, "https://github.com/new-owner/new-repo").
+			Return(nil, &domainErrors.ErrLinkNotFound{URL: "https://github.com/new-owner/new-repo"}).Once()
+		mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+			return link.URL == "https://github.com/new-owner/new-repo" && link.ETag == ""
+		})).Return(nil).Once()
+		mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+			return update.ID == 5 && update.URL == "https://github.com/new-owner/new-repo" &&
+				assert.ElementsMatch(t, []int64{10, 20}, update.TgChatIDs)
+		})).Return(nil).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			nil,
+			nil,
+			nil,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		updated, err := svc.ProcessLink(ctx, link)
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		mockLinkRepo.AssertExpectations(t)
+		mockChatRepo.AssertExpectations(t)
+		mockBotNotifier.AssertExpectations(t)
+	})
+	t.Run("Репозиторий переехал на уже отслеживаемый адрес", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockGithubClient := new(commonmocks.GitHubClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			GitHub: mockGithubClient,
+		})
+
+		link := &models.Link{ID: 6, URL: "https://github.com/owner/repo", Type: models.GitHub, Active: true}
+		existing := &models.Link{ID: 7, URL: "https://github.com/new-owner/repo", Type: models.GitHub, Active: true}
+		mutedUntil := time.Now().Add(time.Hour)
+
+		mockGithubClient.On("GetRepositoryLastUpdate", mock.Anything, "owner", "repo").
+			Return(time.Time{}, &domainErrors.ErrResourceMoved{URL: link.URL, NewURL: existing.URL}).Once()
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			}).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, int64(6)).Return([]*models.Subscription{
+			{
+				ChatID: 10, Tags: []string{"release"}, Mode: models.NotificationModeDigest,
+				MutedUntil: &mutedUntil, MuteSummary: true, MutedUpdates: 2,
+			},
+		}, nil).Once()
+		mockLinkRepo.On("FindByURL", ctx, existing.URL).Return(existing, nil).Once()
+		mockLinkRepo.On("MergeSubscription", ctx, mock.MatchedBy(func(subscription *models.Subscription) bool {
+			return subscription.ChatID == 10 && subscription.LinkID == 7 && subscription.Mode == models.NotificationModeDigest &&
+				assert.Equal(t, []string{"release"}, subscription.Tags) &&
+				subscription.MutedUntil == &mutedUntil && subscription.MuteSummary && subscription.MutedUpdates == 2
+		})).Return(nil).Once()
+		mockLinkRepo.On("DeleteByURL", ctx, "https://github.com/owner/repo", int64(10)).Return(nil).Once()
+		mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+			return update.ID == 7 && update.URL == existing.URL
+		})).Return(nil).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			nil,
+			nil,
+			nil,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		_, err := svc.ProcessLink(ctx, link)
+		require.NoError(t, err)
+
+		mockLinkRepo.AssertExpectations(t)
+		mockLinkRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockBotNotifier.AssertExpectations(t)
+	})
+	t.Run("Ресурс удалён", func(t *testing.T) {
+		mockLinkRepo := new(repomocks.LinkRepository)
+		mockChatRepo := new(repomocks.ChatRepository)
+		mockBotNotifier := new(servicemocks.BotNotifier)
+		mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+		mockTxManager := new(txsmocks.TxManager)
+
+		linkAnalyzer := common.NewLinkAnalyzer(common.NewDefaultSourceRegistry())
+		updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+			StackOverflow: mockStackOverflowClient,
+		})
+
+		link := &models.Link{
+			ID:     8,
+			URL:    "https://stackoverflow.com/questions/12345",
+			Type:   models.StackOverflow,
+			Active: true,
+		}
+
+		mockStackOverflowClient.On("GetQuestionLastUpdate", mock.Anything, "stackoverflow", int64(12345)).
+			Return(time.Time{}, &domainErrors.ErrResourceGone{URL: link.URL}).Times(3)
+
+		mockTxManager.On("WithTransaction", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				err := fn(ctx)
+				require.NoError(t, err)
+			}).Times(3)
+		// Первые ответы 404 ссылку не отключают: ресурс мог быть недоступен временно.
+		mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+			return link.ID == 8 && link.Active && link.GoneChecks == 1
+		})).Return(nil).Once()
+		mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+			return link.ID == 8 && link.Active && link.GoneChecks == 2
+		})).Return(nil).Once()
+		mockLinkRepo.On("Update", ctx, mock.MatchedBy(func(link *models.Link) bool {
+			return link.ID == 8 && !link.Active
+		})).Return(nil).Once()
+		mockLinkRepo.On("FindSubscriptions", ctx, int64(8)).Return([]*models.Subscription{{ChatID: 10}}, nil).Once()
+		mockBotNotifier.On("SendUpdate", ctx, mock.MatchedBy(func(update *models.LinkUpdate) bool {
+			return update.ID == 8 && strings.Contains(update.Description, "/untrack")
+		})).Return(nil).Once()
+
+		svc := service.NewScrapperService(
+			mockLinkRepo,
+			mockChatRepo,
+			mockBotNotifier,
+			nil,
+			nil,
+			nil,
+			updaterFactory,
+			linkAnalyzer,
+			logger,
+			mockTxManager,
+		)
+
+		for range 2 {
+			updated, err := svc.ProcessLink(ctx, link)
+			require.NoError(t, err)
+			assert.False(t, updated)
+			assert.True(t, link.Active)
+		}
+
+		mockBotNotifier.AssertNotCalled(t, "SendUpdate", mock.Anything, mock.Anything)
+
+		updated, err := svc.ProcessLink(ctx, link)
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.False(t, link.Active)
+
+		mockLinkRepo.AssertExpectations(t)
+		mockBotNotifier.AssertExpectations(t)
+	})
+}
+
+func TestScrapperService_CheckLinks(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	mockGithubClient := new(commonmocks.GitHubClient)
+	mockGithubBatch := new(commonmocks.GitHubBatchClient)
+	mockStackOverflowClient := new(commonmocks.StackOverflowClient)
+
+	updaterFactory := common.NewLinkUpdaterFactory(common.NewDefaultSourceRegistry(), &common.UpdaterDependencies{
+		GitHub:        mockGithubClient,
+		GitHubBatch:   mockGithubBatch,
+		StackOverflow: mockStackOverflowClient,
+	})
+
+	pushedAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	repo := models.RepositoryRef{Owner: "owner", Name: "repo"}
+
+	mockGithubBatch.On("GetRepositorySnapshots", ctx, []models.RepositoryRef{repo}).
+		Return(map[models.RepositoryRef]*models.RepositorySnapshot{repo: {PushedAt: pushedAt}}, nil).Once()
+	mockStackOverflowClient.On("GetQuestionsLastUpdate", ctx, "stackoverflow", []int64{12345}).
+		Return(map[int64]time.Time{12345: pushedAt.Add(time.Hour)}, nil).Once()
+
+	svc := service.NewScrapperService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		updaterFactory,
+		common.NewLinkAnalyzer(common.NewDefaultSourceRegistry()),
+		logger,
+		nil,
+	)
+
+	checked := svc.CheckLinks(ctx, []*models.Link{
+		{ID: 1, URL: testRepoURL, Type: models.GitHub},
+		{ID: 2, URL: "https://stackoverflow.com/questions/12345", Type: models.StackOverflow},
+		{ID: 3, URL: "https://example.com/page", Type: models.WebPage},
+	})
+
+	assert.Equal(t, map[int64]time.Time{1: pushedAt, 2: pushedAt.Add(time.Hour)}, checked)
+	mockGithubBatch.AssertExpectations(t)
+	mockStackOverflowClient.AssertExpectations(t)
+	mockGithubClient.AssertNotCalled(t, "GetRepositoryLastUpdate", mock.Anything, mock.Anything, mock.Anything)
 }
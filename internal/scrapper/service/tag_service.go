@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/central-university-dev/go-Matthew11K/internal/common"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/errors"
 	"github.com/central-university-dev/go-Matthew11K/internal/domain/models"
 	"github.com/central-university-dev/go-Matthew11K/internal/scrapper/repository"
@@ -30,20 +31,11 @@ func (s *TagService) AddTagToLink(ctx context.Context, chatID int64, url, tag st
 		return err
 	}
 
-	link, err := s.linkRepo.FindByURL(ctx, url)
-	if err != nil {
-		return err
-	}
-
-	exists, err := s.chatRepo.ExistsChatLink(ctx, chatID, link.ID)
+	link, err := s.findChatLink(ctx, chatID, url)
 	if err != nil {
 		return err
 	}
 
-	if !exists {
-		return &errors.ErrLinkNotFound{URL: url}
-	}
-
 	for _, existingTag := range link.Tags {
 		if existingTag == tag {
 			return &errors.ErrTagAlreadyExists{Tag: tag, URL: url}
@@ -52,7 +44,7 @@ func (s *TagService) AddTagToLink(ctx context.Context, chatID int64, url, tag st
 
 	link.Tags = append(link.Tags, tag)
 
-	if err := s.linkRepo.SaveTags(ctx, link.ID, link.Tags); err != nil {
+	if err := s.linkRepo.SaveTags(ctx, chatID, link.ID, link.Tags); err != nil {
 		return fmt.Errorf("ошибка при сохранении тегов: %w", err)
 	}
 
@@ -71,20 +63,11 @@ func (s *TagService) RemoveTagFromLink(ctx context.Context, chatID int64, url, t
 		return err
 	}
 
-	link, err := s.linkRepo.FindByURL(ctx, url)
+	link, err := s.findChatLink(ctx, chatID, url)
 	if err != nil {
 		return err
 	}
 
-	exists, err := s.chatRepo.ExistsChatLink(ctx, chatID, link.ID)
-	if err != nil {
-		return err
-	}
-
-	if !exists {
-		return &errors.ErrLinkNotFound{URL: url}
-	}
-
 	tagFound := false
 	newTags := make([]string, 0, len(link.Tags))
 
@@ -103,7 +86,7 @@ func (s *TagService) RemoveTagFromLink(ctx context.Context, chatID int64, url, t
 
 	link.Tags = newTags
 
-	if err := s.linkRepo.SaveTags(ctx, link.ID, link.Tags); err != nil {
+	if err := s.linkRepo.SaveTags(ctx, chatID, link.ID, link.Tags); err != nil {
 		return fmt.Errorf("ошибка при сохранении тегов: %w", err)
 	}
 
@@ -116,6 +99,34 @@ func (s *TagService) RemoveTagFromLink(ctx context.Context, chatID int64, url, t
 	return nil
 }
 
+// findChatLink возвращает ссылку, на которую подписан чат, вместе с тегами его подписки.
+// Теги других чатов, отслеживающих тот же адрес, не видны и не изменяются.
+func (s *TagService) findChatLink(ctx context.Context, chatID int64, url string) (*models.Link, error) {
+	url = common.CanonicalizeURL(url)
+
+	link, err := s.linkRepo.FindByURL(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.linkRepo.FindSubscriptions(ctx, link.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptions {
+		if subscription.ChatID == chatID {
+			link.Tags = subscription.Tags
+			link.Filters = subscription.Filters
+			link.Keywords = subscription.Keywords
+
+			return link, nil
+		}
+	}
+
+	return nil, &errors.ErrLinkNotFound{URL: url}
+}
+
 func (s *TagService) GetLinksByTag(ctx context.Context, chatID int64, tag string) ([]*models.Link, error) {
 	_, err := s.chatRepo.FindByID(ctx, chatID)
 	if err != nil {
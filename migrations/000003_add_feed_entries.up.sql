@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS feed_entries (
+    link_id INT NOT NULL,
+    guid TEXT NOT NULL,
+    seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (link_id, guid),
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+ALTER TABLE links
+ADD COLUMN site VARCHAR(64) NOT NULL DEFAULT '';
+
+-- До появления поддержки сети StackExchange отслеживались только вопросы stackoverflow.com.
+UPDATE links SET site = 'stackoverflow' WHERE type = 'stackoverflow';
@@ -0,0 +1,2 @@
+-- Объединение дубликатов ссылок необратимо: исходные варианты URL не сохраняются.
+SELECT 1;
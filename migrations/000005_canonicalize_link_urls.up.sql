@@ -0,0 +1,106 @@
+-- Приводит сохранённые ссылки к каноническому виду по тем же правилам, что и common.CanonicalizeURL
+-- (совпадение результатов проверяет TestCanonicalizeLinkURLsMigration),
+-- и объединяет дубликаты: подписки, теги и фильтры переносятся на ссылку с наименьшим id,
+-- остальные записи удаляются вместе с зависимыми данными.
+
+CREATE TEMP TABLE link_canonical AS
+SELECT id, btrim(url) AS canonical
+FROM links;
+
+UPDATE link_canonical
+SET canonical = 'https://' || canonical
+WHERE canonical !~* '^[a-z][a-z0-9+.-]*://';
+
+-- Схема и хост в нижнем регистре, без порта по умолчанию.
+UPDATE link_canonical
+SET canonical = lower(substring(canonical FROM '^[^:]+://[^/?#]+')) ||
+    coalesce(substring(canonical FROM '^[^:]+://[^/?#]+(.*)$'), '');
+
+UPDATE link_canonical
+SET canonical = regexp_replace(
+    regexp_replace(canonical, '^(http://[^/?#]+):80(?=[/?#]|$)', '\1'),
+    '^(https://[^/?#]+):443(?=[/?#]|$)', '\1');
+
+-- Фрагмент сохраняется только для CSS-селектора веб-страницы.
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical, '#(?!css=).*$', '');
+
+-- Вопросы StackExchange: без www., заголовка вопроса, параметров и якорей.
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical,
+    '^https?://(?:www\.)?([^/?#]+)/(?:questions|q)/([0-9]+).*$', 'https://\1/questions/\2', 'i')
+WHERE canonical ~* ('^https?://(?:www\.)?('
+    '(?:[a-z0-9-]+\.)*(?:stackoverflow|superuser|serverfault|askubuntu|stackapps)\.com|'
+    '(?:meta\.)?mathoverflow\.net|'
+    '(?:[a-z0-9-]+\.)+stackexchange\.com'
+    ')/(?:questions|q)/[0-9]+(?:[/?#].*)?$');
+
+-- GitHub и gitlab.com: https без www., даже если путь не указывает на репозиторий.
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical, '^https?://(?:www\.)?(github\.com|gitlab\.com)(?=[/?#]|$)', 'https://\1');
+
+-- GitHub: владелец и репозиторий в нижнем регистре, без суффикса .git.
+UPDATE link_canonical
+SET canonical = 'https://github.com/' || lower(matched.parts[1]) || '/' ||
+    lower(regexp_replace(matched.parts[2], '\.git$', '')) || matched.parts[3]
+FROM (
+    SELECT id, regexp_match(canonical, '^https://github\.com/([^/?#]+)/([^/?#]+)(.*)$') AS parts
+    FROM link_canonical
+) AS matched
+WHERE link_canonical.id = matched.id
+  AND matched.parts IS NOT NULL;
+
+-- gitlab.com: без суффикса .git.
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical, '\.git/*(?=[?#]|$)', '')
+WHERE canonical ~ '^https://gitlab\.com/';
+
+-- Завершающие слэши пути GitHub и gitlab.com. На остальных сайтах слэш может быть значимым,
+-- поэтому у них отбрасывается только слэш корня.
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical, '^([^?#]*?)/+([?#].*)?$', '\1\2')
+WHERE canonical ~ '^https://(github|gitlab)\.com/[^?#]*/([?#]|$)';
+
+UPDATE link_canonical
+SET canonical = regexp_replace(canonical, '^([^:]+://[^/?#]+)/([?#].*)?$', '\1\2');
+
+CREATE TEMP TABLE link_merge AS
+SELECT id, canonical, MIN(id) OVER (PARTITION BY canonical) AS keep_id
+FROM link_canonical;
+
+INSERT INTO chat_links (chat_id, link_id)
+SELECT cl.chat_id, m.keep_id
+FROM chat_links cl
+JOIN link_merge m ON m.id = cl.link_id
+WHERE m.id <> m.keep_id
+ON CONFLICT DO NOTHING;
+
+INSERT INTO link_tags (link_id, tag_id)
+SELECT m.keep_id, lt.tag_id
+FROM link_tags lt
+JOIN link_merge m ON m.id = lt.link_id
+WHERE m.id <> m.keep_id
+ON CONFLICT DO NOTHING;
+
+INSERT INTO filters (value, link_id)
+SELECT DISTINCT f.value, m.keep_id
+FROM filters f
+JOIN link_merge m ON m.id = f.link_id
+WHERE m.id <> m.keep_id
+  AND NOT EXISTS (
+      SELECT 1 FROM filters kept WHERE kept.link_id = m.keep_id AND kept.value = f.value
+  );
+
+DELETE FROM links l
+USING link_merge m
+WHERE l.id = m.id
+  AND m.id <> m.keep_id;
+
+UPDATE links l
+SET url = m.canonical
+FROM link_merge m
+WHERE l.id = m.id
+  AND l.url <> m.canonical;
+
+DROP TABLE link_merge;
+DROP TABLE link_canonical;
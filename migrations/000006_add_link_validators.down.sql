@@ -0,0 +1,2 @@
+ALTER TABLE links DROP COLUMN IF EXISTS last_modified;
+ALTER TABLE links DROP COLUMN IF EXISTS etag;
@@ -0,0 +1,4 @@
+-- Валидаторы HTTP-кеша последнего ответа API, чтобы повторные проверки отправлялись условными запросами.
+ALTER TABLE links
+ADD COLUMN etag TEXT NOT NULL DEFAULT '',
+ADD COLUMN last_modified TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE links DROP COLUMN IF EXISTS gone_checks;
+ALTER TABLE links DROP COLUMN IF EXISTS active;
@@ -0,0 +1,5 @@
+-- Ссылки на удалённые ресурсы помечаются неактивными и больше не проверяются планировщиком.
+-- gone_checks считает проверки подряд, на которые API ответил 404 или 410: одиночный ответ не отключает ссылку.
+ALTER TABLE links
+ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN gone_checks INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+-- Последний учтённый запуск workflow GitHub Actions: с его итогом сравниваются новые запуски.
+CREATE TABLE IF NOT EXISTS workflow_runs (
+    link_id INT PRIMARY KEY,
+    run_id BIGINT NOT NULL,
+    conclusion TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
+);
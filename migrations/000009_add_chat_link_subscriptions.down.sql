@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    UNIQUE(name)
+);
+
+CREATE TABLE IF NOT EXISTS link_tags (
+    link_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (link_id, tag_id),
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS filters (
+    id SERIAL PRIMARY KEY,
+    value VARCHAR(255) NOT NULL,
+    link_id INT NOT NULL,
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_filters_link_id ON filters(link_id);
+
+-- Теги и фильтры всех подписок объединяются на уровне ссылки.
+INSERT INTO tags (name)
+SELECT DISTINCT tag.name
+FROM chat_links cl, unnest(cl.tags) AS tag(name)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO link_tags (link_id, tag_id)
+SELECT DISTINCT cl.link_id, t.id
+FROM chat_links cl
+CROSS JOIN unnest(cl.tags) AS tag(name)
+JOIN tags t ON t.name = tag.name
+ON CONFLICT DO NOTHING;
+
+INSERT INTO filters (value, link_id)
+SELECT DISTINCT filter.value, cl.link_id
+FROM chat_links cl, unnest(cl.filters) AS filter(value);
+
+ALTER TABLE chat_links
+DROP COLUMN tags,
+DROP COLUMN filters,
+DROP COLUMN mode,
+DROP COLUMN created_at;
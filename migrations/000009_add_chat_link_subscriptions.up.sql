@@ -0,0 +1,26 @@
+-- Теги, фильтры и режим уведомлений принадлежат подписке чата на ссылку, а не самой ссылке:
+-- строка links общая для всех чатов, отслеживающих адрес. Пустой mode означает режим уведомлений чата.
+ALTER TABLE chat_links
+ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN filters TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN mode VARCHAR(10) NOT NULL DEFAULT '',
+ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+-- Раньше теги и фильтры ссылки действовали для всех её чатов, поэтому каждая подписка получает их копию.
+UPDATE chat_links cl
+SET tags = COALESCE((
+        SELECT array_agg(DISTINCT t.name)
+        FROM link_tags lt
+        JOIN tags t ON t.id = lt.tag_id
+        WHERE lt.link_id = cl.link_id
+    ), '{}'),
+    filters = COALESCE((
+        SELECT array_agg(DISTINCT f.value)
+        FROM filters f
+        WHERE f.link_id = cl.link_id
+    ), '{}'),
+    created_at = COALESCE((SELECT l.created_at FROM links l WHERE l.id = cl.link_id), NOW());
+
+DROP TABLE IF EXISTS link_tags;
+DROP TABLE IF EXISTS filters;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,2 @@
+ALTER TABLE chat_links
+DROP COLUMN keywords;
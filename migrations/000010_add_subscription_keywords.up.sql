@@ -0,0 +1,4 @@
+-- Ключевые слова подписки: если они заданы, чат получает только обновления, в тексте которых встречается
+-- хотя бы одно из них.
+ALTER TABLE chat_links
+ADD COLUMN keywords TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS held_updates;
+
+ALTER TABLE chats
+DROP COLUMN quiet_start,
+DROP COLUMN quiet_end,
+DROP COLUMN timezone;
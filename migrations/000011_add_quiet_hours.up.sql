@@ -0,0 +1,20 @@
+-- Часы тишины задаются временем суток в часовом поясе чата. Пустой quiet_start означает, что они отключены.
+ALTER TABLE chats
+ADD COLUMN quiet_start TIME,
+ADD COLUMN quiet_end TIME,
+ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Мгновенные уведомления, отложенные до конца часов тишины чата.
+CREATE TABLE IF NOT EXISTS held_updates (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL,
+    link_id BIGINT NOT NULL,
+    url TEXT NOT NULL,
+    description TEXT NOT NULL,
+    update_info JSONB,
+    keywords TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (chat_id) REFERENCES chats(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_held_updates_chat_id ON held_updates(chat_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_chat_links_muted_until;
+
+ALTER TABLE chat_links
+DROP COLUMN muted_updates,
+DROP COLUMN mute_summary,
+DROP COLUMN muted_until;
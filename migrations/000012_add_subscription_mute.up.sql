@@ -0,0 +1,8 @@
+-- Заглушенная подписка не получает уведомлений до muted_until. Пока она заглушена, muted_updates считает
+-- пропущенные обновления, а mute_summary решает, присылать ли их сводку, когда заглушка закончится.
+ALTER TABLE chat_links
+ADD COLUMN muted_until TIMESTAMP WITH TIME ZONE,
+ADD COLUMN mute_summary BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN muted_updates INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_chat_links_muted_until ON chat_links(muted_until) WHERE muted_until IS NOT NULL;
@@ -0,0 +1,6 @@
+INSERT INTO feed_entries (link_id, guid)
+SELECT sa.link_id, key
+FROM seen_advisories sa, unnest(array_append(sa.keys, 'seeded')) AS key
+ON CONFLICT (link_id, guid) DO NOTHING;
+
+DROP TABLE IF EXISTS seen_advisories;
@@ -0,0 +1,22 @@
+-- Учтённые бюллетени безопасности GitHub: ключи GHSA-идентификатор[/CVE] на момент последней проверки.
+-- Наличие строки означает, что ссылка уже проверялась, даже если бюллетеней у репозитория нет.
+CREATE TABLE IF NOT EXISTS seen_advisories (
+    link_id INT PRIMARY KEY,
+    keys TEXT[] NOT NULL DEFAULT '{}',
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
+);
+
+-- Раньше ключи бюллетеней хранились в feed_entries вместе с меткой 'seeded' первой проверки.
+INSERT INTO seen_advisories (link_id, keys)
+SELECT fe.link_id, coalesce(array_agg(fe.guid) FILTER (WHERE fe.guid <> 'seeded'), '{}')
+FROM feed_entries fe
+JOIN links l ON l.id = fe.link_id
+WHERE l.type = 'github_advisory'
+GROUP BY fe.link_id
+ON CONFLICT (link_id) DO NOTHING;
+
+DELETE FROM feed_entries fe
+USING links l
+WHERE l.id = fe.link_id
+  AND l.type = 'github_advisory';